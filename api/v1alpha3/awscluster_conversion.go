@@ -55,15 +55,45 @@ func (r *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 	}
 
 	dst.Spec.S3Bucket = restored.Spec.S3Bucket
+	dst.Spec.ServiceDiscovery = restored.Spec.ServiceDiscovery
+	dst.Spec.Backup = restored.Spec.Backup
+	dst.Spec.CostAllocationTags = restored.Spec.CostAllocationTags
+	dst.Spec.ServiceQuotaChecks = restored.Spec.ServiceQuotaChecks
+	dst.Spec.NetworkSpec.VPC.RoutePropagation = restored.Spec.NetworkSpec.VPC.RoutePropagation
+	dst.Spec.NetworkSpec.VPC.InstanceTenancy = restored.Spec.NetworkSpec.VPC.InstanceTenancy
+	dst.Spec.NetworkSpec.VPC.BlackholeCIDRs = restored.Spec.NetworkSpec.VPC.BlackholeCIDRs
+	dst.Status.Network.BlackholeNetworkInterfaceID = restored.Status.Network.BlackholeNetworkInterfaceID
+	dst.Status.Network.RoutePropagationGatewayIDs = restored.Status.Network.RoutePropagationGatewayIDs
+	dst.Spec.NetworkSpec.VPC.NatGateway = restored.Spec.NetworkSpec.VPC.NatGateway
+	dst.Spec.NetworkSpec.VPC.SubnetFreeIPThreshold = restored.Spec.NetworkSpec.VPC.SubnetFreeIPThreshold
+	dst.Spec.NetworkSpec.VPC.SubnetTiers = restored.Spec.NetworkSpec.VPC.SubnetTiers
+	RestoreSubnets(restored.Spec.NetworkSpec.Subnets, dst.Spec.NetworkSpec.Subnets)
+	dst.Status.ServiceDiscovery = restored.Status.ServiceDiscovery
+	dst.Status.Backup = restored.Status.Backup
 
 	return nil
 }
 
+// RestoreSubnets manually restores the tier and the tenancy of the subnets.
+// The subnets are only restored when they were not changed in the older version.
+func RestoreSubnets(restored, dst infrav1.Subnets) {
+	if len(restored) != len(dst) {
+		return
+	}
+	for i := range dst {
+		if restored[i].ID == dst[i].ID && restored[i].CidrBlock == dst[i].CidrBlock {
+			dst[i].Tier = restored[i].Tier
+			dst[i].Tenancy = restored[i].Tenancy
+		}
+	}
+}
+
 // restoreControlPlaneLoadBalancer manually restores the control plane loadbalancer data.
 // Assumes restored and dst are non-nil.
 func restoreControlPlaneLoadBalancer(restored, dst *infrav1.AWSLoadBalancerSpec) {
 	dst.Name = restored.Name
 	dst.HealthCheckProtocol = restored.HealthCheckProtocol
+	dst.Existing = restored.Existing
 }
 
 // ConvertFrom converts the v1beta1 AWSCluster receiver to a v1alpha3 AWSCluster.
@@ -131,3 +161,15 @@ func Convert_v1beta1_AWSLoadBalancerSpec_To_v1alpha3_AWSLoadBalancerSpec(in *inf
 func Convert_v1beta1_AWSClusterSpec_To_v1alpha3_AWSClusterSpec(in *infrav1.AWSClusterSpec, out *AWSClusterSpec, s apiconversion.Scope) error {
 	return autoConvert_v1beta1_AWSClusterSpec_To_v1alpha3_AWSClusterSpec(in, out, s)
 }
+
+func Convert_v1beta1_AWSClusterStatus_To_v1alpha3_AWSClusterStatus(in *infrav1.AWSClusterStatus, out *AWSClusterStatus, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_AWSClusterStatus_To_v1alpha3_AWSClusterStatus(in, out, s)
+}
+
+func Convert_v1beta1_VPCSpec_To_v1alpha3_VPCSpec(in *infrav1.VPCSpec, out *VPCSpec, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_VPCSpec_To_v1alpha3_VPCSpec(in, out, s)
+}
+
+func Convert_v1beta1_SubnetSpec_To_v1alpha3_SubnetSpec(in *infrav1.SubnetSpec, out *SubnetSpec, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_SubnetSpec_To_v1alpha3_SubnetSpec(in, out, s)
+}
@@ -41,6 +41,7 @@ func (r *AWSMachine) ConvertTo(dstRaw conversion.Hub) error {
 	restoreSpec(&restored.Spec, &dst.Spec)
 
 	dst.Spec.Ignition = restored.Spec.Ignition
+	dst.Spec.BootMode = restored.Spec.BootMode
 
 	return nil
 }
@@ -103,6 +104,7 @@ func (r *AWSMachineTemplate) ConvertTo(dstRaw conversion.Hub) error {
 
 	dst.Spec.Template.ObjectMeta = restored.Spec.Template.ObjectMeta
 	dst.Spec.Template.Spec.Ignition = restored.Spec.Template.Spec.Ignition
+	dst.Spec.Template.Spec.BootMode = restored.Spec.Template.Spec.BootMode
 
 	restoreSpec(&restored.Spec.Template.Spec, &dst.Spec.Template.Spec)
 
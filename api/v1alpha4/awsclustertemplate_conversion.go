@@ -38,6 +38,16 @@ func (r *AWSClusterTemplate) ConvertTo(dstRaw conversion.Hub) error {
 	}
 
 	dst.Spec.Template.ObjectMeta = restored.Spec.Template.ObjectMeta
+	dst.Spec.Template.Spec.Backup = restored.Spec.Template.Spec.Backup
+	dst.Spec.Template.Spec.CostAllocationTags = restored.Spec.Template.Spec.CostAllocationTags
+	dst.Spec.Template.Spec.ServiceQuotaChecks = restored.Spec.Template.Spec.ServiceQuotaChecks
+	dst.Spec.Template.Spec.NetworkSpec.VPC.RoutePropagation = restored.Spec.Template.Spec.NetworkSpec.VPC.RoutePropagation
+	dst.Spec.Template.Spec.NetworkSpec.VPC.InstanceTenancy = restored.Spec.Template.Spec.NetworkSpec.VPC.InstanceTenancy
+	dst.Spec.Template.Spec.NetworkSpec.VPC.BlackholeCIDRs = restored.Spec.Template.Spec.NetworkSpec.VPC.BlackholeCIDRs
+	dst.Spec.Template.Spec.NetworkSpec.VPC.NatGateway = restored.Spec.Template.Spec.NetworkSpec.VPC.NatGateway
+	dst.Spec.Template.Spec.NetworkSpec.VPC.SubnetFreeIPThreshold = restored.Spec.Template.Spec.NetworkSpec.VPC.SubnetFreeIPThreshold
+	dst.Spec.Template.Spec.NetworkSpec.VPC.SubnetTiers = restored.Spec.Template.Spec.NetworkSpec.VPC.SubnetTiers
+	RestoreSubnets(restored.Spec.Template.Spec.NetworkSpec.Subnets, dst.Spec.Template.Spec.NetworkSpec.Subnets)
 
 	return nil
 }
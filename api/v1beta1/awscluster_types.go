@@ -17,6 +17,8 @@ limitations under the License.
 package v1beta1
 
 import (
+	"strings"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -29,6 +31,11 @@ const (
 
 	// AWSClusterControllerIdentityName is the name of the AWSClusterControllerIdentity singleton.
 	AWSClusterControllerIdentityName = "default"
+
+	// ControlPlaneCertificateRotationAnnotation requests a rotation of the certificates of a self-managed
+	// control plane. The control plane machines are replaced one at a time, which regenerates their
+	// certificates, and the annotation is removed once the rotation has completed.
+	ControlPlaneCertificateRotationAnnotation = "aws.cluster.x-k8s.io/rotate-control-plane-certificates"
 )
 
 // AWSClusterSpec defines the desired state of an EC2-based Kubernetes cluster.
@@ -52,6 +59,13 @@ type AWSClusterSpec struct {
 	// +optional
 	AdditionalTags Tags `json:"additionalTags,omitempty"`
 
+	// CostAllocationTags is an optional set of tags used for cost allocation, e.g. the cost center or the
+	// team owning the cluster. They are added to every AWS resource managed by the AWS provider for the
+	// cluster, including the resources of its machines, and take precedence over the additional tags.
+	// The keys can't be set in the additional tags too. Removed keys are removed from the resources as well.
+	// +optional
+	CostAllocationTags Tags `json:"costAllocationTags,omitempty"`
+
 	// ControlPlaneLoadBalancer is optional configuration for customizing control plane behavior.
 	// +optional
 	ControlPlaneLoadBalancer *AWSLoadBalancerSpec `json:"controlPlaneLoadBalancer,omitempty"`
@@ -97,6 +111,27 @@ type AWSClusterSpec struct {
 	// BootstrapFormatIgnition feature flag to be enabled).
 	// +optional
 	S3Bucket *S3Bucket `json:"s3Bucket,omitempty"`
+
+	// ServiceDiscovery contains options to configure an AWS Cloud Map private
+	// DNS namespace associated with the cluster VPC. The namespace is removed
+	// when the cluster is deleted.
+	// +optional
+	ServiceDiscovery *ServiceDiscovery `json:"serviceDiscovery,omitempty"`
+
+	// Backup contains options to configure an AWS Backup plan taking scheduled
+	// snapshots of the EBS volumes of the cluster. The plan is removed when the
+	// cluster is deleted, the recovery points are kept until they expire.
+	// +optional
+	Backup *Backup `json:"backup,omitempty"`
+
+	// ServiceQuotaChecks enables checking the headroom of the AWS Service Quotas before creating
+	// the instances, network interfaces, Elastic IPs and NAT gateways of the cluster, i.e. of its
+	// network, bastion host, machines, machine pools and managed node groups. When a quota doesn't
+	// have enough headroom, the resources aren't created, the ServiceQuotaHeadroom condition of the
+	// object reports the quota and the reconciliation is retried later. The controller needs the servicequotas:GetServiceQuota,
+	// servicequotas:GetAWSDefaultServiceQuota and ec2:DescribeInstanceTypes permissions.
+	// +optional
+	ServiceQuotaChecks *ServiceQuotaChecks `json:"serviceQuotaChecks,omitempty"`
 }
 
 // AWSIdentityKind defines allowed AWS identity types.
@@ -193,6 +228,39 @@ type AWSLoadBalancerSpec struct {
 	// This is optional - if not provided new security groups will be created for the load balancer
 	// +optional
 	AdditionalSecurityGroups []string `json:"additionalSecurityGroups,omitempty"`
+
+	// Existing references a classic ELB created outside of the provider to use as the control plane
+	// load balancer, found by its ARN or by Name. The provider never creates, configures or deletes it,
+	// it only registers the control plane instances with it, and deregisters the instances of the
+	// cluster when the cluster is deleted. It must have a listener forwarding the API server port to
+	// the instance port 6443, which its security groups are allowed to reach on the control plane.
+	// +optional
+	Existing *ExistingLoadBalancer `json:"existing,omitempty"`
+}
+
+// ExistingLoadBalancer references a classic ELB that isn't managed by the provider.
+type ExistingLoadBalancer struct {
+	// ARN of the classic ELB, e.g. arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/my-lb.
+	// Can't be combined with the name of the control plane load balancer.
+	// +kubebuilder:validation:Pattern=`^arn:aws[a-z-]*:elasticloadbalancing:[a-z0-9-]+:[0-9]{12}:loadbalancer/[A-Za-z0-9-]+$`
+	// +optional
+	ARN string `json:"arn,omitempty"`
+
+	// Tags the load balancer must have to be used, guarding against referencing another load
+	// balancer with the same name.
+	// +optional
+	Tags Tags `json:"tags,omitempty"`
+}
+
+// LoadBalancerName returns the name of the load balancer referenced by the ARN, or an empty string.
+func (e *ExistingLoadBalancer) LoadBalancerName() string {
+	if e == nil {
+		return ""
+	}
+	if i := strings.LastIndex(e.ARN, ":loadbalancer/"); i >= 0 {
+		return e.ARN[i+len(":loadbalancer/"):]
+	}
+	return ""
 }
 
 // AWSClusterStatus defines the observed state of AWSCluster.
@@ -203,6 +271,15 @@ type AWSClusterStatus struct {
 	FailureDomains clusterv1.FailureDomains `json:"failureDomains,omitempty"`
 	Bastion        *Instance                `json:"bastion,omitempty"`
 	Conditions     clusterv1.Conditions     `json:"conditions,omitempty"`
+
+	// ServiceDiscovery is the observed state of the AWS Cloud Map namespace
+	// managed for this cluster.
+	// +optional
+	ServiceDiscovery *ServiceDiscoveryStatus `json:"serviceDiscovery,omitempty"`
+
+	// Backup is the observed state of the AWS Backup plan managed for this cluster.
+	// +optional
+	Backup *BackupStatus `json:"backup,omitempty"`
 }
 
 type S3Bucket struct {
@@ -221,6 +298,98 @@ type S3Bucket struct {
 	Name string `json:"name"`
 }
 
+// ServiceDiscovery defines an AWS Cloud Map namespace for the cluster.
+type ServiceDiscovery struct {
+	// NamespaceName is the name of the private DNS namespace to create in
+	// AWS Cloud Map, e.g. "cluster.local". Once set, the value cannot be changed.
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:MaxLength:=253
+	NamespaceName string `json:"namespaceName"`
+
+	// Description is an optional description for the namespace.
+	// +kubebuilder:validation:MaxLength:=1024
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+// ServiceDiscoveryStatus describes the AWS Cloud Map namespace created for the cluster.
+type ServiceDiscoveryStatus struct {
+	// NamespaceID is the ID of the AWS Cloud Map namespace.
+	// +optional
+	NamespaceID string `json:"namespaceID,omitempty"`
+
+	// NamespaceARN is the ARN of the AWS Cloud Map namespace.
+	// +optional
+	NamespaceARN string `json:"namespaceARN,omitempty"`
+}
+
+// Backup defines an AWS Backup plan for the EBS volumes of the cluster.
+type Backup struct {
+	// Schedule is the cron expression in UTC used to start the backups.
+	// +kubebuilder:validation:Pattern=`^cron\(.+\)$`
+	// +kubebuilder:default="cron(0 5 ? * * *)"
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+
+	// RetentionDays is the number of days the recovery points are kept.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:default=7
+	// +optional
+	RetentionDays int64 `json:"retentionDays,omitempty"`
+
+	// VaultName is the name of the existing backup vault the recovery points are stored in.
+	// +kubebuilder:default=Default
+	// +optional
+	VaultName string `json:"vaultName,omitempty"`
+
+	// IAMRoleARN is the ARN of the IAM role AWS Backup assumes to snapshot the volumes,
+	// e.g. the AWSBackupDefaultServiceRole. The controller needs iam:PassRole on it.
+	IAMRoleARN string `json:"iamRoleARN"`
+
+	// SelectionTags are the tags selecting the volumes to back up. Defaults to the
+	// tag marking resources as owned by the cluster.
+	// +optional
+	SelectionTags Tags `json:"selectionTags,omitempty"`
+}
+
+// ServiceQuota is an AWS Service Quota checked before creating resources.
+// +kubebuilder:validation:Enum=vCPU;ElasticIP;NATGateway;NetworkInterface
+type ServiceQuota string
+
+const (
+	// ServiceQuotaVCPU is the quota of vCPUs of the running on-demand standard (A, C, D, H, I, M, R, T, Z) instances.
+	ServiceQuotaVCPU = ServiceQuota("vCPU")
+	// ServiceQuotaElasticIP is the quota of Elastic IP addresses of the region.
+	ServiceQuotaElasticIP = ServiceQuota("ElasticIP")
+	// ServiceQuotaNATGateway is the quota of NAT gateways of each availability zone.
+	ServiceQuotaNATGateway = ServiceQuota("NATGateway")
+	// ServiceQuotaNetworkInterface is the quota of network interfaces of the region.
+	ServiceQuotaNetworkInterface = ServiceQuota("NetworkInterface")
+)
+
+// ServiceQuotaChecks defines the AWS Service Quotas checked before creating resources.
+type ServiceQuotaChecks struct {
+	// Quotas are the quotas to check the headroom of. Defaults to all of them.
+	// +listType=set
+	// +optional
+	Quotas []ServiceQuota `json:"quotas,omitempty"`
+}
+
+// BackupStatus describes the AWS Backup plan created for the cluster.
+type BackupStatus struct {
+	// PlanID is the ID of the backup plan.
+	// +optional
+	PlanID string `json:"planID,omitempty"`
+
+	// PlanARN is the ARN of the backup plan.
+	// +optional
+	PlanARN string `json:"planARN,omitempty"`
+
+	// SelectionID is the ID of the resource selection assigned to the backup plan.
+	// +optional
+	SelectionID string `json:"selectionID,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:path=awsclusters,scope=Namespaced,categories=cluster-api,shortName=awsc
 // +kubebuilder:storageversion
@@ -55,7 +55,13 @@ func (r *AWSCluster) ValidateCreate() error {
 	allErrs = append(allErrs, r.Spec.Bastion.Validate()...)
 	allErrs = append(allErrs, r.validateSSHKeyName()...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
+	allErrs = append(allErrs, r.Spec.CostAllocationTags.ValidateCostAllocation(r.Spec.AdditionalTags)...)
 	allErrs = append(allErrs, r.Spec.S3Bucket.Validate()...)
+	allErrs = append(allErrs, r.Spec.ServiceDiscovery.Validate()...)
+	allErrs = append(allErrs, r.Spec.Backup.Validate()...)
+	allErrs = append(allErrs, r.validateInternetFacing()...)
+	allErrs = append(allErrs, r.validateExistingLoadBalancer()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.Validate()...)
 
 	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
@@ -126,6 +132,14 @@ func (r *AWSCluster) ValidateUpdate(old runtime.Object) error {
 					newLoadBalancer.HealthCheckProtocol, "field is immutable once set"),
 			)
 		}
+
+		// Switching between an existing and a managed load balancer would orphan one of them.
+		if !cmp.Equal(existingLoadBalancer.Existing, newLoadBalancer.Existing) {
+			allErrs = append(allErrs,
+				field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "existing"),
+					newLoadBalancer.Existing, "field is immutable"),
+			)
+		}
 	}
 
 	if !cmp.Equal(oldC.Spec.ControlPlaneEndpoint, clusterv1.APIEndpoint{}) &&
@@ -163,9 +177,25 @@ func (r *AWSCluster) ValidateUpdate(old runtime.Object) error {
 		)
 	}
 
+	// The Cloud Map namespace name cannot be changed once set, as it would orphan the existing namespace.
+	if oldC.Spec.ServiceDiscovery != nil && r.Spec.ServiceDiscovery != nil &&
+		oldC.Spec.ServiceDiscovery.NamespaceName != r.Spec.ServiceDiscovery.NamespaceName {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "serviceDiscovery", "namespaceName"),
+				r.Spec.ServiceDiscovery.NamespaceName, "field is immutable"),
+		)
+	}
+
 	allErrs = append(allErrs, r.Spec.Bastion.Validate()...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
+	allErrs = append(allErrs, r.Spec.CostAllocationTags.ValidateCostAllocation(r.Spec.AdditionalTags)...)
 	allErrs = append(allErrs, r.Spec.S3Bucket.Validate()...)
+	allErrs = append(allErrs, r.Spec.ServiceDiscovery.Validate()...)
+	allErrs = append(allErrs, r.Spec.Backup.Validate()...)
+	allErrs = append(allErrs, r.validateInternetFacing()...)
+	allErrs = append(allErrs, r.validateExistingLoadBalancer()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.Validate()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateUpdate(&oldC.Spec.NetworkSpec)...)
 
 	return aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
@@ -175,6 +205,41 @@ func (r *AWSCluster) Default() {
 	SetObjectDefaults_AWSCluster(r)
 }
 
+func (r *AWSCluster) validateInternetFacing() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.Bastion.Enabled {
+		allErrs = append(allErrs, r.Spec.NetworkSpec.VPC.ValidateInternetFacing(field.NewPath("spec", "bastion", "enabled"))...)
+	}
+
+	// The scheme defaults to internet-facing, existing load balancers aren't placed by CAPA.
+	lb := r.Spec.ControlPlaneLoadBalancer
+	if lb == nil || (lb.Existing == nil && (lb.Scheme == nil || *lb.Scheme == ClassicELBSchemeInternetFacing)) {
+		allErrs = append(allErrs, r.Spec.NetworkSpec.VPC.ValidateInternetFacing(field.NewPath("spec", "controlPlaneLoadBalancer", "scheme"))...)
+	}
+
+	return allErrs
+}
+
+func (r *AWSCluster) validateExistingLoadBalancer() field.ErrorList {
+	var allErrs field.ErrorList
+
+	lb := r.Spec.ControlPlaneLoadBalancer
+	if lb == nil || lb.Existing == nil {
+		return allErrs
+	}
+
+	fldPath := field.NewPath("spec", "controlPlaneLoadBalancer")
+	if lb.Existing.ARN == "" && lb.Name == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("existing", "arn"), "either the ARN or the name of the existing load balancer is required"))
+	}
+	if lb.Existing.ARN != "" && lb.Name != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("name"), "cannot be combined with the ARN of the existing load balancer"))
+	}
+
+	return allErrs
+}
+
 func (r *AWSCluster) validateSSHKeyName() field.ErrorList {
 	return validateSSHKeyName(r.Spec.SSHKeyName)
 }
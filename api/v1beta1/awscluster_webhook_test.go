@@ -96,6 +96,26 @@ func TestAWSCluster_ValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "accepts cost allocation tags",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					AdditionalTags:     Tags{"environment": "production"},
+					CostAllocationTags: Tags{"cost-center": "1234"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects cost allocation tags also set in additional tags",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					AdditionalTags:     Tags{"cost-center": "5678"},
+					CostAllocationTags: Tags{"cost-center": "1234"},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "accepts bucket name with acceptable characters",
 			cluster: &AWSCluster{
@@ -222,6 +242,367 @@ func TestAWSCluster_ValidateCreate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "accepts a valid service discovery namespace name",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ServiceDiscovery: &ServiceDiscovery{
+						NamespaceName: "cluster.local",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects a service discovery namespace name that is not a valid DNS name",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ServiceDiscovery: &ServiceDiscovery{
+						NamespaceName: "Not_A_DNS_Name",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts subnet tiers including the lb and node tiers",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							SubnetTiers: []SubnetTier{SubnetTierLB, SubnetTierNode, SubnetTierPod, SubnetTierDatabase},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects subnet tiers without the node tier",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							SubnetTiers: []SubnetTier{SubnetTierLB, SubnetTierPod},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects subnet tiers on an unmanaged VPC",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							ID:          "vpc-123",
+							SubnetTiers: []SubnetTier{SubnetTierLB, SubnetTierNode},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects duplicated subnet tiers",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							SubnetTiers: []SubnetTier{SubnetTierLB, SubnetTierNode, SubnetTierLB},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts private NAT gateways with a transit gateway",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						Scheme: &ClassicELBSchemeInternal,
+					},
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							NatGateway: &NatGatewaySpec{
+								ConnectivityType: NatGatewayConnectivityTypePrivate,
+								TransitGatewayID: "tgw-0123456789abcdef0",
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects an internet-facing load balancer with private NAT gateways",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							NatGateway: &NatGatewaySpec{
+								ConnectivityType: NatGatewayConnectivityTypePrivate,
+								TransitGatewayID: "tgw-0123456789abcdef0",
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects a bastion with private NAT gateways",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						Scheme: &ClassicELBSchemeInternal,
+					},
+					Bastion: Bastion{
+						Enabled: true,
+					},
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							NatGateway: &NatGatewaySpec{
+								ConnectivityType: NatGatewayConnectivityTypePrivate,
+								TransitGatewayID: "tgw-0123456789abcdef0",
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects private NAT gateways without a transit gateway",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							NatGateway: &NatGatewaySpec{
+								ConnectivityType: NatGatewayConnectivityTypePrivate,
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects a transit gateway for public NAT gateways",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							NatGateway: &NatGatewaySpec{
+								ConnectivityType: NatGatewayConnectivityTypePublic,
+								TransitGatewayID: "tgw-0123456789abcdef0",
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts dedicated subnets in a VPC with default tenancy",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						Subnets: Subnets{
+							{ID: "subnet-1", Tenancy: "dedicated"},
+							{ID: "subnet-2", Tenancy: "default"},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects subnets with default tenancy in a VPC with dedicated tenancy",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							InstanceTenancy: "dedicated",
+						},
+						Subnets: Subnets{
+							{ID: "subnet-1", Tenancy: "default"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects instance tenancy on an unmanaged VPC",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							ID:              "vpc-123",
+							InstanceTenancy: "dedicated",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts blackhole CIDR blocks",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							BlackholeCIDRs: []string{"198.51.100.0/24", "203.0.113.7/32"},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects blackhole CIDR blocks which aren't network addresses",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							BlackholeCIDRs: []string{"198.51.100.7/24"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects a blackhole CIDR block replacing the default route",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							BlackholeCIDRs: []string{"0.0.0.0/0"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects a blackhole CIDR block overlapping the VPC CIDR block",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							CidrBlock:      "10.0.0.0/16",
+							BlackholeCIDRs: []string{"10.0.1.0/24"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects blackhole CIDR blocks on an unmanaged VPC",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							ID:             "vpc-123",
+							BlackholeCIDRs: []string{"198.51.100.0/24"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts route propagation on a managed VPC",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							RoutePropagation: &RoutePropagationSpec{EnableRoutePropagation: true, GatewayID: "vgw-0123456789abcdef0"},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects route propagation on an unmanaged VPC",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							ID:               "vpc-123",
+							RoutePropagation: &RoutePropagationSpec{EnableRoutePropagation: true, GatewayID: "vgw-0123456789abcdef0"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts an existing control plane load balancer referenced by ARN",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						Existing: &ExistingLoadBalancer{
+							ARN: "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/corp-apiserver",
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects an existing control plane load balancer without ARN nor name",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						Existing: &ExistingLoadBalancer{},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects an existing control plane load balancer with both ARN and name",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						Name: aws.String("corp-apiserver"),
+						Existing: &ExistingLoadBalancer{
+							ARN: "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/corp-apiserver",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts backup with an IAM role ARN",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Backup: &Backup{
+						IAMRoleARN: "arn:aws:iam::123456789012:role/service-role/AWSBackupDefaultServiceRole",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects backup with an invalid IAM role ARN",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					Backup: &Backup{
+						IAMRoleARN: "AWSBackupDefaultServiceRole",
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -542,6 +923,74 @@ func TestAWSCluster_ValidateUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "natGateway connectivityType is immutable",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							NatGateway: &NatGatewaySpec{
+								ConnectivityType: NatGatewayConnectivityTypePrivate,
+								TransitGatewayID: "tgw-0123456789abcdef0",
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "instanceTenancy is immutable",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						VPC: VPCSpec{
+							InstanceTenancy: "dedicated",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "controlPlaneLoadBalancer existing is immutable",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						Name: aws.String("corp-apiserver"),
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						Name:     aws.String("corp-apiserver"),
+						Existing: &ExistingLoadBalancer{},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "serviceDiscovery namespaceName is immutable",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ServiceDiscovery: &ServiceDiscovery{NamespaceName: "old.local"},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ServiceDiscovery: &ServiceDiscovery{NamespaceName: "new.local"},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -157,6 +157,13 @@ type AWSMachineSpec struct {
 	// +optional
 	// +kubebuilder:validation:Enum:=default;dedicated;host
 	Tenancy string `json:"tenancy,omitempty"`
+
+	// BootMode is the boot mode the instance is required to boot with. The boot mode
+	// must be supported by the AMI, otherwise the instance will not be created.
+	// When not specified the default boot mode of the AMI is used.
+	// +optional
+	// +kubebuilder:validation:Enum:=legacy-bios;uefi
+	BootMode string `json:"bootMode,omitempty"`
 }
 
 // CloudInit defines options related to the bootstrapping systems where
@@ -0,0 +1,50 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var iamRoleARNRegex = regexp.MustCompile(`^arn:aws[a-z-]*:iam::[0-9]{12}:role/[\w+=,.@/-]+$`)
+
+// Validate validates Backup fields.
+func (b *Backup) Validate() []*field.Error {
+	var errs field.ErrorList
+
+	if b == nil {
+		return errs
+	}
+
+	path := field.NewPath("spec", "backup")
+
+	if b.IAMRoleARN == "" {
+		errs = append(errs, field.Required(path.Child("iamRoleARN"), "can't be empty"))
+	} else if !iamRoleARNRegex.MatchString(b.IAMRoleARN) {
+		errs = append(errs, field.Invalid(path.Child("iamRoleARN"), b.IAMRoleARN, "must be the ARN of an IAM role"))
+	}
+
+	for k := range b.SelectionTags {
+		if k == "" {
+			errs = append(errs, field.Invalid(path.Child("selectionTags"), k, "key cannot be empty"))
+		}
+	}
+
+	return errs
+}
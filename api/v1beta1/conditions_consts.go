@@ -51,6 +51,11 @@ const (
 	SubnetsReadyCondition clusterv1.ConditionType = "SubnetsReady"
 	// SubnetsReconciliationFailedReason used to report failures while reconciling subnets.
 	SubnetsReconciliationFailedReason = "SubnetsReconciliationFailed"
+	// SubnetsFreeIPsSufficientCondition reports on whether the subnets used by the cluster have
+	// at least the configured number of available IP addresses.
+	SubnetsFreeIPsSufficientCondition clusterv1.ConditionType = "SubnetsFreeIPsSufficient"
+	// SubnetsFreeIPsLowReason used when one or more subnets are below the free IP threshold.
+	SubnetsFreeIPsLowReason = "SubnetsFreeIPsLow"
 )
 
 const (
@@ -165,3 +170,46 @@ const (
 	// S3BucketFailedReason is used when any errors occur during reconciliation of an S3 bucket.
 	S3BucketFailedReason = "S3BucketCreationFailed"
 )
+
+const (
+	// ServiceDiscoveryNamespaceReadyCondition indicates the AWS Cloud Map namespace has been created successfully.
+	ServiceDiscoveryNamespaceReadyCondition clusterv1.ConditionType = "ServiceDiscoveryNamespaceReady"
+
+	// ServiceDiscoveryNamespaceFailedReason is used when any errors occur during reconciliation of the AWS Cloud Map namespace.
+	ServiceDiscoveryNamespaceFailedReason = "ServiceDiscoveryNamespaceFailed"
+	// ServiceDiscoveryNamespaceCreatingReason is used while the AWS Cloud Map namespace is still being created.
+	ServiceDiscoveryNamespaceCreatingReason = "ServiceDiscoveryNamespaceCreating"
+)
+
+const (
+	// ControlPlaneCertificatesRotatedCondition reports on the progress of a control plane certificate rotation
+	// requested with the ControlPlaneCertificateRotationAnnotation.
+	ControlPlaneCertificatesRotatedCondition clusterv1.ConditionType = "ControlPlaneCertificatesRotated"
+
+	// ControlPlaneCertificatesRotationPendingReason used when the rotation is waiting for the control plane to be stable.
+	ControlPlaneCertificatesRotationPendingReason = "ControlPlaneCertificatesRotationPending"
+	// ControlPlaneCertificatesRotationInProgressReason used while the control plane machines are being replaced.
+	ControlPlaneCertificatesRotationInProgressReason = "ControlPlaneCertificatesRotationInProgress"
+	// ControlPlaneCertificatesRotationFailedReason used when the rotation can't be performed.
+	ControlPlaneCertificatesRotationFailedReason = "ControlPlaneCertificatesRotationFailed"
+)
+
+const (
+	// BackupPlanReadyCondition indicates the AWS Backup plan and its resource selection have been reconciled successfully.
+	BackupPlanReadyCondition clusterv1.ConditionType = "BackupPlanReady"
+
+	// BackupPlanFailedReason is used when any errors occur during reconciliation of the AWS Backup plan.
+	BackupPlanFailedReason = "BackupPlanFailed"
+)
+
+const (
+	// ServiceQuotaHeadroomCondition reports whether the AWS Service Quotas have enough headroom for the resources
+	// about to be created.
+	ServiceQuotaHeadroomCondition clusterv1.ConditionType = "ServiceQuotaHeadroom"
+
+	// InsufficientServiceQuotaReason is used when a service quota doesn't have enough headroom for the resources
+	// about to be created.
+	InsufficientServiceQuotaReason = "InsufficientServiceQuota"
+	// ServiceQuotaCheckFailedReason is used when the headroom of a service quota can't be checked.
+	ServiceQuotaCheckFailedReason = "ServiceQuotaCheckFailed"
+)
@@ -0,0 +1,198 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Validate validates the NetworkSpec fields that are shared by the AWSCluster and
+// AWSManagedControlPlane webhooks.
+func (n *NetworkSpec) Validate() field.ErrorList {
+	var allErrs field.ErrorList
+
+	fldPath := field.NewPath("spec", "network", "vpc")
+	if n.VPC.isUserProvided() && n.VPC.RoutePropagation != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("routePropagation"), "only applicable to managed VPCs"))
+	}
+	if n.VPC.isUserProvided() && len(n.VPC.SubnetTiers) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("subnetTiers"), "only applicable to managed VPCs"))
+	}
+	if n.VPC.isUserProvided() && n.VPC.InstanceTenancy != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("instanceTenancy"), "only applicable to managed VPCs"))
+	}
+	if n.VPC.isUserProvided() && len(n.VPC.BlackholeCIDRs) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("blackholeCidrs"), "only applicable to managed VPCs"))
+	}
+	allErrs = append(allErrs, n.VPC.ValidateSubnetTiers()...)
+	allErrs = append(allErrs, n.VPC.validateNatGateway()...)
+	allErrs = append(allErrs, n.VPC.validateBlackholeCIDRs()...)
+	allErrs = append(allErrs, n.validateSubnetTenancy()...)
+
+	return allErrs
+}
+
+// ValidateUpdate validates the changes to the NetworkSpec fields that are shared by the AWSCluster
+// and AWSManagedControlPlane webhooks.
+func (n *NetworkSpec) ValidateUpdate(old *NetworkSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	// The tenancy of a VPC is only set when it's created.
+	if n.VPC.instanceTenancy() != old.VPC.instanceTenancy() {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "network", "vpc", "instanceTenancy"), n.VPC.InstanceTenancy, "field is immutable"),
+		)
+	}
+
+	// Existing NAT gateways cannot change their connectivity type.
+	if n.VPC.NatGateway.IsPrivate() != old.VPC.NatGateway.IsPrivate() {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "network", "vpc", "natGateway", "connectivityType"), n.VPC.NatGateway, "field is immutable"),
+		)
+	}
+
+	return allErrs
+}
+
+func (n *NetworkSpec) validateSubnetTenancy() field.ErrorList {
+	var allErrs field.ErrorList
+
+	// Instances of a VPC with dedicated tenancy always run on single-tenant hardware.
+	if n.VPC.InstanceTenancy != "dedicated" {
+		return allErrs
+	}
+
+	for i, subnet := range n.Subnets {
+		if subnet.Tenancy == "default" {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "network", "subnets").Index(i).Child("tenancy"),
+				subnet.Tenancy, "cannot be default in a VPC with dedicated tenancy"))
+		}
+	}
+
+	return allErrs
+}
+
+// instanceTenancy returns the instance tenancy of the VPC, which defaults to default.
+func (v *VPCSpec) instanceTenancy() string {
+	if v.InstanceTenancy == "" {
+		return "default"
+	}
+	return v.InstanceTenancy
+}
+
+func (v *VPCSpec) validateNatGateway() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if v.NatGateway == nil {
+		return allErrs
+	}
+
+	fldPath := field.NewPath("spec", "network", "vpc", "natGateway", "transitGatewayId")
+	if v.NatGateway.IsPrivate() && v.NatGateway.TransitGatewayID == "" {
+		allErrs = append(allErrs, field.Required(fldPath, "a transit gateway is required for private NAT gateways"))
+	}
+	if !v.NatGateway.IsPrivate() && v.NatGateway.TransitGatewayID != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "only applicable to private NAT gateways"))
+	}
+
+	return allErrs
+}
+
+func (v *VPCSpec) validateBlackholeCIDRs() field.ErrorList {
+	var allErrs field.ErrorList
+
+	// Routes to the CIDR block of the VPC itself can't be replaced.
+	_, vpcNet, _ := net.ParseCIDR(v.CidrBlock)
+
+	fldPath := field.NewPath("spec", "network", "vpc", "blackholeCidrs")
+	seen := map[string]bool{}
+	for i, cidr := range v.BlackholeCIDRs {
+		ip, ipNet, err := net.ParseCIDR(cidr)
+		switch {
+		case err != nil || ip.To4() == nil:
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), cidr, "must be a valid IPv4 CIDR block"))
+		case ipNet.String() != cidr:
+			// The routes are matched by destination, which AWS normalizes.
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), cidr, fmt.Sprintf("must be the network address %s", ipNet.String())))
+		case ipNet.String() == "0.0.0.0/0":
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), cidr, "cannot replace the default route"))
+		case vpcNet != nil && (vpcNet.Contains(ipNet.IP) || ipNet.Contains(vpcNet.IP)):
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), cidr, fmt.Sprintf("cannot overlap the VPC CIDR block %s", v.CidrBlock)))
+		case seen[cidr]:
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), cidr))
+		}
+		seen[cidr] = true
+	}
+
+	return allErrs
+}
+
+// ValidateInternetFacing validates that an internet-facing resource placed in the public subnets,
+// like the bastion host, can be reached. The public subnets of private NAT gateways route their
+// default traffic to the transit gateway instead of the internet gateway.
+func (v *VPCSpec) ValidateInternetFacing(fldPath *field.Path) field.ErrorList {
+	if !v.NatGateway.IsPrivate() {
+		return nil
+	}
+	return field.ErrorList{
+		field.Forbidden(fldPath, "internet-facing resources are unreachable when the NAT gateways are private, the public subnets route to the transit gateway"),
+	}
+}
+
+// ValidateSubnetTiers validates the subnet tiers carved out of a managed VPC. Each tier can only
+// be specified once, and the lb and node tiers are required.
+func (v *VPCSpec) ValidateSubnetTiers() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(v.SubnetTiers) == 0 {
+		return allErrs
+	}
+
+	fldPath := field.NewPath("spec", "network", "vpc", "subnetTiers")
+	seen := map[SubnetTier]bool{}
+	for i, tier := range v.SubnetTiers {
+		if seen[tier] {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), tier))
+		}
+		seen[tier] = true
+	}
+	for _, required := range []SubnetTier{SubnetTierLB, SubnetTierNode} {
+		if !seen[required] {
+			allErrs = append(allErrs, field.Required(fldPath, fmt.Sprintf("the %s subnet tier is required", required)))
+		}
+	}
+
+	return allErrs
+}
+
+// isUserProvided returns true if the VPC was brought by the user rather than created by the
+// provider. Unlike IsUnmanaged it doesn't need the cluster name, which webhooks don't know.
+func (v *VPCSpec) isUserProvided() bool {
+	if v.ID == "" {
+		return false
+	}
+	for key, value := range v.Tags {
+		if strings.HasPrefix(key, NameAWSProviderOwned) && ResourceLifecycle(value) == ResourceLifecycleOwned {
+			return false
+		}
+	}
+	return true
+}
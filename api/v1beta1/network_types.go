@@ -29,6 +29,17 @@ type NetworkStatus struct {
 
 	// APIServerELB is the Kubernetes api server classic load balancer.
 	APIServerELB ClassicELB `json:"apiServerElb,omitempty"`
+
+	// BlackholeNetworkInterfaceID is the id of the unattached network interface targeted by the
+	// blackhole routes of the managed route tables, see VPCSpec.BlackholeCIDRs.
+	// +optional
+	BlackholeNetworkInterfaceID string `json:"blackholeNetworkInterfaceId,omitempty"`
+
+	// RoutePropagationGatewayIDs are the IDs of the virtual private gateways route propagation was
+	// enabled from on the managed route tables, see VPCSpec.RoutePropagation. Propagation from these
+	// gateways is disabled once they are no longer configured.
+	// +optional
+	RoutePropagationGatewayIDs []string `json:"routePropagationGatewayIds,omitempty"`
 }
 
 // ClassicELBScheme defines the scheme of a classic load balancer.
@@ -181,6 +192,14 @@ type VPCSpec struct {
 	// Tags is a collection of tags describing the resource.
 	Tags Tags `json:"tags,omitempty"`
 
+	// InstanceTenancy is the tenancy of the instances launched in the VPC when the provider creates a
+	// managed VPC. With dedicated, all the instances run on single-tenant hardware regardless of the
+	// tenancy requested for them. It can't be changed once the VPC is created, nor set for an
+	// unmanaged VPC. Defaults to default.
+	// +kubebuilder:validation:Enum:=default;dedicated
+	// +optional
+	InstanceTenancy string `json:"instanceTenancy,omitempty"`
+
 	// AvailabilityZoneUsageLimit specifies the maximum number of availability zones (AZ) that
 	// should be used in a region when automatically creating subnets. If a region has more
 	// than this number of AZs then this number of AZs will be picked randomly when creating
@@ -197,6 +216,102 @@ type VPCSpec struct {
 	// +kubebuilder:default=Ordered
 	// +kubebuilder:validation:Enum=Ordered;Random
 	AvailabilityZoneSelection *AZSelectionScheme `json:"availabilityZoneSelection,omitempty"`
+
+	// RoutePropagation configures the propagation of routes from a virtual private gateway
+	// into the route tables managed by the provider.
+	// Transit gateways don't propagate routes into VPC route tables, so they aren't supported here.
+	// Only applicable to managed VPCs.
+	// +optional
+	RoutePropagation *RoutePropagationSpec `json:"routePropagation,omitempty"`
+
+	// NatGateway configures the NAT gateways created in the public subnets for the egress of the
+	// private subnets. When unset, public NAT gateways are created.
+	// Only applicable to managed VPCs.
+	// +optional
+	NatGateway *NatGatewaySpec `json:"natGateway,omitempty"`
+
+	// SubnetFreeIPThreshold is the minimum number of available IP addresses each subnet used by
+	// the cluster should have. When a subnet has fewer available IP addresses, the
+	// SubnetsFreeIPsSufficient condition is set to false and a warning event is emitted.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	SubnetFreeIPThreshold *int64 `json:"subnetFreeIPThreshold,omitempty"`
+
+	// BlackholeCIDRs are IPv4 CIDR blocks null-routed from the managed route tables, e.g. to cut the
+	// nodes off a malicious network during an incident. The routes target a network interface that
+	// isn't attached to any instance, dropping the traffic, and are removed with the CIDR block.
+	// The CIDR blocks can't overlap the VPC CIDR block. Only applicable to managed VPCs.
+	// +optional
+	BlackholeCIDRs []string `json:"blackholeCidrs,omitempty"`
+
+	// SubnetTiers are the subnet tiers carved out of the VPC CIDR block in each availability zone
+	// when no subnets are specified. The lb and node tiers are required. When unset, one public and
+	// one private subnet are created per availability zone.
+	// Only applicable to managed VPCs.
+	// +optional
+	SubnetTiers []SubnetTier `json:"subnetTiers,omitempty"`
+}
+
+// SubnetTier describes the purpose of a subnet, which determines its routing and tags.
+// +kubebuilder:validation:Enum=lb;node;pod;database
+type SubnetTier string
+
+const (
+	// SubnetTierLB is a public subnet for internet-facing load balancers and NAT gateways.
+	SubnetTierLB = SubnetTier("lb")
+	// SubnetTierNode is a private subnet for nodes and internal load balancers, routed through the NAT gateways.
+	SubnetTierNode = SubnetTier("node")
+	// SubnetTierPod is a private subnet dedicated to pod IP addresses, routed through the NAT gateways.
+	SubnetTierPod = SubnetTier("pod")
+	// SubnetTierDatabase is an isolated private subnet without a default route.
+	SubnetTierDatabase = SubnetTier("database")
+)
+
+// RoutePropagationSpec configures route propagation for the managed route tables.
+type RoutePropagationSpec struct {
+	// EnableRoutePropagation enables the propagation of routes learned by the gateway
+	// into the managed route tables. When false, propagation from the gateway is disabled.
+	// +optional
+	EnableRoutePropagation bool `json:"enableRoutePropagation"`
+
+	// GatewayID is the ID of the virtual private gateway attached to the VPC, e.g. vgw-0123456789abcdef0.
+	// Transit gateway IDs are not accepted.
+	// +kubebuilder:validation:Pattern=`^vgw-[0-9a-f]+$`
+	GatewayID string `json:"gatewayId"`
+}
+
+// NatGatewayConnectivityType defines whether a NAT gateway provides internet or private connectivity.
+type NatGatewayConnectivityType string
+
+const (
+	// NatGatewayConnectivityTypePublic NAT gateways are assigned an Elastic IP address and send
+	// traffic to the internet through the internet gateway.
+	NatGatewayConnectivityTypePublic = NatGatewayConnectivityType("public")
+	// NatGatewayConnectivityTypePrivate NAT gateways have no Elastic IP address and send traffic to
+	// other networks, e.g. through a transit gateway.
+	NatGatewayConnectivityTypePrivate = NatGatewayConnectivityType("private")
+)
+
+// NatGatewaySpec configures the managed NAT gateways.
+type NatGatewaySpec struct {
+	// ConnectivityType is the connectivity type of the NAT gateways. When private, no Elastic IP
+	// addresses are allocated and the subnets hosting the NAT gateways route their default traffic
+	// to the transit gateway instead of the internet gateway. Defaults to public.
+	// +kubebuilder:validation:Enum=public;private
+	// +kubebuilder:default=public
+	// +optional
+	ConnectivityType NatGatewayConnectivityType `json:"connectivityType,omitempty"`
+
+	// TransitGatewayID is the ID of the transit gateway attached to the VPC that the private NAT
+	// gateways send traffic to, e.g. tgw-0123456789abcdef0. Required when ConnectivityType is private.
+	// +kubebuilder:validation:Pattern=`^tgw-[0-9a-f]+$`
+	// +optional
+	TransitGatewayID string `json:"transitGatewayId,omitempty"`
+}
+
+// IsPrivate returns true if the NAT gateways provide private connectivity only.
+func (n *NatGatewaySpec) IsPrivate() bool {
+	return n != nil && n.ConnectivityType == NatGatewayConnectivityTypePrivate
 }
 
 // String returns a string representation of the VPC.
@@ -240,6 +355,16 @@ type SubnetSpec struct {
 
 	// Tags is a collection of tags describing the resource.
 	Tags Tags `json:"tags,omitempty"`
+
+	// Tier is the subnet tier the subnet was carved for, see VPCSpec.SubnetTiers.
+	// +optional
+	Tier SubnetTier `json:"tier,omitempty"`
+
+	// Tenancy is the tenancy of the instances placed in the subnet which don't request one, as AWS
+	// doesn't support setting a tenancy on subnets. Can't be default in a VPC with dedicated tenancy.
+	// +kubebuilder:validation:Enum:=default;dedicated;host
+	// +optional
+	Tenancy string `json:"tenancy,omitempty"`
 }
 
 // String returns a string representation of the subnet.
@@ -293,9 +418,20 @@ func (s Subnets) FindEqual(spec *SubnetSpec) *SubnetSpec {
 }
 
 // FilterPrivate returns a slice containing all subnets marked as private.
+// Subnets of the pod and database tiers are dedicated to their purpose and are left out.
 func (s Subnets) FilterPrivate() (res Subnets) {
 	for _, x := range s {
-		if !x.IsPublic {
+		if !x.IsPublic && x.Tier != SubnetTierPod && x.Tier != SubnetTierDatabase {
+			res = append(res, x)
+		}
+	}
+	return
+}
+
+// ExcludeTier returns a slice containing all subnets not carved for the given tier.
+func (s Subnets) ExcludeTier(tier SubnetTier) (res Subnets) {
+	for _, x := range s {
+		if x.Tier != tier {
 			res = append(res, x)
 		}
 	}
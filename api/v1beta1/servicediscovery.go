@@ -0,0 +1,43 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Validate validates ServiceDiscovery fields.
+func (s *ServiceDiscovery) Validate() []*field.Error {
+	var errs field.ErrorList
+
+	if s == nil {
+		return errs
+	}
+
+	path := field.NewPath("spec", "serviceDiscovery", "namespaceName")
+
+	if s.NamespaceName == "" {
+		return append(errs, field.Required(path, "can't be empty"))
+	}
+
+	for _, msg := range validation.IsDNS1123Subdomain(s.NamespaceName) {
+		errs = append(errs, field.Invalid(path, s.NamespaceName, msg))
+	}
+
+	return errs
+}
@@ -0,0 +1,33 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// Checks returns true if the headroom of the given quota is checked.
+func (c *ServiceQuotaChecks) Checks(quota ServiceQuota) bool {
+	if c == nil {
+		return false
+	}
+	if len(c.Quotas) == 0 {
+		return true
+	}
+	for _, q := range c.Quotas {
+		if q == quota {
+			return true
+		}
+	}
+	return false
+}
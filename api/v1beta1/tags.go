@@ -19,6 +19,7 @@ package v1beta1
 import (
 	"fmt"
 	"regexp"
+	"sort"
 
 	"github.com/google/go-cmp/cmp"
 	"k8s.io/apimachinery/pkg/types"
@@ -75,6 +76,9 @@ func (t Tags) Merge(other Tags) {
 	}
 }
 
+// maxUserTagsAllowed defines the maximum number of user tags which can be created for a specific resource.
+const maxUserTagsAllowed = 50
+
 // Validate checks if tags are valid for the AWS API/Resources.
 // Keys must have at least 1 and max 128 characters.
 // Values must be max 256 characters long.
@@ -82,8 +86,37 @@ func (t Tags) Merge(other Tags) {
 // Tag's key cannot have prefix "aws:".
 // Max count of User tags for a specific resource can be 50.
 func (t Tags) Validate() []*field.Error {
-	// Defines the maximum number of user tags which can be created for a specific resource
-	const maxUserTagsAllowed = 50
+	return t.validate(field.NewPath("spec", "additionalTags"))
+}
+
+// ValidateCostAllocation checks if cost allocation tags are valid for the AWS API/Resources, like Validate does,
+// and that none of their keys are set in the additional tags, as both are added to the same resources.
+func (t Tags) ValidateCostAllocation(additional Tags) []*field.Error {
+	fldPath := field.NewPath("spec", "costAllocationTags")
+	errs := t.validate(fldPath)
+
+	keys := make([]string, 0, len(t))
+	for k := range t {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if _, ok := additional[k]; ok {
+			errs = append(errs, field.Forbidden(fldPath.Key(k), "key is also set in additionalTags"))
+		}
+	}
+
+	if len(t)+len(additional) > maxUserTagsAllowed {
+		errs = append(errs,
+			field.Invalid(fldPath, t, "user created tags cannot be more than 50 together with additionalTags"),
+		)
+	}
+
+	return errs
+}
+
+func (t Tags) validate(fldPath *field.Path) []*field.Error {
 	var errs field.ErrorList
 	var userTagCount = len(t)
 	re := regexp.MustCompile(`^[a-zA-Z0-9\\s\_\.\:\=\+\-\@\/]*$`)
@@ -91,41 +124,41 @@ func (t Tags) Validate() []*field.Error {
 	for k, v := range t {
 		if len(k) < 1 {
 			errs = append(errs,
-				field.Invalid(field.NewPath("spec", "additionalTags"), k, "key cannot be empty"),
+				field.Invalid(fldPath, k, "key cannot be empty"),
 			)
 		}
 		if len(k) > 128 {
 			errs = append(errs,
-				field.Invalid(field.NewPath("spec", "additionalTags"), k, "key cannot be longer than 128 characters"),
+				field.Invalid(fldPath, k, "key cannot be longer than 128 characters"),
 			)
 		}
 		if len(v) > 256 {
 			errs = append(errs,
-				field.Invalid(field.NewPath("spec", "additionalTags"), v, "value cannot be longer than 256 characters"),
+				field.Invalid(fldPath, v, "value cannot be longer than 256 characters"),
 			)
 		}
 		if wrongUserTagNomenclature(k) {
 			errs = append(errs,
-				field.Invalid(field.NewPath("spec", "additionalTags"), k, "user created tag's key cannot have prefix aws:"),
+				field.Invalid(fldPath, k, "user created tag's key cannot have prefix aws:"),
 			)
 		}
 		val := re.MatchString(k)
 		if !val {
 			errs = append(errs,
-				field.Invalid(field.NewPath("spec", "additionalTags"), k, "key cannot have characters other than alphabets, numbers, spaces and _ . : / = + - @ ."),
+				field.Invalid(fldPath, k, "key cannot have characters other than alphabets, numbers, spaces and _ . : / = + - @ ."),
 			)
 		}
 		val = re.MatchString(v)
 		if !val {
 			errs = append(errs,
-				field.Invalid(field.NewPath("spec", "additionalTags"), v, "value cannot have characters other than alphabets, numbers, spaces and _ . : / = + - @ ."),
+				field.Invalid(fldPath, v, "value cannot have characters other than alphabets, numbers, spaces and _ . : / = + - @ ."),
 			)
 		}
 	}
 
 	if userTagCount > maxUserTagsAllowed {
 		errs = append(errs,
-			field.Invalid(field.NewPath("spec", "additionalTags"), t, "user created tags cannot be more than 50"),
+			field.Invalid(fldPath, t, "user created tags cannot be more than 50"),
 		)
 	}
 
@@ -180,6 +213,9 @@ const (
 	// SecondarySubnetTagValue is the secondary subnet tag constant value.
 	SecondarySubnetTagValue = "secondary"
 
+	// NameAWSSubnetTier is the tag name we use to mark the tier of subnets carved by the provider.
+	NameAWSSubnetTier = NameAWSProviderPrefix + "subnet-tier"
+
 	// APIServerRoleTagValue describes the value for the apiserver role.
 	APIServerRoleTagValue = "apiserver"
 
@@ -195,8 +231,15 @@ const (
 	// PrivateRoleTagValue describes the value for the private role.
 	PrivateRoleTagValue = "private"
 
+	// BlackholeRoleTagValue describes the value for the blackhole role.
+	BlackholeRoleTagValue = "blackhole"
+
 	// MachineNameTagKey is the key for machine name.
 	MachineNameTagKey = "MachineName"
+
+	// CostAllocationTagsLastAppliedAnnotation is the key for the cluster object annotation
+	// which tracks the keys of the CostAllocationTags applied to the resources of the cluster.
+	CostAllocationTagsLastAppliedAnnotation = "sigs.k8s.io/cluster-api-provider-aws-last-applied-cost-allocation-tags"
 )
 
 // ClusterTagKey generates the key for resources associated with a cluster.
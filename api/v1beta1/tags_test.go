@@ -347,6 +347,69 @@ func TestTags_Validate(t *testing.T) {
 	}
 }
 
+func TestTags_ValidateCostAllocation(t *testing.T) {
+	tests := []struct {
+		name       string
+		self       Tags
+		additional Tags
+		expected   []*field.Error
+	}{
+		{
+			name: "no errors",
+			self: Tags{
+				"cost-center": "1234",
+			},
+			additional: Tags{
+				"environment": "production",
+			},
+			expected: nil,
+		},
+		{
+			name: "invalid tags are reported on cost allocation tags",
+			self: Tags{
+				"aws:cost-center": "1234",
+			},
+			expected: []*field.Error{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Detail:   "user created tag's key cannot have prefix aws:",
+					Field:    "spec.costAllocationTags",
+					BadValue: "aws:cost-center",
+				},
+			},
+		},
+		{
+			name: "key cannot be set in additional tags too",
+			self: Tags{
+				"cost-center": "1234",
+				"team":        "platform",
+			},
+			additional: Tags{
+				"cost-center": "5678",
+			},
+			expected: []*field.Error{
+				{
+					Type:     field.ErrorTypeForbidden,
+					Detail:   "key is also set in additionalTags",
+					Field:    "spec.costAllocationTags[cost-center]",
+					BadValue: "",
+				},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			out := tc.self.ValidateCostAllocation(tc.additional)
+			sort.Slice(out, getSortFieldErrorsFunc(out))
+			sort.Slice(tc.expected, getSortFieldErrorsFunc(tc.expected))
+
+			if !cmp.Equal(out, tc.expected) {
+				t.Errorf("expected %+v, got %+v", tc.expected, out)
+			}
+		})
+	}
+}
+
 func getSortFieldErrorsFunc(errs []*field.Error) func(i, j int) bool {
 	return func(i, j int) bool {
 		if errs[i].Detail != errs[j].Detail {
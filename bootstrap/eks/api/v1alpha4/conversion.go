@@ -54,6 +54,20 @@ func restoreSpec(rSpec, dSpec *v1beta1.EKSConfigSpec) {
 		}
 	}
 	dSpec.UseMaxPods = rSpec.UseMaxPods
+	dSpec.TopologyManagerPolicy = rSpec.TopologyManagerPolicy
+	dSpec.CPUManagerPolicy = rSpec.CPUManagerPolicy
+	dSpec.CNI = rSpec.CNI
+	dSpec.SSMAgent = rSpec.SSMAgent
+	dSpec.Journald = rSpec.Journald
+	dSpec.Auditd = rSpec.Auditd
+	dSpec.ImageCredentialProviders = rSpec.ImageCredentialProviders
+	dSpec.PrimaryInterfaceMTU = rSpec.PrimaryInterfaceMTU
+	dSpec.HostEntries = rSpec.HostEntries
+	dSpec.DNSSearchDomains = rSpec.DNSSearchDomains
+	dSpec.StartupGate = rSpec.StartupGate
+	dSpec.TimeSync = rSpec.TimeSync
+	dSpec.RegistryAuths = rSpec.RegistryAuths
+	dSpec.ReadOnlyRootFilesystem = rSpec.ReadOnlyRootFilesystem
 }
 
 // ConvertFrom converts the v1beta1 EKSConfig receiver to a v1alpha4 EKSConfig.
@@ -213,6 +213,20 @@ func autoConvert_v1beta1_EKSConfigSpec_To_v1alpha4_EKSConfigSpec(in *v1beta1.EKS
 	// WARNING: in.APIRetryAttempts requires manual conversion: does not exist in peer-type
 	// WARNING: in.PauseContainer requires manual conversion: does not exist in peer-type
 	// WARNING: in.UseMaxPods requires manual conversion: does not exist in peer-type
+	// WARNING: in.TopologyManagerPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.CPUManagerPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.CNI requires manual conversion: does not exist in peer-type
+	// WARNING: in.SSMAgent requires manual conversion: does not exist in peer-type
+	// WARNING: in.Journald requires manual conversion: does not exist in peer-type
+	// WARNING: in.Auditd requires manual conversion: does not exist in peer-type
+	// WARNING: in.ImageCredentialProviders requires manual conversion: does not exist in peer-type
+	// WARNING: in.PrimaryInterfaceMTU requires manual conversion: does not exist in peer-type
+	// WARNING: in.HostEntries requires manual conversion: does not exist in peer-type
+	// WARNING: in.DNSSearchDomains requires manual conversion: does not exist in peer-type
+	// WARNING: in.StartupGate requires manual conversion: does not exist in peer-type
+	// WARNING: in.TimeSync requires manual conversion: does not exist in peer-type
+	// WARNING: in.RegistryAuths requires manual conversion: does not exist in peer-type
+	// WARNING: in.ReadOnlyRootFilesystem requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -47,4 +47,8 @@ const (
 	// NOTE: This is a pre-condition for starting to create machines;
 	// the EKSConfig controller ensure this pre-condition is satisfied.
 	WaitingForControlPlaneInitializationReason = "WaitingForControlPlaneInitialization"
+
+	// IncompatibleAMIReason (Severity=Warning) documents a bootstrap secret that isn't generated because
+	// the AMI of the machine doesn't support the configuration, e.g. the read-only root filesystem.
+	IncompatibleAMIReason = "IncompatibleAMI"
 )
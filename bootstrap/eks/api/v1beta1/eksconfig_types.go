@@ -46,6 +46,69 @@ type EKSConfigSpec struct {
 	// UseMaxPods  sets --max-pods for the kubelet when true.
 	// +optional
 	UseMaxPods *bool `json:"useMaxPods,omitempty"`
+	// TopologyManagerPolicy sets --topology-manager-policy for the kubelet. This is useful for
+	// NUMA-sensitive workloads that require CPU and device resources to be aligned.
+	// +optional
+	TopologyManagerPolicy *TopologyManagerPolicy `json:"topologyManagerPolicy,omitempty"`
+	// CPUManagerPolicy sets --cpu-manager-policy for the kubelet.
+	// +optional
+	CPUManagerPolicy *CPUManagerPolicy `json:"cpuManagerPolicy,omitempty"`
+	// CNI configures the directories used for the container network interface plugins.
+	// It requires the containerRuntime to be set to dockerd or containerd.
+	// +optional
+	CNI *CNI `json:"cni,omitempty"`
+	// SSMAgent installs a pinned version of the AWS Systems Manager agent on the node,
+	// replacing the version shipped with the AMI.
+	// +optional
+	SSMAgent *SSMAgent `json:"ssmAgent,omitempty"`
+	// Journald configures the log retention and rate limits of the systemd journal on the node.
+	// +optional
+	Journald *Journald `json:"journald,omitempty"`
+	// Auditd configures the audit rules of the node and forwarding of the audit log.
+	// +optional
+	Auditd *Auditd `json:"auditd,omitempty"`
+	// ImageCredentialProviders configures the kubelet to get image registry credentials from
+	// exec plugins, e.g. for ECR registries in other accounts or GCR.
+	// +optional
+	ImageCredentialProviders *ImageCredentialProviders `json:"imageCredentialProviders,omitempty"`
+	// PrimaryInterfaceMTU sets the MTU of the primary network interface of the node, e.g. to
+	// match the pod MTU of the VPC CNI when using jumbo frames or a VPN overlay. The MTU is
+	// persisted in the network configuration of the node so it survives reboots.
+	// +kubebuilder:validation:Minimum=576
+	// +kubebuilder:validation:Maximum=9001
+	// +optional
+	PrimaryInterfaceMTU *int `json:"primaryInterfaceMTU,omitempty"`
+	// HostEntries are appended to /etc/hosts on the node, e.g. to resolve on-premises
+	// services that aren't in DNS.
+	// +optional
+	HostEntries []HostEntry `json:"hostEntries,omitempty"`
+	// DNSSearchDomains are added to the DNS search domains of the node. They're configured
+	// through systemd-resolved when it's running and through resolv.conf and dhclient otherwise.
+	// +optional
+	DNSSearchDomains []DomainName `json:"dnsSearchDomains,omitempty"`
+	// StartupGate holds back the kubelet, and so the registration of the node, until a health
+	// check passes, so no pods are scheduled before custom node initialization has completed.
+	// +optional
+	StartupGate *StartupGate `json:"startupGate,omitempty"`
+	// TimeSync sets the timezone and the NTP servers of the node, e.g. to correlate the logs of
+	// the nodes with the ones of other systems.
+	// +optional
+	TimeSync *TimeSync `json:"timeSync,omitempty"`
+	// RegistryAuths configure containerd to authenticate to private registries without a kubelet
+	// image credential provider. Only applicable to the containerd runtime.
+	// +listType=map
+	// +listMapKey=registry
+	// +optional
+	RegistryAuths []RegistryAuth `json:"registryAuths,omitempty"`
+	// ReadOnlyRootFilesystem remounts the root filesystem of the node read-only, keeping the paths
+	// the kubelet and the container runtime write to writable with overlays backed by memory. The
+	// writes are lost on reboot. The node reboots once bootstrapped for the setting to take effect,
+	// the configuration written by the bootstrap, e.g. the primary interface MTU, is kept as it's
+	// written to the root filesystem before. Only supported by Amazon Linux 2 AMIs: the bootstrap
+	// data isn't generated when the machine looks up another AMI, and the bootstrap fails on other
+	// AMIs set by ID.
+	// +optional
+	ReadOnlyRootFilesystem *ReadOnlyRootFilesystem `json:"readOnlyRootFilesystem,omitempty"`
 
 	// TODO(richardcase): this can be uncommented when we get to the ipv6/dual-stack implementation
 	// ServiceIPV6Cidr is the ipv6 cidr range of the cluster. If this is specified then
@@ -54,6 +117,238 @@ type EKSConfigSpec struct {
 	// ServiceIPV6Cidr *string `json:"serviceIPV6Cidr,omitempty"`
 }
 
+// TopologyManagerPolicy defines the kubelet topology manager policy.
+// +kubebuilder:validation:Enum=none;best-effort;restricted;single-numa-node
+type TopologyManagerPolicy string
+
+const (
+	// TopologyManagerPolicyNone performs no topology alignment.
+	TopologyManagerPolicyNone = TopologyManagerPolicy("none")
+	// TopologyManagerPolicyBestEffort prefers NUMA aligned resources but admits pods regardless.
+	TopologyManagerPolicyBestEffort = TopologyManagerPolicy("best-effort")
+	// TopologyManagerPolicyRestricted rejects pods for which a preferred NUMA alignment can't be found.
+	TopologyManagerPolicyRestricted = TopologyManagerPolicy("restricted")
+	// TopologyManagerPolicySingleNUMANode rejects pods that can't be placed on a single NUMA node.
+	TopologyManagerPolicySingleNUMANode = TopologyManagerPolicy("single-numa-node")
+)
+
+// CPUManagerPolicy defines the kubelet CPU manager policy.
+// +kubebuilder:validation:Enum=none;static
+type CPUManagerPolicy string
+
+const (
+	// CPUManagerPolicyNone uses the default CFS quota based CPU affinity.
+	CPUManagerPolicyNone = CPUManagerPolicy("none")
+	// CPUManagerPolicyStatic grants exclusive CPUs to Guaranteed pods with integer CPU requests.
+	CPUManagerPolicyStatic = CPUManagerPolicy("static")
+)
+
+// CNI defines the directories the container runtime and kubelet use for the CNI plugins.
+type CNI struct {
+	// BinDir is the absolute path of the directory containing the CNI plugin binaries.
+	// +kubebuilder:validation:Pattern=`^/[A-Za-z0-9._/-]*$`
+	// +optional
+	BinDir string `json:"binDir,omitempty"`
+	// ConfDir is the absolute path of the directory containing the CNI network configuration.
+	// +kubebuilder:validation:Pattern=`^/[A-Za-z0-9._/-]*$`
+	// +optional
+	ConfDir string `json:"confDir,omitempty"`
+}
+
+// SSMAgent defines the version of the AWS Systems Manager agent to install.
+type SSMAgent struct {
+	// Version is the version of the agent to install, e.g. 3.1.1446.0, or latest.
+	// +kubebuilder:validation:Pattern=`^(latest|[0-9]+(\.[0-9]+){3})$`
+	Version string `json:"version"`
+}
+
+// Journald defines the systemd journal settings written to a journald.conf drop-in.
+type Journald struct {
+	// MaxUse is the maximum disk space the journal may use, e.g. 500M or 2G.
+	// +kubebuilder:validation:Pattern=`^[0-9]+[KMGT]?$`
+	// +optional
+	MaxUse string `json:"maxUse,omitempty"`
+	// MaxFileSize is the maximum size of an individual journal file, e.g. 50M.
+	// +kubebuilder:validation:Pattern=`^[0-9]+[KMGT]?$`
+	// +optional
+	MaxFileSize string `json:"maxFileSize,omitempty"`
+	// RateLimitIntervalSec is the interval, in seconds, over which the rate limit is applied.
+	// A value of 0 disables rate limiting.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	RateLimitIntervalSec *int32 `json:"rateLimitIntervalSec,omitempty"`
+	// RateLimitBurst is the number of messages a service may log within the interval
+	// before further messages are dropped. A value of 0 disables rate limiting.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	RateLimitBurst *int32 `json:"rateLimitBurst,omitempty"`
+}
+
+// AuditRule is a rule in auditctl syntax, e.g. "-w /etc/kubernetes/ -p wa -k kubernetes".
+// +kubebuilder:validation:Pattern=`^-[^\n]+$`
+type AuditRule string
+
+// Auditd defines the audit rules loaded on the node and where the audit log is forwarded to.
+type Auditd struct {
+	// Rules are written to /etc/audit/rules.d and loaded in order.
+	// +optional
+	Rules []AuditRule `json:"rules,omitempty"`
+	// Syslog forwards the audit log to a remote syslog server, such as a SIEM collector.
+	// +optional
+	Syslog *SyslogForwarder `json:"syslog,omitempty"`
+}
+
+// SyslogForwarder defines the remote syslog server the audit log is forwarded to.
+type SyslogForwarder struct {
+	// Address is the hostname or IP address of the syslog server.
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9.-]+$`
+	Address string `json:"address"`
+	// Port is the port of the syslog server.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +kubebuilder:default=514
+	// +optional
+	Port int32 `json:"port,omitempty"`
+	// Protocol is the transport used to reach the syslog server.
+	// +kubebuilder:validation:Enum=tcp;udp
+	// +kubebuilder:default=tcp
+	// +optional
+	Protocol string `json:"protocol,omitempty"`
+}
+
+// ImageCredentialProviders defines the kubelet CredentialProviderConfig and where the provider binaries live.
+type ImageCredentialProviders struct {
+	// BinDir is the absolute path of the directory containing the provider binaries.
+	// Defaults to /etc/eks/image-credential-provider, where the Amazon EKS AMI ships the ecr-credential-provider.
+	// +kubebuilder:validation:Pattern=`^/[A-Za-z0-9._/-]*$`
+	// +optional
+	BinDir string `json:"binDir,omitempty"`
+	// Providers is the list of credential provider plugins the kubelet may invoke.
+	// +kubebuilder:validation:MinItems=1
+	Providers []ImageCredentialProvider `json:"providers"`
+}
+
+// ImageCredentialProvider defines a credential provider plugin invoked by the kubelet.
+type ImageCredentialProvider struct {
+	// Name is the name of the provider binary in the bin directory.
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9._-]+$`
+	Name string `json:"name"`
+	// MatchImages are the image patterns the provider is invoked for, e.g. "*.dkr.ecr.*.amazonaws.com" or "gcr.io".
+	// +kubebuilder:validation:MinItems=1
+	MatchImages []string `json:"matchImages"`
+	// DefaultCacheDuration is how long the kubelet caches credentials when the plugin response doesn't set a duration.
+	// Defaults to 12h.
+	// +optional
+	DefaultCacheDuration *metav1.Duration `json:"defaultCacheDuration,omitempty"`
+	// APIVersion is the version of the CredentialProviderRequest/Response API the plugin speaks.
+	// +kubebuilder:validation:Enum=credentialprovider.kubelet.k8s.io/v1alpha1;credentialprovider.kubelet.k8s.io/v1beta1;credentialprovider.kubelet.k8s.io/v1
+	// +kubebuilder:default=credentialprovider.kubelet.k8s.io/v1beta1
+	// +optional
+	APIVersion string `json:"apiVersion,omitempty"`
+	// Args are the arguments passed to the plugin.
+	// +optional
+	Args []string `json:"args,omitempty"`
+	// Env are the environment variables set for the plugin.
+	// +optional
+	Env []ImageCredentialProviderEnv `json:"env,omitempty"`
+	// BinaryURL is the https URL the provider binary is downloaded from. When unset the binary
+	// must already be present in the bin directory of the AMI.
+	// +kubebuilder:validation:Pattern=`^https://[^\s'"]+$`
+	// +optional
+	BinaryURL string `json:"binaryURL,omitempty"`
+}
+
+// ImageCredentialProviderEnv is an environment variable set for a credential provider plugin.
+type ImageCredentialProviderEnv struct {
+	// Name of the environment variable.
+	Name string `json:"name"`
+	// Value of the environment variable.
+	Value string `json:"value"`
+}
+
+// StartupGate defines the health check the kubelet waits for before it starts.
+type StartupGate struct {
+	// Command is the shell command run until it exits with 0, e.g. "test -f /var/run/node-init-done".
+	// It can't depend on pods running on the node since the node isn't registered yet.
+	// +kubebuilder:validation:MinLength=1
+	Command string `json:"command"`
+	// TimeoutSeconds is how long the health check is retried before the kubelet start fails. systemd
+	// restarts the kubelet afterwards, which runs the health check again.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=600
+	// +optional
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+	// IntervalSeconds is the time between two runs of the health check.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=10
+	// +optional
+	IntervalSeconds int32 `json:"intervalSeconds,omitempty"`
+}
+
+// TimeSync defines the clock settings of the node.
+type TimeSync struct {
+	// Timezone is the IANA timezone of the node, e.g. "Europe/Berlin". Defaults to UTC.
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_+-]+(/[A-Za-z0-9_+-]+)*$`
+	// +kubebuilder:default=UTC
+	// +optional
+	Timezone string `json:"timezone,omitempty"`
+	// NTPServers are the NTP servers chrony synchronizes the clock with, replacing the ones of the
+	// AMI. Defaults to the Amazon Time Sync service.
+	// +optional
+	NTPServers []NTPServer `json:"ntpServers,omitempty"`
+}
+
+// RegistryAuth defines the credentials of a private registry.
+type RegistryAuth struct {
+	// Registry is the host, and optionally the port, of the registry, e.g. "registry.example.com:5000".
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9.-]+(:[0-9]+)?$`
+	Registry string `json:"registry"`
+	// SecretARN is the ARN of the AWS Secrets Manager secret holding the credentials of the registry,
+	// a JSON object with the username and password keys. The node fetches the secret when it boots so
+	// the credentials aren't written to the user data, which requires its instance profile to allow
+	// secretsmanager:GetSecretValue on the secret.
+	// +kubebuilder:validation:Pattern=`^arn:[a-z-]+:secretsmanager:[a-z0-9-]+:[0-9]{12}:secret:[A-Za-z0-9/_+=.@-]+$`
+	SecretARN string `json:"secretARN"`
+}
+
+// ReadOnlyRootFilesystem defines the writable overlays of a read-only root filesystem.
+type ReadOnlyRootFilesystem struct {
+	// WritablePaths are directories kept writable in addition to /etc, /var, /tmp, /root, /home and
+	// /opt/cni, which are required by the kubelet, the container runtime and the VPC CNI.
+	// +optional
+	WritablePaths []WritablePath `json:"writablePaths,omitempty"`
+	// OverlaySize is the size of the tmpfs holding the writes to the writable paths and the state of
+	// the container runtime, including the images, e.g. "8G" or "50%". Defaults to half of the memory.
+	// +kubebuilder:validation:Pattern=`^[0-9]+[kKmMgG%]?$`
+	// +optional
+	OverlaySize string `json:"overlaySize,omitempty"`
+}
+
+// WritablePath is the absolute path of a directory, e.g. "/opt/agent".
+// +kubebuilder:validation:Pattern=`^(/[A-Za-z0-9_.-]+)+$`
+// +kubebuilder:validation:MaxLength=4096
+type WritablePath string
+
+// NTPServer is the hostname or IP address of an NTP server.
+// +kubebuilder:validation:Pattern=`^[0-9A-Za-z.:-]+$`
+// +kubebuilder:validation:MaxLength=253
+type NTPServer string
+
+// DomainName is a DNS name, e.g. "corp.example.com".
+// +kubebuilder:validation:Pattern=`^[A-Za-z0-9]([A-Za-z0-9-]*[A-Za-z0-9])?(\.[A-Za-z0-9]([A-Za-z0-9-]*[A-Za-z0-9])?)*$`
+// +kubebuilder:validation:MaxLength=253
+type DomainName string
+
+// HostEntry defines a line of /etc/hosts.
+type HostEntry struct {
+	// IP is the IPv4 or IPv6 address the hostnames resolve to.
+	// +kubebuilder:validation:Pattern=`^[0-9A-Fa-f.:]+$`
+	IP string `json:"ip"`
+	// Hostnames resolve to the IP address, the first one being the canonical name.
+	// +kubebuilder:validation:MinItems=1
+	Hostnames []DomainName `json:"hostnames"`
+}
+
 // PauseContainer contains details of pause container.
 type PauseContainer struct {
 	//  AccountNumber is the AWS account number to pull the pause container from.
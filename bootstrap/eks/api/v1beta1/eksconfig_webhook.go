@@ -17,7 +17,11 @@ limitations under the License.
 package v1beta1
 
 import (
+	"net"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 )
@@ -37,12 +41,44 @@ var _ webhook.Validator = &EKSConfig{}
 
 // ValidateCreate will do any extra validation when creating a EKSConfig.
 func (r *EKSConfig) ValidateCreate() error {
-	return nil
+	return r.validate()
 }
 
 // ValidateUpdate will do any extra validation when updating a EKSConfig.
 func (r *EKSConfig) ValidateUpdate(old runtime.Object) error {
-	return nil
+	return r.validate()
+}
+
+func (r *EKSConfig) validate() error {
+	allErrs := r.Spec.validate(field.NewPath("spec"))
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(GroupVersion.WithKind("EKSConfig").GroupKind(), r.Name, allErrs)
+}
+
+func (s *EKSConfigSpec) validate(path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, entry := range s.HostEntries {
+		if net.ParseIP(entry.IP) == nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("hostEntries").Index(i).Child("ip"), entry.IP, "must be a valid IPv4 or IPv6 address"))
+		}
+	}
+
+	// The CNI directories are passed to the kubelet with dockerd and set in the containerd configuration
+	// with containerd. The runtime defaulted by the bootstrap script depends on the Kubernetes version.
+	if s.CNI != nil {
+		switch {
+		case s.ContainerRuntime == nil:
+			allErrs = append(allErrs, field.Required(path.Child("containerRuntime"), "must be set when cni is set"))
+		case *s.ContainerRuntime != "dockerd" && *s.ContainerRuntime != "containerd":
+			allErrs = append(allErrs, field.NotSupported(path.Child("containerRuntime"), *s.ContainerRuntime, []string{"dockerd", "containerd"}))
+		}
+	}
+
+	return allErrs
 }
 
 // ValidateDelete allows you to add any extra validation when deleting.
@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/pointer"
+)
+
+func TestEKSConfigValidateHostEntries(t *testing.T) {
+	tests := []struct {
+		name        string
+		hostEntries []HostEntry
+		expectError bool
+	}{
+		{
+			name: "IPv4 and IPv6 addresses are accepted",
+			hostEntries: []HostEntry{
+				{IP: "10.0.0.1", Hostnames: []DomainName{"registry.example.com"}},
+				{IP: "fd00::1", Hostnames: []DomainName{"git.example.com"}},
+			},
+		},
+		{
+			name: "invalid address is rejected",
+			hostEntries: []HostEntry{
+				{IP: "10.0.0.256", Hostnames: []DomainName{"registry.example.com"}},
+			},
+			expectError: true,
+		},
+		{
+			name: "malformed IPv6 address is rejected",
+			hostEntries: []HostEntry{
+				{IP: "fd00:::1", Hostnames: []DomainName{"registry.example.com"}},
+			},
+			expectError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			config := &EKSConfig{Spec: EKSConfigSpec{HostEntries: tt.hostEntries}}
+			template := &EKSConfigTemplate{Spec: EKSConfigTemplateSpec{Template: EKSConfigTemplateResource{Spec: config.Spec}}}
+			for _, err := range []error{config.ValidateCreate(), config.ValidateUpdate(config.DeepCopy()), template.ValidateCreate(), template.ValidateUpdate(template.DeepCopy())} {
+				if tt.expectError {
+					g.Expect(err).To(HaveOccurred())
+				} else {
+					g.Expect(err).NotTo(HaveOccurred())
+				}
+			}
+		})
+	}
+}
+
+func TestEKSConfigValidateCNI(t *testing.T) {
+	tests := []struct {
+		name             string
+		containerRuntime *string
+		cni              *CNI
+		expectError      bool
+	}{
+		{
+			name:             "cni with dockerd is accepted",
+			containerRuntime: pointer.String("dockerd"),
+			cni:              &CNI{BinDir: "/opt/cni/bin"},
+		},
+		{
+			name:             "cni with containerd is accepted",
+			containerRuntime: pointer.String("containerd"),
+			cni:              &CNI{ConfDir: "/etc/cni/net.d"},
+		},
+		{
+			name:        "cni without a container runtime is rejected",
+			cni:         &CNI{BinDir: "/opt/cni/bin"},
+			expectError: true,
+		},
+		{
+			name:             "cni with an unknown container runtime is rejected",
+			containerRuntime: pointer.String("cri-o"),
+			cni:              &CNI{BinDir: "/opt/cni/bin"},
+			expectError:      true,
+		},
+		{
+			name: "no cni without a container runtime is accepted",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			config := &EKSConfig{Spec: EKSConfigSpec{ContainerRuntime: tt.containerRuntime, CNI: tt.cni}}
+			template := &EKSConfigTemplate{Spec: EKSConfigTemplateSpec{Template: EKSConfigTemplateResource{Spec: config.Spec}}}
+			for _, err := range []error{config.ValidateCreate(), config.ValidateUpdate(config.DeepCopy()), template.ValidateCreate(), template.ValidateUpdate(template.DeepCopy())} {
+				if tt.expectError {
+					g.Expect(err).To(HaveOccurred())
+				} else {
+					g.Expect(err).NotTo(HaveOccurred())
+				}
+			}
+		})
+	}
+}
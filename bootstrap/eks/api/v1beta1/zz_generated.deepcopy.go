@@ -22,10 +22,51 @@ limitations under the License.
 package v1beta1
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	apiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Auditd) DeepCopyInto(out *Auditd) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]AuditRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.Syslog != nil {
+		in, out := &in.Syslog, &out.Syslog
+		*out = new(SyslogForwarder)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Auditd.
+func (in *Auditd) DeepCopy() *Auditd {
+	if in == nil {
+		return nil
+	}
+	out := new(Auditd)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CNI) DeepCopyInto(out *CNI) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CNI.
+func (in *CNI) DeepCopy() *CNI {
+	if in == nil {
+		return nil
+	}
+	out := new(CNI)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EKSConfig) DeepCopyInto(out *EKSConfig) {
 	*out = *in
@@ -125,6 +166,78 @@ func (in *EKSConfigSpec) DeepCopyInto(out *EKSConfigSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.TopologyManagerPolicy != nil {
+		in, out := &in.TopologyManagerPolicy, &out.TopologyManagerPolicy
+		*out = new(TopologyManagerPolicy)
+		**out = **in
+	}
+	if in.CPUManagerPolicy != nil {
+		in, out := &in.CPUManagerPolicy, &out.CPUManagerPolicy
+		*out = new(CPUManagerPolicy)
+		**out = **in
+	}
+	if in.CNI != nil {
+		in, out := &in.CNI, &out.CNI
+		*out = new(CNI)
+		**out = **in
+	}
+	if in.SSMAgent != nil {
+		in, out := &in.SSMAgent, &out.SSMAgent
+		*out = new(SSMAgent)
+		**out = **in
+	}
+	if in.Journald != nil {
+		in, out := &in.Journald, &out.Journald
+		*out = new(Journald)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Auditd != nil {
+		in, out := &in.Auditd, &out.Auditd
+		*out = new(Auditd)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageCredentialProviders != nil {
+		in, out := &in.ImageCredentialProviders, &out.ImageCredentialProviders
+		*out = new(ImageCredentialProviders)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrimaryInterfaceMTU != nil {
+		in, out := &in.PrimaryInterfaceMTU, &out.PrimaryInterfaceMTU
+		*out = new(int)
+		**out = **in
+	}
+	if in.HostEntries != nil {
+		in, out := &in.HostEntries, &out.HostEntries
+		*out = make([]HostEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DNSSearchDomains != nil {
+		in, out := &in.DNSSearchDomains, &out.DNSSearchDomains
+		*out = make([]DomainName, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartupGate != nil {
+		in, out := &in.StartupGate, &out.StartupGate
+		*out = new(StartupGate)
+		**out = **in
+	}
+	if in.TimeSync != nil {
+		in, out := &in.TimeSync, &out.TimeSync
+		*out = new(TimeSync)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RegistryAuths != nil {
+		in, out := &in.RegistryAuths, &out.RegistryAuths
+		*out = make([]RegistryAuth, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReadOnlyRootFilesystem != nil {
+		in, out := &in.ReadOnlyRootFilesystem, &out.ReadOnlyRootFilesystem
+		*out = new(ReadOnlyRootFilesystem)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSConfigSpec.
@@ -254,6 +367,123 @@ func (in *EKSConfigTemplateSpec) DeepCopy() *EKSConfigTemplateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostEntry) DeepCopyInto(out *HostEntry) {
+	*out = *in
+	if in.Hostnames != nil {
+		in, out := &in.Hostnames, &out.Hostnames
+		*out = make([]DomainName, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostEntry.
+func (in *HostEntry) DeepCopy() *HostEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(HostEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCredentialProvider) DeepCopyInto(out *ImageCredentialProvider) {
+	*out = *in
+	if in.MatchImages != nil {
+		in, out := &in.MatchImages, &out.MatchImages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DefaultCacheDuration != nil {
+		in, out := &in.DefaultCacheDuration, &out.DefaultCacheDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]ImageCredentialProviderEnv, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCredentialProvider.
+func (in *ImageCredentialProvider) DeepCopy() *ImageCredentialProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCredentialProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCredentialProviderEnv) DeepCopyInto(out *ImageCredentialProviderEnv) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCredentialProviderEnv.
+func (in *ImageCredentialProviderEnv) DeepCopy() *ImageCredentialProviderEnv {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCredentialProviderEnv)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCredentialProviders) DeepCopyInto(out *ImageCredentialProviders) {
+	*out = *in
+	if in.Providers != nil {
+		in, out := &in.Providers, &out.Providers
+		*out = make([]ImageCredentialProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCredentialProviders.
+func (in *ImageCredentialProviders) DeepCopy() *ImageCredentialProviders {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCredentialProviders)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Journald) DeepCopyInto(out *Journald) {
+	*out = *in
+	if in.RateLimitIntervalSec != nil {
+		in, out := &in.RateLimitIntervalSec, &out.RateLimitIntervalSec
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RateLimitBurst != nil {
+		in, out := &in.RateLimitBurst, &out.RateLimitBurst
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Journald.
+func (in *Journald) DeepCopy() *Journald {
+	if in == nil {
+		return nil
+	}
+	out := new(Journald)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PauseContainer) DeepCopyInto(out *PauseContainer) {
 	*out = *in
@@ -268,3 +498,103 @@ func (in *PauseContainer) DeepCopy() *PauseContainer {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReadOnlyRootFilesystem) DeepCopyInto(out *ReadOnlyRootFilesystem) {
+	*out = *in
+	if in.WritablePaths != nil {
+		in, out := &in.WritablePaths, &out.WritablePaths
+		*out = make([]WritablePath, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadOnlyRootFilesystem.
+func (in *ReadOnlyRootFilesystem) DeepCopy() *ReadOnlyRootFilesystem {
+	if in == nil {
+		return nil
+	}
+	out := new(ReadOnlyRootFilesystem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryAuth) DeepCopyInto(out *RegistryAuth) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryAuth.
+func (in *RegistryAuth) DeepCopy() *RegistryAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSMAgent) DeepCopyInto(out *SSMAgent) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSMAgent.
+func (in *SSMAgent) DeepCopy() *SSMAgent {
+	if in == nil {
+		return nil
+	}
+	out := new(SSMAgent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StartupGate) DeepCopyInto(out *StartupGate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StartupGate.
+func (in *StartupGate) DeepCopy() *StartupGate {
+	if in == nil {
+		return nil
+	}
+	out := new(StartupGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyslogForwarder) DeepCopyInto(out *SyslogForwarder) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyslogForwarder.
+func (in *SyslogForwarder) DeepCopy() *SyslogForwarder {
+	if in == nil {
+		return nil
+	}
+	out := new(SyslogForwarder)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeSync) DeepCopyInto(out *TimeSync) {
+	*out = *in
+	if in.NTPServers != nil {
+		in, out := &in.NTPServers, &out.NTPServers
+		*out = make([]NTPServer, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeSync.
+func (in *TimeSync) DeepCopy() *TimeSync {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeSync)
+	in.DeepCopyInto(out)
+	return out
+}
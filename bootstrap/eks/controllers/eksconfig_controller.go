@@ -21,10 +21,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/pointer"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,9 +35,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
 	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/bootstrap/eks/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-aws/bootstrap/eks/internal/userdata"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1beta1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	bsutil "sigs.k8s.io/cluster-api/bootstrap/util"
 	expclusterv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
@@ -47,6 +51,13 @@ import (
 	"sigs.k8s.io/cluster-api/util/predicates"
 )
 
+const (
+	// amazonLinux2BaseOS is the base OS of the Amazon Linux 2 AMIs looked up by name.
+	amazonLinux2BaseOS = "amazon-2"
+	// defaultAMILookupBaseOS is the base OS of the AMIs looked up by name when none is set.
+	defaultAMILookupBaseOS = "ubuntu-18.04"
+)
+
 // EKSConfigReconciler reconciles a EKSConfig object.
 type EKSConfigReconciler struct {
 	client.Client
@@ -60,6 +71,7 @@ type EKSConfigReconciler struct {
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines;machinepools;clusters,verbs=get;list;watch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinepools,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;delete;
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines;awsmachinepools,verbs=get;list;watch
 
 func (r *EKSConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, rerr error) {
 	log := ctrl.LoggerFrom(ctx)
@@ -188,18 +200,123 @@ func (r *EKSConfigReconciler) joinWorker(ctx context.Context, cluster *clusterv1
 
 	nodeInput := &userdata.NodeInput{
 		// AWSManagedControlPlane webhooks default and validate EKSClusterName
-		ClusterName:      controlPlane.Spec.EKSClusterName,
-		KubeletExtraArgs: config.Spec.KubeletExtraArgs,
-		ContainerRuntime: config.Spec.ContainerRuntime,
-		DNSClusterIP:     config.Spec.DNSClusterIP,
-		DockerConfigJSON: config.Spec.DockerConfigJSON,
-		APIRetryAttempts: config.Spec.APIRetryAttempts,
-		UseMaxPods:       config.Spec.UseMaxPods,
+		ClusterName:         controlPlane.Spec.EKSClusterName,
+		KubeletExtraArgs:    config.Spec.KubeletExtraArgs,
+		ContainerRuntime:    config.Spec.ContainerRuntime,
+		DNSClusterIP:        config.Spec.DNSClusterIP,
+		DockerConfigJSON:    config.Spec.DockerConfigJSON,
+		APIRetryAttempts:    config.Spec.APIRetryAttempts,
+		UseMaxPods:          config.Spec.UseMaxPods,
+		PrimaryInterfaceMTU: config.Spec.PrimaryInterfaceMTU,
 	}
 	if config.Spec.PauseContainer != nil {
 		nodeInput.PauseContainerAccount = &config.Spec.PauseContainer.AccountNumber
 		nodeInput.PauseContainerVersion = &config.Spec.PauseContainer.Version
 	}
+	if config.Spec.TopologyManagerPolicy != nil {
+		nodeInput.TopologyManagerPolicy = pointer.String(string(*config.Spec.TopologyManagerPolicy))
+	}
+	if config.Spec.CPUManagerPolicy != nil {
+		nodeInput.CPUManagerPolicy = pointer.String(string(*config.Spec.CPUManagerPolicy))
+	}
+	if config.Spec.CNI != nil {
+		if config.Spec.CNI.BinDir != "" {
+			nodeInput.CNIBinDir = pointer.String(config.Spec.CNI.BinDir)
+		}
+		if config.Spec.CNI.ConfDir != "" {
+			nodeInput.CNIConfDir = pointer.String(config.Spec.CNI.ConfDir)
+		}
+	}
+	if config.Spec.SSMAgent != nil {
+		nodeInput.SSMAgentVersion = pointer.String(config.Spec.SSMAgent.Version)
+	}
+	if config.Spec.Journald != nil {
+		if config.Spec.Journald.MaxUse != "" {
+			nodeInput.JournaldMaxUse = pointer.String(config.Spec.Journald.MaxUse)
+		}
+		if config.Spec.Journald.MaxFileSize != "" {
+			nodeInput.JournaldMaxFileSize = pointer.String(config.Spec.Journald.MaxFileSize)
+		}
+		nodeInput.JournaldRateLimitIntervalSec = config.Spec.Journald.RateLimitIntervalSec
+		nodeInput.JournaldRateLimitBurst = config.Spec.Journald.RateLimitBurst
+	}
+	if config.Spec.Auditd != nil {
+		for _, rule := range config.Spec.Auditd.Rules {
+			nodeInput.AuditdRules = append(nodeInput.AuditdRules, string(rule))
+		}
+		if config.Spec.Auditd.Syslog != nil {
+			nodeInput.AuditdSyslogAddress = pointer.String(config.Spec.Auditd.Syslog.Address)
+			nodeInput.AuditdSyslogPort = config.Spec.Auditd.Syslog.Port
+			nodeInput.AuditdSyslogProtocol = config.Spec.Auditd.Syslog.Protocol
+		}
+	}
+	if config.Spec.ImageCredentialProviders != nil {
+		if config.Spec.ImageCredentialProviders.BinDir != "" {
+			nodeInput.CredentialProviderBinDir = pointer.String(config.Spec.ImageCredentialProviders.BinDir)
+		}
+		for _, provider := range config.Spec.ImageCredentialProviders.Providers {
+			credentialProvider := userdata.CredentialProvider{
+				Name:        provider.Name,
+				MatchImages: provider.MatchImages,
+				APIVersion:  provider.APIVersion,
+				Args:        provider.Args,
+				BinaryURL:   provider.BinaryURL,
+			}
+			if provider.DefaultCacheDuration != nil {
+				credentialProvider.DefaultCacheDuration = provider.DefaultCacheDuration.Duration.String()
+			}
+			for _, env := range provider.Env {
+				credentialProvider.Env = append(credentialProvider.Env, userdata.CredentialProviderEnv{Name: env.Name, Value: env.Value})
+			}
+			nodeInput.CredentialProviders = append(nodeInput.CredentialProviders, credentialProvider)
+		}
+	}
+	for _, entry := range config.Spec.HostEntries {
+		hostEntry := userdata.HostEntry{IP: entry.IP}
+		for _, hostname := range entry.Hostnames {
+			hostEntry.Hostnames = append(hostEntry.Hostnames, string(hostname))
+		}
+		nodeInput.HostEntries = append(nodeInput.HostEntries, hostEntry)
+	}
+	for _, domain := range config.Spec.DNSSearchDomains {
+		nodeInput.DNSSearchDomains = append(nodeInput.DNSSearchDomains, string(domain))
+	}
+	if config.Spec.StartupGate != nil {
+		nodeInput.StartupGateCommand = pointer.String(config.Spec.StartupGate.Command)
+		nodeInput.StartupGateTimeoutSeconds = config.Spec.StartupGate.TimeoutSeconds
+		nodeInput.StartupGateIntervalSeconds = config.Spec.StartupGate.IntervalSeconds
+	}
+	if config.Spec.TimeSync != nil {
+		nodeInput.Timezone = pointer.String(config.Spec.TimeSync.Timezone)
+		for _, server := range config.Spec.TimeSync.NTPServers {
+			nodeInput.NTPServers = append(nodeInput.NTPServers, string(server))
+		}
+	}
+	if config.Spec.ReadOnlyRootFilesystem != nil {
+		incompatibility, err := r.readOnlyRootIncompatibleAMI(ctx, config, controlPlane)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if incompatibility != "" {
+			log.Info("Not generating userdata for an incompatible AMI", "reason", incompatibility)
+			conditions.MarkFalse(config, eksbootstrapv1.DataSecretAvailableCondition, eksbootstrapv1.IncompatibleAMIReason, clusterv1.ConditionSeverityWarning, incompatibility)
+			return ctrl.Result{}, nil
+		}
+		nodeInput.ReadOnlyRootFilesystem = true
+		for _, path := range config.Spec.ReadOnlyRootFilesystem.WritablePaths {
+			nodeInput.ReadOnlyRootWritablePaths = append(nodeInput.ReadOnlyRootWritablePaths, string(path))
+		}
+		nodeInput.ReadOnlyRootOverlaySize = config.Spec.ReadOnlyRootFilesystem.OverlaySize
+	}
+	if len(config.Spec.RegistryAuths) > 0 {
+		registryAuths, err := r.registryAuths(config)
+		if err != nil {
+			log.Error(err, "Failed to configure the registry credentials")
+			conditions.MarkFalse(config, eksbootstrapv1.DataSecretAvailableCondition, eksbootstrapv1.DataSecretGenerationFailedReason, clusterv1.ConditionSeverityWarning, "")
+			return ctrl.Result{}, err
+		}
+		nodeInput.RegistryAuths = registryAuths
+	}
 	// TODO(richardcase): uncomment when we support ipv6 / dual stack
 	/*if config.Spec.ServiceIPV6Cidr != nil && *config.Spec.ServiceIPV6Cidr != "" {
 		nodeInput.ServiceIPV6Cidr = config.Spec.ServiceIPV6Cidr
@@ -258,6 +375,92 @@ func (r *EKSConfigReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Man
 	return nil
 }
 
+// readOnlyRootIncompatibleAMI returns why the AMI of the infrastructure machine of the config doesn't
+// support the read-only root filesystem, which requires Amazon Linux 2. An empty string is returned
+// when the AMI is compatible or can't be told before the instance exists, e.g. when the AMI is set
+// by ID, in which case the user data checks the operating system when the node boots.
+func (r *EKSConfigReconciler) readOnlyRootIncompatibleAMI(ctx context.Context, config *eksbootstrapv1.EKSConfig, controlPlane *ekscontrolplanev1.AWSManagedControlPlane) (string, error) {
+	configOwner, err := bsutil.GetConfigOwner(ctx, r.Client, config)
+	if err != nil || configOwner == nil {
+		return "", err
+	}
+
+	refPath := []string{"spec", "infrastructureRef"}
+	if configOwner.IsMachinePool() {
+		refPath = []string{"spec", "template", "spec", "infrastructureRef"}
+	}
+	kind, _, _ := unstructured.NestedString(configOwner.Object, append(refPath, "kind")...)
+	name, _, _ := unstructured.NestedString(configOwner.Object, append(refPath, "name")...)
+	key := client.ObjectKey{Namespace: configOwner.GetNamespace(), Name: name}
+
+	var amiID *string
+	var lookupFormat, lookupOrg, lookupBaseOS string
+	switch kind {
+	case "AWSMachine":
+		machine := &infrav1.AWSMachine{}
+		if err := r.Client.Get(ctx, key, machine); err != nil {
+			return "", errors.Wrapf(err, "failed to get AWSMachine %s", key)
+		}
+		amiID, lookupFormat, lookupOrg, lookupBaseOS = machine.Spec.AMI.ID, machine.Spec.ImageLookupFormat, machine.Spec.ImageLookupOrg, machine.Spec.ImageLookupBaseOS
+	case "AWSMachinePool":
+		machinePool := &expinfrav1.AWSMachinePool{}
+		if err := r.Client.Get(ctx, key, machinePool); err != nil {
+			return "", errors.Wrapf(err, "failed to get AWSMachinePool %s", key)
+		}
+		lt := machinePool.Spec.AWSLaunchTemplate
+		amiID, lookupFormat, lookupOrg, lookupBaseOS = lt.AMI.ID, lt.ImageLookupFormat, lt.ImageLookupOrg, lt.ImageLookupBaseOS
+	default:
+		return "", nil
+	}
+	if amiID != nil {
+		return "", nil
+	}
+
+	// The AMI is looked up the same way as by the AWSMachine and AWSMachinePool controllers.
+	if lookupFormat == "" {
+		lookupFormat = controlPlane.Spec.ImageLookupFormat
+	}
+	if lookupOrg == "" {
+		lookupOrg = controlPlane.Spec.ImageLookupOrg
+	}
+	if lookupBaseOS == "" {
+		lookupBaseOS = controlPlane.Spec.ImageLookupBaseOS
+	}
+	switch {
+	case lookupFormat == "" && lookupOrg == "" && lookupBaseOS == "":
+		// The EKS optimized Amazon Linux 2 AMI.
+		return "", nil
+	case lookupBaseOS == "" && lookupFormat != "":
+		// A custom name format may not include the base OS.
+		return "", nil
+	case lookupBaseOS == "":
+		lookupBaseOS = defaultAMILookupBaseOS
+	}
+	if lookupBaseOS != amazonLinux2BaseOS {
+		return fmt.Sprintf("read-only root filesystem requires an Amazon Linux 2 AMI, %s %s looks up an AMI of base OS %q", kind, name, lookupBaseOS), nil
+	}
+
+	return "", nil
+}
+
+// registryAuths returns the Secrets Manager secrets holding the credentials of the registries
+// containerd authenticates to, which the node fetches from the region of each secret.
+func (r *EKSConfigReconciler) registryAuths(config *eksbootstrapv1.EKSConfig) ([]userdata.RegistryAuth, error) {
+	registryAuths := make([]userdata.RegistryAuth, 0, len(config.Spec.RegistryAuths))
+	for _, auth := range config.Spec.RegistryAuths {
+		secretARN, err := arn.Parse(auth.SecretARN)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse the secret ARN of registry %s", auth.Registry)
+		}
+		registryAuths = append(registryAuths, userdata.RegistryAuth{
+			Registry:  auth.Registry,
+			SecretARN: auth.SecretARN,
+			Region:    secretARN.Region,
+		})
+	}
+	return registryAuths, nil
+}
+
 // storeBootstrapData creates a new secret with the data passed in as input,
 // sets the reference in the configuration status and ready to true.
 func (r *EKSConfigReconciler) storeBootstrapData(ctx context.Context, cluster *clusterv1.Cluster, config *eksbootstrapv1.EKSConfig, data []byte) error {
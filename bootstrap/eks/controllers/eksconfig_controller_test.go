@@ -21,8 +21,16 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/bootstrap/eks/api/v1beta1"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 )
 
@@ -69,3 +77,80 @@ func TestEKSConfigReconciler_ReturnEarlyIfClusterControlPlaneNotInitialized(t *t
 		gomega.Expect(err).NotTo(HaveOccurred())
 	}).Should(Succeed())
 }
+
+func TestEKSConfigReconciler_ReadOnlyRootIncompatibleAMI(t *testing.T) {
+	tests := []struct {
+		name             string
+		awsMachine       infrav1.AWSMachineSpec
+		controlPlane     ekscontrolplanev1.AWSManagedControlPlaneSpec
+		expectCompatible bool
+	}{
+		{
+			name:             "eks optimized ami",
+			expectCompatible: true,
+		},
+		{
+			name:             "ami set by id",
+			awsMachine:       infrav1.AWSMachineSpec{AMI: infrav1.AMIReference{ID: pointer.String("ami-0123456789abcdef0")}},
+			expectCompatible: true,
+		},
+		{
+			name:             "amazon linux 2 ami looked up by base os",
+			awsMachine:       infrav1.AWSMachineSpec{ImageLookupBaseOS: "amazon-2"},
+			expectCompatible: true,
+		},
+		{
+			name:             "ubuntu ami looked up by base os",
+			awsMachine:       infrav1.AWSMachineSpec{ImageLookupBaseOS: "ubuntu-20.04"},
+			expectCompatible: false,
+		},
+		{
+			name:             "ubuntu ami looked up by the base os of the control plane",
+			controlPlane:     ekscontrolplanev1.AWSManagedControlPlaneSpec{ImageLookupBaseOS: "ubuntu-20.04"},
+			expectCompatible: false,
+		},
+		{
+			name:             "ami looked up with the default base os",
+			awsMachine:       infrav1.AWSMachineSpec{ImageLookupOrg: "123456789012"},
+			expectCompatible: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(infrav1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(eksbootstrapv1.AddToScheme(scheme)).To(Succeed())
+
+			cluster := newCluster("cluster")
+			machine := newMachine(cluster, "machine")
+			machine.Spec.InfrastructureRef = corev1.ObjectReference{
+				Kind:       "AWSMachine",
+				APIVersion: infrav1.GroupVersion.String(),
+				Namespace:  machine.Namespace,
+				Name:       machine.Name,
+			}
+			awsMachine := &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{Namespace: machine.Namespace, Name: machine.Name},
+				Spec:       tc.awsMachine,
+			}
+			config := newEKSConfig(machine)
+			controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{Spec: tc.controlPlane}
+
+			reconciler := EKSConfigReconciler{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(machine, awsMachine).Build(),
+			}
+
+			incompatibility, err := reconciler.readOnlyRootIncompatibleAMI(context.Background(), config, controlPlane)
+			g.Expect(err).NotTo(HaveOccurred())
+			if tc.expectCompatible {
+				g.Expect(incompatibility).To(BeEmpty())
+			} else {
+				g.Expect(incompatibility).To(ContainSubstring("requires an Amazon Linux 2 AMI"))
+			}
+		})
+	}
+}
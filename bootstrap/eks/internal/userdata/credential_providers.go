@@ -0,0 +1,122 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userdata
+
+import (
+	"encoding/json"
+)
+
+const (
+	credentialProviderConfigDir            = "/etc/eks/image-credential-provider"
+	credentialProviderConfigFile           = credentialProviderConfigDir + "/capa-config.json"
+	credentialProviderConfigAPIVersion     = "kubelet.config.k8s.io/v1beta1"
+	credentialProviderConfigKind           = "CredentialProviderConfig"
+	defaultCredentialProviderBinDir        = credentialProviderConfigDir
+	defaultCredentialProviderCacheDuration = "12h0m0s"
+	defaultCredentialProviderAPIVersion    = "credentialprovider.kubelet.k8s.io/v1beta1"
+
+	credentialProvidersTemplate = `{{- define "credentialProviders" -}}
+{{- if .CredentialProviders }}
+mkdir -p ` + credentialProviderConfigDir + ` {{.CredentialProviderBinDirOrDefault}}
+{{- range .CredentialProviders }}
+{{- if .BinaryURL }}
+curl -sSfL -o {{$.CredentialProviderBinDirOrDefault}}/{{.Name}} '{{.BinaryURL}}'
+chmod +x {{$.CredentialProviderBinDirOrDefault}}/{{.Name}}
+{{- end }}
+{{- end }}
+cat > ` + credentialProviderConfigFile + ` <<'EOF'
+{{.CredentialProviderConfig}}
+EOF
+{{- end -}}
+{{- end -}}`
+)
+
+// CredentialProvider defines a kubelet image credential provider plugin.
+type CredentialProvider struct {
+	Name                 string
+	MatchImages          []string
+	DefaultCacheDuration string
+	APIVersion           string
+	Args                 []string
+	Env                  []CredentialProviderEnv
+	// BinaryURL is where the plugin binary is downloaded from, the binary is expected
+	// to be present in the AMI when unset.
+	BinaryURL string
+}
+
+// CredentialProviderEnv is an environment variable passed to a credential provider plugin.
+type CredentialProviderEnv struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// credentialProviderConfig mirrors the kubelet CredentialProviderConfig. v1beta1 is used as
+// it is understood by all kubelet versions supporting credential providers without a feature gate.
+type credentialProviderConfig struct {
+	APIVersion string                    `json:"apiVersion"`
+	Kind       string                    `json:"kind"`
+	Providers  []credentialProviderEntry `json:"providers"`
+}
+
+type credentialProviderEntry struct {
+	Name                 string                  `json:"name"`
+	MatchImages          []string                `json:"matchImages"`
+	DefaultCacheDuration string                  `json:"defaultCacheDuration"`
+	APIVersion           string                  `json:"apiVersion"`
+	Args                 []string                `json:"args,omitempty"`
+	Env                  []CredentialProviderEnv `json:"env,omitempty"`
+}
+
+// CredentialProviderBinDirOrDefault returns the directory containing the credential provider binaries.
+func (ni *NodeInput) CredentialProviderBinDirOrDefault() string {
+	if ni.CredentialProviderBinDir == nil || *ni.CredentialProviderBinDir == "" {
+		return defaultCredentialProviderBinDir
+	}
+	return *ni.CredentialProviderBinDir
+}
+
+// CredentialProviderConfig returns the kubelet CredentialProviderConfig for the configured providers as JSON.
+func (ni *NodeInput) CredentialProviderConfig() (string, error) {
+	config := credentialProviderConfig{
+		APIVersion: credentialProviderConfigAPIVersion,
+		Kind:       credentialProviderConfigKind,
+		Providers:  make([]credentialProviderEntry, 0, len(ni.CredentialProviders)),
+	}
+	for _, provider := range ni.CredentialProviders {
+		entry := credentialProviderEntry{
+			Name:                 provider.Name,
+			MatchImages:          provider.MatchImages,
+			DefaultCacheDuration: provider.DefaultCacheDuration,
+			APIVersion:           provider.APIVersion,
+			Args:                 provider.Args,
+			Env:                  provider.Env,
+		}
+		if entry.DefaultCacheDuration == "" {
+			entry.DefaultCacheDuration = defaultCredentialProviderCacheDuration
+		}
+		if entry.APIVersion == "" {
+			entry.APIVersion = defaultCredentialProviderAPIVersion
+		}
+		config.Providers = append(config.Providers, entry)
+	}
+
+	out, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
@@ -17,7 +17,7 @@ limitations under the License.
 package userdata
 
 const argsTemplate = `{{- define "args" -}}
-{{- if .KubeletExtraArgs }} --kubelet-extra-args '{{ template "kubeletArgsTemplate" .KubeletExtraArgs }}'
+{{- if .KubeletArgs }} --kubelet-extra-args '{{ template "kubeletArgsTemplate" .KubeletArgs }}'
 {{- end -}}
 {{- if .ContainerRuntime }} --container-runtime {{.ContainerRuntime}}{{- end -}}
 {{- if .IPFamily }} --ip-family {{.IPFamily}}{{- end -}}
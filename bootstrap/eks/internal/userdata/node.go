@@ -26,8 +26,208 @@ import (
 
 const (
 	nodeUserData = `#!/bin/bash
+{{- template "time" . }}
+{{- template "journald" . }}
+{{- template "auditd" . }}
+{{- template "ssmAgent" . }}
+{{- template "mtu" . }}
+{{- template "hosts" . }}
+{{- template "dns" . }}
+{{- template "cni" . }}
+{{- template "registryAuth" . }}
+{{- template "credentialProviders" . }}
+{{- template "startupGate" . }}
+{{- template "readOnlyRoot" . }}
 /etc/eks/bootstrap.sh {{.ClusterName}} {{- template "args" . }}
+{{- template "registryAuthPermissions" . }}
+{{- template "readOnlyRootReboot" . }}
 `
+
+	containerdConfigTemplate = "/etc/eks/containerd/containerd-config.toml"
+
+	cniTemplate = `{{- define "cni" -}}
+{{- if .CNIBinDir }}
+sed -i 's|^bin_dir = .*|bin_dir = "{{.CNIBinDir}}"|' ` + containerdConfigTemplate + `
+{{- end -}}
+{{- if .CNIConfDir }}
+sed -i 's|^conf_dir = .*|conf_dir = "{{.CNIConfDir}}"|' ` + containerdConfigTemplate + `
+{{- end -}}
+{{- end -}}`
+
+	networkdMTUDropInFile = "99-eks-bootstrap-mtu.conf"
+
+	// mtuTemplate sets the MTU of the interface holding the default route, which is the
+	// primary interface whatever its name is on the AMI. The MTU is persisted in a drop-in of
+	// the systemd-networkd network file of the interface, or in its ifcfg file on AMIs using
+	// the network scripts, so that it survives reboots and isn't reset by DHCP.
+	mtuTemplate = `{{- define "mtu" -}}
+{{- if .PrimaryInterfaceMTU }}
+PRIMARY_INTERFACE="$(ip route show default | awk '{print $5; exit}')"
+ip link set dev "${PRIMARY_INTERFACE}" mtu {{.PrimaryInterfaceMTU}}
+if systemctl is-active --quiet systemd-networkd; then
+NETWORK_DROP_IN_DIR="/etc/systemd/network/$(basename "$(networkctl status "${PRIMARY_INTERFACE}" | awk '/Network File:/ {print $3}')").d"
+mkdir -p "${NETWORK_DROP_IN_DIR}"
+cat > "${NETWORK_DROP_IN_DIR}/` + networkdMTUDropInFile + `" <<'EOF'
+[Link]
+MTUBytes={{.PrimaryInterfaceMTU}}
+
+[DHCPv4]
+UseMTU=false
+EOF
+networkctl reload
+else
+sed -i '/^MTU=/d' "/etc/sysconfig/network-scripts/ifcfg-${PRIMARY_INTERFACE}"
+echo 'MTU={{.PrimaryInterfaceMTU}}' >> "/etc/sysconfig/network-scripts/ifcfg-${PRIMARY_INTERFACE}"
+fi
+{{- end -}}
+{{- end -}}`
+
+	// hostsTemplate appends the host entries to /etc/hosts, which cloud-init only
+	// writes on first boot unless manage_etc_hosts is set.
+	hostsTemplate = `{{- define "hosts" -}}
+{{- if .HostEntries }}
+cat >> /etc/hosts <<'EOF'
+{{- range .HostEntries }}
+{{.IP}}{{ range .Hostnames }} {{.}}{{ end }}
+{{- end }}
+EOF
+{{- end -}}
+{{- end -}}`
+
+	resolvedConfigFile = "/etc/systemd/resolved.conf.d/99-eks-bootstrap.conf"
+
+	// dnsTemplate adds the search domains through a systemd-resolved drop-in when resolved
+	// manages DNS. Otherwise resolv.conf is edited directly and dhclient is told to append
+	// the domains so they survive lease renewals, which rewrite resolv.conf.
+	dnsTemplate = `{{- define "dns" -}}
+{{- if .DNSSearchDomains }}
+if systemctl is-active --quiet systemd-resolved; then
+mkdir -p /etc/systemd/resolved.conf.d
+cat > ` + resolvedConfigFile + ` <<'EOF'
+[Resolve]
+Domains={{ range $i, $d := .DNSSearchDomains }}{{ if $i }} {{ end }}{{ $d }}{{ end }}
+EOF
+systemctl restart systemd-resolved
+else
+echo 'append domain-search {{ range $i, $d := .DNSSearchDomains }}{{ if $i }}, {{ end }}"{{ $d }}"{{ end }};' >> /etc/dhcp/dhclient.conf
+if grep -q '^search ' /etc/resolv.conf; then
+sed -i 's/^search .*/&{{ range .DNSSearchDomains }} {{.}}{{ end }}/' /etc/resolv.conf
+else
+echo 'search{{ range .DNSSearchDomains }} {{.}}{{ end }}' >> /etc/resolv.conf
+fi
+fi
+{{- end -}}
+{{- end -}}`
+
+	startupGateScript     = "/etc/eks/startup-gate.sh"
+	startupGateDropInFile = "/etc/systemd/system/kubelet.service.d/05-startup-gate.conf"
+
+	// startupGateTemplate runs the health check before the kubelet starts, so the node registers
+	// only once it passes. The start timeout of the kubelet unit leaves room for the health check.
+	startupGateTemplate = `{{- define "startupGate" -}}
+{{- if .StartupGateCommand }}
+cat > ` + startupGateScript + ` <<'EOF'
+#!/bin/bash
+deadline=$((SECONDS + {{.StartupGateTimeout}}))
+until {{.StartupGateCommand}}; do
+  if [ "$SECONDS" -ge "$deadline" ]; then
+    echo "startup gate health check did not pass within {{.StartupGateTimeout}}s" >&2
+    exit 1
+  fi
+  sleep {{.StartupGateInterval}}
+done
+EOF
+chmod +x ` + startupGateScript + `
+mkdir -p /etc/systemd/system/kubelet.service.d
+cat > ` + startupGateDropInFile + ` <<'EOF'
+[Service]
+ExecStartPre=` + startupGateScript + `
+TimeoutStartSec={{.StartupGateUnitTimeout}}
+EOF
+systemctl daemon-reload
+{{- end -}}
+{{- end -}}`
+
+	chronyConfigFile = "/etc/chrony.conf"
+	// amazonTimeSyncServer is the link-local address of the Amazon Time Sync service.
+	amazonTimeSyncServer = "169.254.169.123"
+
+	// timeTemplate sets the timezone of the node and replaces the time sources of chrony,
+	// which is the NTP client of the EKS optimized AMIs.
+	timeTemplate = `{{- define "time" -}}
+{{- if .Timezone }}
+timedatectl set-timezone {{.TimezoneOrDefault}}
+sed -i -e '/^server /d' -e '/^pool /d' ` + chronyConfigFile + `
+cat >> ` + chronyConfigFile + ` <<'EOF'
+{{- range .NTPServersOrDefault }}
+server {{.}} prefer iburst
+{{- end }}
+EOF
+systemctl restart chronyd
+{{- end -}}
+{{- end -}}`
+
+	journaldConfigFile = "/etc/systemd/journald.conf.d/99-eks-bootstrap.conf"
+
+	// journaldTemplate writes the journal retention and rate limits to a drop-in,
+	// leaving the journald.conf shipped with the AMI untouched.
+	journaldTemplate = `{{- define "journald" -}}
+{{- if or .JournaldMaxUse .JournaldMaxFileSize .JournaldRateLimitIntervalSec .JournaldRateLimitBurst }}
+mkdir -p /etc/systemd/journald.conf.d
+cat > ` + journaldConfigFile + ` <<'EOF'
+[Journal]
+{{- if .JournaldMaxUse }}
+SystemMaxUse={{.JournaldMaxUse}}
+{{- end }}
+{{- if .JournaldMaxFileSize }}
+SystemMaxFileSize={{.JournaldMaxFileSize}}
+{{- end }}
+{{- if .JournaldRateLimitIntervalSec }}
+RateLimitIntervalSec={{.JournaldRateLimitIntervalSec}}
+{{- end }}
+{{- if .JournaldRateLimitBurst }}
+RateLimitBurst={{.JournaldRateLimitBurst}}
+{{- end }}
+EOF
+systemctl restart systemd-journald
+{{- end -}}
+{{- end -}}`
+
+	auditRulesFile = "/etc/audit/rules.d/99-eks-bootstrap.rules"
+
+	// auditdTemplate loads the audit rules and, when a syslog server is set, enables the
+	// audisp syslog plugin on the local6 facility and has rsyslog forward that facility.
+	auditdTemplate = `{{- define "auditd" -}}
+{{- if .AuditdRules }}
+cat > ` + auditRulesFile + ` <<'EOF'
+{{- range .AuditdRules }}
+{{.}}
+{{- end }}
+EOF
+augenrules --load
+{{- end -}}
+{{- if .AuditdSyslogAddress }}
+sed -i -e 's/^active = .*/active = yes/' -e 's/^args = .*/args = LOG_INFO LOG_LOCAL6/' /etc/audisp/plugins.d/syslog.conf
+cat > /etc/rsyslog.d/99-eks-bootstrap-audit.conf <<'EOF'
+local6.* {{ if eq .AuditdSyslogProtocol "udp" }}@{{ else }}@@{{ end }}{{.AuditdSyslogAddress}}:{{.SyslogPort}}
+EOF
+systemctl restart rsyslog
+service auditd restart
+{{- end -}}
+{{- end -}}`
+
+	// ssmAgentTemplate installs the pinned SSM agent version from the regional
+	// release bucket, replacing the version shipped with the AMI.
+	ssmAgentTemplate = `{{- define "ssmAgent" -}}
+{{- if .SSMAgentVersion }}
+IMDS_TOKEN=$(curl -sS -X PUT http://169.254.169.254/latest/api/token -H "X-aws-ec2-metadata-token-ttl-seconds: 300")
+AWS_REGION=$(curl -sS -H "X-aws-ec2-metadata-token: ${IMDS_TOKEN}" http://169.254.169.254/latest/meta-data/placement/region)
+case $(uname -m) in aarch64) SSM_AGENT_ARCH=linux_arm64 ;; *) SSM_AGENT_ARCH=linux_amd64 ;; esac
+yum install -y "https://s3.${AWS_REGION}.amazonaws.com/amazon-ssm-${AWS_REGION}/{{.SSMAgentVersion}}/${SSM_AGENT_ARCH}/amazon-ssm-agent.rpm"
+systemctl enable amazon-ssm-agent
+systemctl restart amazon-ssm-agent
+{{- end -}}
+{{- end -}}`
 )
 
 // NodeInput defines the context to generate a node user data.
@@ -41,12 +241,48 @@ type NodeInput struct {
 	PauseContainerAccount *string
 	PauseContainerVersion *string
 	UseMaxPods            *bool
+	TopologyManagerPolicy *string
+	CPUManagerPolicy      *string
+	CNIBinDir             *string
+	CNIConfDir            *string
+	SSMAgentVersion       *string
+	JournaldMaxUse        *string
+	JournaldMaxFileSize   *string
+	// JournaldRateLimitIntervalSec and JournaldRateLimitBurst disable rate limiting when set to 0.
+	JournaldRateLimitIntervalSec *int32
+	JournaldRateLimitBurst       *int32
+	AuditdRules                  []string
+	AuditdSyslogAddress          *string
+	AuditdSyslogPort             int32
+	AuditdSyslogProtocol         string
+	CredentialProviderBinDir     *string
+	CredentialProviders          []CredentialProvider
+	PrimaryInterfaceMTU          *int
+	HostEntries                  []HostEntry
+	DNSSearchDomains             []string
+	StartupGateCommand           *string
+	StartupGateTimeoutSeconds    int32
+	StartupGateIntervalSeconds   int32
+	// Timezone and NTPServers configure the clock of the node when Timezone is set.
+	Timezone      *string
+	NTPServers    []string
+	RegistryAuths []RegistryAuth
+	// ReadOnlyRootWritablePaths and ReadOnlyRootOverlaySize configure the overlays when ReadOnlyRootFilesystem is set.
+	ReadOnlyRootFilesystem    bool
+	ReadOnlyRootWritablePaths []string
+	ReadOnlyRootOverlaySize   string
 	// NOTE: currently the IPFamily/ServiceIPV6Cidr isn't exposed to the user.
 	// TODO (richardcase): remove the above comment when IPV6 / dual stack is implemented.
 	IPFamily        *string
 	ServiceIPV6Cidr *string
 }
 
+// HostEntry is a line appended to /etc/hosts.
+type HostEntry struct {
+	IP        string
+	Hostnames []string
+}
+
 func (ni *NodeInput) DockerConfigJSONEscaped() string {
 	if ni.DockerConfigJSON == nil || len(*ni.DockerConfigJSON) == 0 {
 		return "''"
@@ -55,6 +291,83 @@ func (ni *NodeInput) DockerConfigJSONEscaped() string {
 	return shellescape.Quote(*ni.DockerConfigJSON)
 }
 
+// SyslogPort returns the port of the syslog server the audit log is forwarded to, defaulting to 514.
+func (ni *NodeInput) SyslogPort() int32 {
+	if ni.AuditdSyslogPort == 0 {
+		return 514
+	}
+	return ni.AuditdSyslogPort
+}
+
+// StartupGateTimeout returns how long, in seconds, the startup gate health check is retried, defaulting to 600.
+func (ni *NodeInput) StartupGateTimeout() int32 {
+	if ni.StartupGateTimeoutSeconds == 0 {
+		return 600
+	}
+	return ni.StartupGateTimeoutSeconds
+}
+
+// StartupGateInterval returns the time, in seconds, between two runs of the startup gate health check, defaulting to 10.
+func (ni *NodeInput) StartupGateInterval() int32 {
+	if ni.StartupGateIntervalSeconds == 0 {
+		return 10
+	}
+	return ni.StartupGateIntervalSeconds
+}
+
+// StartupGateUnitTimeout returns the start timeout of the kubelet unit, which leaves a minute
+// on top of the startup gate timeout for the kubelet itself to start.
+func (ni *NodeInput) StartupGateUnitTimeout() int32 {
+	return ni.StartupGateTimeout() + 60
+}
+
+// TimezoneOrDefault returns the timezone of the node, defaulting to UTC.
+func (ni *NodeInput) TimezoneOrDefault() string {
+	if ni.Timezone == nil || *ni.Timezone == "" {
+		return "UTC"
+	}
+	return *ni.Timezone
+}
+
+// NTPServersOrDefault returns the NTP servers of the node, defaulting to the Amazon Time Sync service.
+func (ni *NodeInput) NTPServersOrDefault() []string {
+	if len(ni.NTPServers) == 0 {
+		return []string{amazonTimeSyncServer}
+	}
+	return ni.NTPServers
+}
+
+// KubeletArgs returns the kubelet args to pass to the bootstrap script, combining the
+// user supplied extra args with the args derived from the other node settings.
+func (ni *NodeInput) KubeletArgs() map[string]string {
+	args := make(map[string]string, len(ni.KubeletExtraArgs))
+	for k, v := range ni.KubeletExtraArgs {
+		args[k] = v
+	}
+	if ni.TopologyManagerPolicy != nil {
+		args["topology-manager-policy"] = *ni.TopologyManagerPolicy
+	}
+	if ni.CPUManagerPolicy != nil {
+		args["cpu-manager-policy"] = *ni.CPUManagerPolicy
+	}
+	if len(ni.CredentialProviders) > 0 {
+		args["image-credential-provider-config"] = credentialProviderConfigFile
+		args["image-credential-provider-bin-dir"] = ni.CredentialProviderBinDirOrDefault()
+	}
+	// With dockershim the kubelet runs the CNI plugins itself, containerd
+	// reads the directories from its own configuration instead.
+	if ni.ContainerRuntime != nil && *ni.ContainerRuntime == "dockerd" {
+		if ni.CNIBinDir != nil {
+			args["cni-bin-dir"] = *ni.CNIBinDir
+		}
+		if ni.CNIConfDir != nil {
+			args["cni-conf-dir"] = *ni.CNIConfDir
+		}
+	}
+
+	return args
+}
+
 // NewNode returns the user data string to be used on a node instance.
 func NewNode(input *NodeInput) ([]byte, error) {
 	tm := template.New("Node")
@@ -67,6 +380,62 @@ func NewNode(input *NodeInput) ([]byte, error) {
 		return nil, fmt.Errorf("failed to parse kubeletExtraArgs template: %w", err)
 	}
 
+	if _, err := tm.Parse(cniTemplate); err != nil {
+		return nil, fmt.Errorf("failed to parse cni template: %w", err)
+	}
+
+	if _, err := tm.Parse(readOnlyRootTemplate); err != nil {
+		return nil, fmt.Errorf("failed to parse readOnlyRoot template: %w", err)
+	}
+
+	if _, err := tm.Parse(readOnlyRootRebootTemplate); err != nil {
+		return nil, fmt.Errorf("failed to parse readOnlyRootReboot template: %w", err)
+	}
+
+	if _, err := tm.Parse(registryAuthTemplate); err != nil {
+		return nil, fmt.Errorf("failed to parse registryAuth template: %w", err)
+	}
+
+	if _, err := tm.Parse(registryAuthPermissionsTemplate); err != nil {
+		return nil, fmt.Errorf("failed to parse registryAuthPermissions template: %w", err)
+	}
+
+	if _, err := tm.Parse(mtuTemplate); err != nil {
+		return nil, fmt.Errorf("failed to parse mtu template: %w", err)
+	}
+
+	if _, err := tm.Parse(hostsTemplate); err != nil {
+		return nil, fmt.Errorf("failed to parse hosts template: %w", err)
+	}
+
+	if _, err := tm.Parse(dnsTemplate); err != nil {
+		return nil, fmt.Errorf("failed to parse dns template: %w", err)
+	}
+
+	if _, err := tm.Parse(startupGateTemplate); err != nil {
+		return nil, fmt.Errorf("failed to parse startupGate template: %w", err)
+	}
+
+	if _, err := tm.Parse(timeTemplate); err != nil {
+		return nil, fmt.Errorf("failed to parse time template: %w", err)
+	}
+
+	if _, err := tm.Parse(journaldTemplate); err != nil {
+		return nil, fmt.Errorf("failed to parse journald template: %w", err)
+	}
+
+	if _, err := tm.Parse(auditdTemplate); err != nil {
+		return nil, fmt.Errorf("failed to parse auditd template: %w", err)
+	}
+
+	if _, err := tm.Parse(credentialProvidersTemplate); err != nil {
+		return nil, fmt.Errorf("failed to parse credentialProviders template: %w", err)
+	}
+
+	if _, err := tm.Parse(ssmAgentTemplate); err != nil {
+		return nil, fmt.Errorf("failed to parse ssmAgent template: %w", err)
+	}
+
 	t, err := tm.Parse(nodeUserData)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse Node template: %w", err)
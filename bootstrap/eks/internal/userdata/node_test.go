@@ -165,6 +165,595 @@ func TestNewNode(t *testing.T) {
 			},
 			expectedBytes: []byte(`#!/bin/bash
 /etc/eks/bootstrap.sh test-cluster --docker-config-json '{"debug":true}'
+`),
+		},
+		{
+			name: "with topology manager policy",
+			args: args{
+				input: &NodeInput{
+					ClusterName:           "test-cluster",
+					TopologyManagerPolicy: pointer.String("single-numa-node"),
+				},
+			},
+			expectedBytes: []byte(`#!/bin/bash
+/etc/eks/bootstrap.sh test-cluster --kubelet-extra-args '--topology-manager-policy=single-numa-node'
+`),
+		},
+		{
+			name: "with topology and cpu manager policies and kubelet extra args",
+			args: args{
+				input: &NodeInput{
+					ClusterName: "test-cluster",
+					KubeletExtraArgs: map[string]string{
+						"node-labels": "node-role.undistro.io/infra=true",
+					},
+					TopologyManagerPolicy: pointer.String("restricted"),
+					CPUManagerPolicy:      pointer.String("static"),
+				},
+			},
+			expectedBytes: []byte(`#!/bin/bash
+/etc/eks/bootstrap.sh test-cluster --kubelet-extra-args '--cpu-manager-policy=static --node-labels=node-role.undistro.io/infra=true --topology-manager-policy=restricted'
+`),
+		},
+		{
+			name: "policies override kubelet extra args",
+			args: args{
+				input: &NodeInput{
+					ClusterName: "test-cluster",
+					KubeletExtraArgs: map[string]string{
+						"cpu-manager-policy": "none",
+					},
+					CPUManagerPolicy: pointer.String("static"),
+				},
+			},
+			expectedBytes: []byte(`#!/bin/bash
+/etc/eks/bootstrap.sh test-cluster --kubelet-extra-args '--cpu-manager-policy=static'
+`),
+		},
+		{
+			name: "with cni directories",
+			args: args{
+				input: &NodeInput{
+					ClusterName: "test-cluster",
+					CNIBinDir:   pointer.String("/opt/custom/cni/bin"),
+					CNIConfDir:  pointer.String("/etc/custom/cni/net.d"),
+				},
+			},
+			expectedBytes: []byte(`#!/bin/bash
+sed -i 's|^bin_dir = .*|bin_dir = "/opt/custom/cni/bin"|' /etc/eks/containerd/containerd-config.toml
+sed -i 's|^conf_dir = .*|conf_dir = "/etc/custom/cni/net.d"|' /etc/eks/containerd/containerd-config.toml
+/etc/eks/bootstrap.sh test-cluster
+`),
+		},
+		{
+			name: "with cni conf directory and containerd",
+			args: args{
+				input: &NodeInput{
+					ClusterName:      "test-cluster",
+					ContainerRuntime: pointer.String("containerd"),
+					CNIConfDir:       pointer.String("/etc/custom/cni/net.d"),
+				},
+			},
+			expectedBytes: []byte(`#!/bin/bash
+sed -i 's|^conf_dir = .*|conf_dir = "/etc/custom/cni/net.d"|' /etc/eks/containerd/containerd-config.toml
+/etc/eks/bootstrap.sh test-cluster --container-runtime containerd
+`),
+		},
+		{
+			name: "with cni directories and dockerd",
+			args: args{
+				input: &NodeInput{
+					ClusterName:      "test-cluster",
+					ContainerRuntime: pointer.String("dockerd"),
+					CNIBinDir:        pointer.String("/opt/custom/cni/bin"),
+					CNIConfDir:       pointer.String("/etc/custom/cni/net.d"),
+				},
+			},
+			expectedBytes: []byte(`#!/bin/bash
+sed -i 's|^bin_dir = .*|bin_dir = "/opt/custom/cni/bin"|' /etc/eks/containerd/containerd-config.toml
+sed -i 's|^conf_dir = .*|conf_dir = "/etc/custom/cni/net.d"|' /etc/eks/containerd/containerd-config.toml
+/etc/eks/bootstrap.sh test-cluster --kubelet-extra-args '--cni-bin-dir=/opt/custom/cni/bin --cni-conf-dir=/etc/custom/cni/net.d' --container-runtime dockerd
+`),
+		},
+		{
+			name: "with ssm agent version",
+			args: args{
+				input: &NodeInput{
+					ClusterName:     "test-cluster",
+					SSMAgentVersion: pointer.String("3.1.1446.0"),
+				},
+			},
+			expectedBytes: []byte(`#!/bin/bash
+IMDS_TOKEN=$(curl -sS -X PUT http://169.254.169.254/latest/api/token -H "X-aws-ec2-metadata-token-ttl-seconds: 300")
+AWS_REGION=$(curl -sS -H "X-aws-ec2-metadata-token: ${IMDS_TOKEN}" http://169.254.169.254/latest/meta-data/placement/region)
+case $(uname -m) in aarch64) SSM_AGENT_ARCH=linux_arm64 ;; *) SSM_AGENT_ARCH=linux_amd64 ;; esac
+yum install -y "https://s3.${AWS_REGION}.amazonaws.com/amazon-ssm-${AWS_REGION}/3.1.1446.0/${SSM_AGENT_ARCH}/amazon-ssm-agent.rpm"
+systemctl enable amazon-ssm-agent
+systemctl restart amazon-ssm-agent
+/etc/eks/bootstrap.sh test-cluster
+`),
+		},
+		{
+			name: "with ssm agent version and cni directories",
+			args: args{
+				input: &NodeInput{
+					ClusterName:     "test-cluster",
+					SSMAgentVersion: pointer.String("latest"),
+					CNIBinDir:       pointer.String("/opt/custom/cni/bin"),
+				},
+			},
+			expectedBytes: []byte(`#!/bin/bash
+IMDS_TOKEN=$(curl -sS -X PUT http://169.254.169.254/latest/api/token -H "X-aws-ec2-metadata-token-ttl-seconds: 300")
+AWS_REGION=$(curl -sS -H "X-aws-ec2-metadata-token: ${IMDS_TOKEN}" http://169.254.169.254/latest/meta-data/placement/region)
+case $(uname -m) in aarch64) SSM_AGENT_ARCH=linux_arm64 ;; *) SSM_AGENT_ARCH=linux_amd64 ;; esac
+yum install -y "https://s3.${AWS_REGION}.amazonaws.com/amazon-ssm-${AWS_REGION}/latest/${SSM_AGENT_ARCH}/amazon-ssm-agent.rpm"
+systemctl enable amazon-ssm-agent
+systemctl restart amazon-ssm-agent
+sed -i 's|^bin_dir = .*|bin_dir = "/opt/custom/cni/bin"|' /etc/eks/containerd/containerd-config.toml
+/etc/eks/bootstrap.sh test-cluster
+`),
+		},
+		{
+			name: "with journald retention and rate limits",
+			args: args{
+				input: &NodeInput{
+					ClusterName:                  "test-cluster",
+					JournaldMaxUse:               pointer.String("2G"),
+					JournaldMaxFileSize:          pointer.String("100M"),
+					JournaldRateLimitIntervalSec: pointer.Int32(30),
+					JournaldRateLimitBurst:       pointer.Int32(10000),
+				},
+			},
+			expectedBytes: []byte(`#!/bin/bash
+mkdir -p /etc/systemd/journald.conf.d
+cat > /etc/systemd/journald.conf.d/99-eks-bootstrap.conf <<'EOF'
+[Journal]
+SystemMaxUse=2G
+SystemMaxFileSize=100M
+RateLimitIntervalSec=30
+RateLimitBurst=10000
+EOF
+systemctl restart systemd-journald
+/etc/eks/bootstrap.sh test-cluster
+`),
+		},
+		{
+			name: "with journald rate limiting disabled",
+			args: args{
+				input: &NodeInput{
+					ClusterName:            "test-cluster",
+					JournaldRateLimitBurst: pointer.Int32(0),
+				},
+			},
+			expectedBytes: []byte(`#!/bin/bash
+mkdir -p /etc/systemd/journald.conf.d
+cat > /etc/systemd/journald.conf.d/99-eks-bootstrap.conf <<'EOF'
+[Journal]
+RateLimitBurst=0
+EOF
+systemctl restart systemd-journald
+/etc/eks/bootstrap.sh test-cluster
+`),
+		},
+		{
+			name: "with auditd rules",
+			args: args{
+				input: &NodeInput{
+					ClusterName: "test-cluster",
+					AuditdRules: []string{
+						"-w /etc/kubernetes/ -p wa -k kubernetes",
+						"-a always,exit -F arch=b64 -S execve -k exec",
+					},
+				},
+			},
+			expectedBytes: []byte(`#!/bin/bash
+cat > /etc/audit/rules.d/99-eks-bootstrap.rules <<'EOF'
+-w /etc/kubernetes/ -p wa -k kubernetes
+-a always,exit -F arch=b64 -S execve -k exec
+EOF
+augenrules --load
+/etc/eks/bootstrap.sh test-cluster
+`),
+		},
+		{
+			name: "with auditd rules and tcp syslog forwarder on the default port",
+			args: args{
+				input: &NodeInput{
+					ClusterName:          "test-cluster",
+					AuditdRules:          []string{"-w /etc/kubernetes/ -p wa -k kubernetes"},
+					AuditdSyslogAddress:  pointer.String("siem.example.com"),
+					AuditdSyslogProtocol: "tcp",
+				},
+			},
+			expectedBytes: []byte(`#!/bin/bash
+cat > /etc/audit/rules.d/99-eks-bootstrap.rules <<'EOF'
+-w /etc/kubernetes/ -p wa -k kubernetes
+EOF
+augenrules --load
+sed -i -e 's/^active = .*/active = yes/' -e 's/^args = .*/args = LOG_INFO LOG_LOCAL6/' /etc/audisp/plugins.d/syslog.conf
+cat > /etc/rsyslog.d/99-eks-bootstrap-audit.conf <<'EOF'
+local6.* @@siem.example.com:514
+EOF
+systemctl restart rsyslog
+service auditd restart
+/etc/eks/bootstrap.sh test-cluster
+`),
+		},
+		{
+			name: "with udp syslog forwarder",
+			args: args{
+				input: &NodeInput{
+					ClusterName:          "test-cluster",
+					AuditdSyslogAddress:  pointer.String("10.0.0.10"),
+					AuditdSyslogPort:     1514,
+					AuditdSyslogProtocol: "udp",
+				},
+			},
+			expectedBytes: []byte(`#!/bin/bash
+sed -i -e 's/^active = .*/active = yes/' -e 's/^args = .*/args = LOG_INFO LOG_LOCAL6/' /etc/audisp/plugins.d/syslog.conf
+cat > /etc/rsyslog.d/99-eks-bootstrap-audit.conf <<'EOF'
+local6.* @10.0.0.10:1514
+EOF
+systemctl restart rsyslog
+service auditd restart
+/etc/eks/bootstrap.sh test-cluster
+`),
+		},
+		{
+			name: "with image credential provider from the AMI",
+			args: args{
+				input: &NodeInput{
+					ClusterName: "test-cluster",
+					CredentialProviders: []CredentialProvider{
+						{
+							Name:        "ecr-credential-provider",
+							MatchImages: []string{"*.dkr.ecr.*.amazonaws.com"},
+							Args:        []string{"--v=2"},
+							Env:         []CredentialProviderEnv{{Name: "AWS_PROFILE", Value: "ecr"}},
+						},
+					},
+				},
+			},
+			expectedBytes: []byte(`#!/bin/bash
+mkdir -p /etc/eks/image-credential-provider /etc/eks/image-credential-provider
+cat > /etc/eks/image-credential-provider/capa-config.json <<'EOF'
+{
+  "apiVersion": "kubelet.config.k8s.io/v1beta1",
+  "kind": "CredentialProviderConfig",
+  "providers": [
+    {
+      "name": "ecr-credential-provider",
+      "matchImages": [
+        "*.dkr.ecr.*.amazonaws.com"
+      ],
+      "defaultCacheDuration": "12h0m0s",
+      "apiVersion": "credentialprovider.kubelet.k8s.io/v1beta1",
+      "args": [
+        "--v=2"
+      ],
+      "env": [
+        {
+          "name": "AWS_PROFILE",
+          "value": "ecr"
+        }
+      ]
+    }
+  ]
+}
+EOF
+/etc/eks/bootstrap.sh test-cluster --kubelet-extra-args '--image-credential-provider-bin-dir=/etc/eks/image-credential-provider --image-credential-provider-config=/etc/eks/image-credential-provider/capa-config.json'
+`),
+		},
+		{
+			name: "with downloaded image credential provider in a custom bin dir",
+			args: args{
+				input: &NodeInput{
+					ClusterName:              "test-cluster",
+					CredentialProviderBinDir: pointer.String("/opt/credential-providers"),
+					CredentialProviders: []CredentialProvider{
+						{
+							Name:                 "gcr-credential-provider",
+							MatchImages:          []string{"gcr.io", "*.gcr.io"},
+							DefaultCacheDuration: "1h0m0s",
+							APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+							BinaryURL:            "https://example.com/gcr-credential-provider",
+						},
+					},
+				},
+			},
+			expectedBytes: []byte(`#!/bin/bash
+mkdir -p /etc/eks/image-credential-provider /opt/credential-providers
+curl -sSfL -o /opt/credential-providers/gcr-credential-provider 'https://example.com/gcr-credential-provider'
+chmod +x /opt/credential-providers/gcr-credential-provider
+cat > /etc/eks/image-credential-provider/capa-config.json <<'EOF'
+{
+  "apiVersion": "kubelet.config.k8s.io/v1beta1",
+  "kind": "CredentialProviderConfig",
+  "providers": [
+    {
+      "name": "gcr-credential-provider",
+      "matchImages": [
+        "gcr.io",
+        "*.gcr.io"
+      ],
+      "defaultCacheDuration": "1h0m0s",
+      "apiVersion": "credentialprovider.kubelet.k8s.io/v1"
+    }
+  ]
+}
+EOF
+/etc/eks/bootstrap.sh test-cluster --kubelet-extra-args '--image-credential-provider-bin-dir=/opt/credential-providers --image-credential-provider-config=/etc/eks/image-credential-provider/capa-config.json'
+`),
+		},
+		{
+			name: "with primary interface MTU",
+			args: args{
+				input: &NodeInput{
+					ClusterName:         "test-cluster",
+					PrimaryInterfaceMTU: pointer.Int(9001),
+				},
+			},
+			expectedBytes: []byte(`#!/bin/bash
+PRIMARY_INTERFACE="$(ip route show default | awk '{print $5; exit}')"
+ip link set dev "${PRIMARY_INTERFACE}" mtu 9001
+if systemctl is-active --quiet systemd-networkd; then
+NETWORK_DROP_IN_DIR="/etc/systemd/network/$(basename "$(networkctl status "${PRIMARY_INTERFACE}" | awk '/Network File:/ {print $3}')").d"
+mkdir -p "${NETWORK_DROP_IN_DIR}"
+cat > "${NETWORK_DROP_IN_DIR}/99-eks-bootstrap-mtu.conf" <<'EOF'
+[Link]
+MTUBytes=9001
+
+[DHCPv4]
+UseMTU=false
+EOF
+networkctl reload
+else
+sed -i '/^MTU=/d' "/etc/sysconfig/network-scripts/ifcfg-${PRIMARY_INTERFACE}"
+echo 'MTU=9001' >> "/etc/sysconfig/network-scripts/ifcfg-${PRIMARY_INTERFACE}"
+fi
+/etc/eks/bootstrap.sh test-cluster
+`),
+		},
+		{
+			name: "with host entries",
+			args: args{
+				input: &NodeInput{
+					ClusterName: "test-cluster",
+					HostEntries: []HostEntry{
+						{IP: "10.0.0.10", Hostnames: []string{"registry.corp.example.com", "registry"}},
+						{IP: "fd00::1", Hostnames: []string{"proxy.corp.example.com"}},
+					},
+				},
+			},
+			expectedBytes: []byte(`#!/bin/bash
+cat >> /etc/hosts <<'EOF'
+10.0.0.10 registry.corp.example.com registry
+fd00::1 proxy.corp.example.com
+EOF
+/etc/eks/bootstrap.sh test-cluster
+`),
+		},
+		{
+			name: "with DNS search domains",
+			args: args{
+				input: &NodeInput{
+					ClusterName:      "test-cluster",
+					DNSSearchDomains: []string{"corp.example.com", "example.com"},
+				},
+			},
+			expectedBytes: []byte(`#!/bin/bash
+if systemctl is-active --quiet systemd-resolved; then
+mkdir -p /etc/systemd/resolved.conf.d
+cat > /etc/systemd/resolved.conf.d/99-eks-bootstrap.conf <<'EOF'
+[Resolve]
+Domains=corp.example.com example.com
+EOF
+systemctl restart systemd-resolved
+else
+echo 'append domain-search "corp.example.com", "example.com";' >> /etc/dhcp/dhclient.conf
+if grep -q '^search ' /etc/resolv.conf; then
+sed -i 's/^search .*/& corp.example.com example.com/' /etc/resolv.conf
+else
+echo 'search corp.example.com example.com' >> /etc/resolv.conf
+fi
+fi
+/etc/eks/bootstrap.sh test-cluster
+`),
+		},
+		{
+			name: "with startup gate",
+			args: args{
+				input: &NodeInput{
+					ClusterName:        "test-cluster",
+					StartupGateCommand: pointer.String("test -f /var/run/node-init-done"),
+				},
+			},
+			expectedBytes: []byte(`#!/bin/bash
+cat > /etc/eks/startup-gate.sh <<'EOF'
+#!/bin/bash
+deadline=$((SECONDS + 600))
+until test -f /var/run/node-init-done; do
+  if [ "$SECONDS" -ge "$deadline" ]; then
+    echo "startup gate health check did not pass within 600s" >&2
+    exit 1
+  fi
+  sleep 10
+done
+EOF
+chmod +x /etc/eks/startup-gate.sh
+mkdir -p /etc/systemd/system/kubelet.service.d
+cat > /etc/systemd/system/kubelet.service.d/05-startup-gate.conf <<'EOF'
+[Service]
+ExecStartPre=/etc/eks/startup-gate.sh
+TimeoutStartSec=660
+EOF
+systemctl daemon-reload
+/etc/eks/bootstrap.sh test-cluster
+`),
+		},
+		{
+			name: "with startup gate timeout and interval",
+			args: args{
+				input: &NodeInput{
+					ClusterName:                "test-cluster",
+					StartupGateCommand:         pointer.String("curl -sf http://localhost:8080/healthz"),
+					StartupGateTimeoutSeconds:  120,
+					StartupGateIntervalSeconds: 5,
+				},
+			},
+			expectedBytes: []byte(`#!/bin/bash
+cat > /etc/eks/startup-gate.sh <<'EOF'
+#!/bin/bash
+deadline=$((SECONDS + 120))
+until curl -sf http://localhost:8080/healthz; do
+  if [ "$SECONDS" -ge "$deadline" ]; then
+    echo "startup gate health check did not pass within 120s" >&2
+    exit 1
+  fi
+  sleep 5
+done
+EOF
+chmod +x /etc/eks/startup-gate.sh
+mkdir -p /etc/systemd/system/kubelet.service.d
+cat > /etc/systemd/system/kubelet.service.d/05-startup-gate.conf <<'EOF'
+[Service]
+ExecStartPre=/etc/eks/startup-gate.sh
+TimeoutStartSec=180
+EOF
+systemctl daemon-reload
+/etc/eks/bootstrap.sh test-cluster
+`),
+		},
+		{
+			name: "with default timezone and NTP servers",
+			args: args{
+				input: &NodeInput{
+					ClusterName: "test-cluster",
+					Timezone:    pointer.String(""),
+				},
+			},
+			expectedBytes: []byte(`#!/bin/bash
+timedatectl set-timezone UTC
+sed -i -e '/^server /d' -e '/^pool /d' /etc/chrony.conf
+cat >> /etc/chrony.conf <<'EOF'
+server 169.254.169.123 prefer iburst
+EOF
+systemctl restart chronyd
+/etc/eks/bootstrap.sh test-cluster
+`),
+		},
+		{
+			name: "with registry auths",
+			args: args{
+				input: &NodeInput{
+					ClusterName: "test-cluster",
+					RegistryAuths: []RegistryAuth{
+						{
+							Registry:  "registry.example.com",
+							SecretARN: "arn:aws:secretsmanager:eu-west-1:123456789012:secret:registry-AbCdEf",
+							Region:    "eu-west-1",
+						},
+						{
+							Registry:  "registry.example.com:5000",
+							SecretARN: "arn:aws:secretsmanager:us-east-1:123456789012:secret:team/registry-GhIjKl",
+							Region:    "us-east-1",
+						},
+					},
+				},
+			},
+			expectedBytes: []byte(`#!/bin/bash
+chmod 600 /etc/eks/containerd/containerd-config.toml
+mkdir -p "$(dirname /etc/containerd/config.toml)"
+install -m 600 /dev/null /etc/containerd/config.toml
+echo '[plugins."io.containerd.grpc.v1.cri".registry.configs."registry.example.com".auth]' >> /etc/eks/containerd/containerd-config.toml
+REGISTRY_CREDENTIALS="$(aws secretsmanager get-secret-value --region eu-west-1 --secret-id 'arn:aws:secretsmanager:eu-west-1:123456789012:secret:registry-AbCdEf' --query SecretString --output text)" || exit 1
+echo "${REGISTRY_CREDENTIALS}" | jq -r '"  username = \(.username | tojson)\n  password = \(.password | tojson)"' >> /etc/eks/containerd/containerd-config.toml || exit 1
+echo '[plugins."io.containerd.grpc.v1.cri".registry.configs."registry.example.com:5000".auth]' >> /etc/eks/containerd/containerd-config.toml
+REGISTRY_CREDENTIALS="$(aws secretsmanager get-secret-value --region us-east-1 --secret-id 'arn:aws:secretsmanager:us-east-1:123456789012:secret:team/registry-GhIjKl' --query SecretString --output text)" || exit 1
+echo "${REGISTRY_CREDENTIALS}" | jq -r '"  username = \(.username | tojson)\n  password = \(.password | tojson)"' >> /etc/eks/containerd/containerd-config.toml || exit 1
+unset REGISTRY_CREDENTIALS
+/etc/eks/bootstrap.sh test-cluster
+chmod 600 /etc/containerd/config.toml
+`),
+		},
+		{
+			name: "with read-only root filesystem",
+			args: args{
+				input: &NodeInput{
+					ClusterName:               "test-cluster",
+					ReadOnlyRootFilesystem:    true,
+					ReadOnlyRootWritablePaths: []string{"/opt/agent", "/var"},
+					ReadOnlyRootOverlaySize:   "8G",
+				},
+			},
+			expectedBytes: []byte(`#!/bin/bash
+. /etc/os-release
+if [ "${ID}" != "amzn" ] || [ "${VERSION_ID}" != "2" ]; then
+  echo "read-only root filesystem requires an Amazon Linux 2 AMI, found ${PRETTY_NAME}" >&2
+  exit 1
+fi
+cat > /etc/eks/readonly-root.sh <<'EOF'
+#!/bin/bash
+set -e
+mkdir -p /run/readonly-root
+mount -t tmpfs -o mode=0755,size=8G tmpfs /run/readonly-root
+mkdir -p /etc /run/readonly-root/upper/etc /run/readonly-root/work/etc
+mount -t overlay overlay -o lowerdir=/etc,upperdir=/run/readonly-root/upper/etc,workdir=/run/readonly-root/work/etc /etc
+mkdir -p /var /run/readonly-root/upper/var /run/readonly-root/work/var
+mount -t overlay overlay -o lowerdir=/var,upperdir=/run/readonly-root/upper/var,workdir=/run/readonly-root/work/var /var
+mkdir -p /tmp /run/readonly-root/upper/tmp /run/readonly-root/work/tmp
+mount -t overlay overlay -o lowerdir=/tmp,upperdir=/run/readonly-root/upper/tmp,workdir=/run/readonly-root/work/tmp /tmp
+mkdir -p /root /run/readonly-root/upper/root /run/readonly-root/work/root
+mount -t overlay overlay -o lowerdir=/root,upperdir=/run/readonly-root/upper/root,workdir=/run/readonly-root/work/root /root
+mkdir -p /home /run/readonly-root/upper/home /run/readonly-root/work/home
+mount -t overlay overlay -o lowerdir=/home,upperdir=/run/readonly-root/upper/home,workdir=/run/readonly-root/work/home /home
+mkdir -p /opt/cni /run/readonly-root/upper/opt/cni /run/readonly-root/work/opt/cni
+mount -t overlay overlay -o lowerdir=/opt/cni,upperdir=/run/readonly-root/upper/opt/cni,workdir=/run/readonly-root/work/opt/cni /opt/cni
+mkdir -p /opt/agent /run/readonly-root/upper/opt/agent /run/readonly-root/work/opt/agent
+mount -t overlay overlay -o lowerdir=/opt/agent,upperdir=/run/readonly-root/upper/opt/agent,workdir=/run/readonly-root/work/opt/agent /opt/agent
+mkdir -p /var/lib/containerd /run/readonly-root/state/var/lib/containerd
+mount --bind /run/readonly-root/state/var/lib/containerd /var/lib/containerd
+mount -o remount,ro /
+EOF
+chmod +x /etc/eks/readonly-root.sh
+cat > /etc/systemd/system/readonly-root.service <<'EOF'
+[Unit]
+Description=Read-only root filesystem with writable overlays
+DefaultDependencies=no
+After=systemd-remount-fs.service
+Before=sysinit.target systemd-journal-flush.service systemd-tmpfiles-setup.service
+
+[Service]
+Type=oneshot
+RemainAfterExit=yes
+ExecStart=/etc/eks/readonly-root.sh
+
+[Install]
+WantedBy=sysinit.target
+EOF
+systemctl enable readonly-root.service
+/etc/eks/bootstrap.sh test-cluster
+systemctl reboot
+`),
+		},
+		{
+			name: "with custom timezone and NTP servers",
+			args: args{
+				input: &NodeInput{
+					ClusterName: "test-cluster",
+					Timezone:    pointer.String("Europe/Berlin"),
+					NTPServers:  []string{"ntp1.corp.example.com", "10.0.0.123"},
+				},
+			},
+			expectedBytes: []byte(`#!/bin/bash
+timedatectl set-timezone Europe/Berlin
+sed -i -e '/^server /d' -e '/^pool /d' /etc/chrony.conf
+cat >> /etc/chrony.conf <<'EOF'
+server ntp1.corp.example.com prefer iburst
+server 10.0.0.123 prefer iburst
+EOF
+systemctl restart chronyd
+/etc/eks/bootstrap.sh test-cluster
 `),
 		},
 	}
@@ -0,0 +1,106 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userdata
+
+const (
+	readOnlyRootScript     = "/etc/eks/readonly-root.sh"
+	readOnlyRootUnit       = "readonly-root.service"
+	readOnlyRootUnitFile   = "/etc/systemd/system/" + readOnlyRootUnit
+	readOnlyRootOverlayDir = "/run/readonly-root"
+
+	// readOnlyRootTemplate installs a unit remounting the root filesystem read-only early on the next
+	// boots, once the writable paths are covered by overlays whose writes go to a tmpfs. It can't be
+	// done on the first boot as cloud-init and journald hold files of the root filesystem open for
+	// writing, so the node reboots after the bootstrap script ran. Everything the user data configured
+	// before, e.g. the MTU persisted in the network configuration, is in the lower layers of the
+	// overlays and survives the reboot. The container runtime state directories are bind mounted
+	// from the tmpfs, as the overlay snapshotters can't use an overlay. The operating system is
+	// checked again here for AMIs set by ID, which the EKSConfig controller can't tell.
+	readOnlyRootTemplate = `{{- define "readOnlyRoot" -}}
+{{- if .ReadOnlyRootFilesystem }}
+. /etc/os-release
+if [ "${ID}" != "amzn" ] || [ "${VERSION_ID}" != "2" ]; then
+  echo "read-only root filesystem requires an Amazon Linux 2 AMI, found ${PRETTY_NAME}" >&2
+  exit 1
+fi
+cat > ` + readOnlyRootScript + ` <<'EOF'
+#!/bin/bash
+set -e
+mkdir -p ` + readOnlyRootOverlayDir + `
+mount -t tmpfs -o mode=0755{{ if .ReadOnlyRootOverlaySize }},size={{.ReadOnlyRootOverlaySize}}{{ end }} tmpfs ` + readOnlyRootOverlayDir + `
+{{- range .ReadOnlyRootWritablePathsOrDefault }}
+mkdir -p {{.}} ` + readOnlyRootOverlayDir + `/upper{{.}} ` + readOnlyRootOverlayDir + `/work{{.}}
+mount -t overlay overlay -o lowerdir={{.}},upperdir=` + readOnlyRootOverlayDir + `/upper{{.}},workdir=` + readOnlyRootOverlayDir + `/work{{.}} {{.}}
+{{- end }}
+{{- range .ContainerRuntimeStateDirs }}
+mkdir -p {{.}} ` + readOnlyRootOverlayDir + `/state{{.}}
+mount --bind ` + readOnlyRootOverlayDir + `/state{{.}} {{.}}
+{{- end }}
+mount -o remount,ro /
+EOF
+chmod +x ` + readOnlyRootScript + `
+cat > ` + readOnlyRootUnitFile + ` <<'EOF'
+[Unit]
+Description=Read-only root filesystem with writable overlays
+DefaultDependencies=no
+After=systemd-remount-fs.service
+Before=sysinit.target systemd-journal-flush.service systemd-tmpfiles-setup.service
+
+[Service]
+Type=oneshot
+RemainAfterExit=yes
+ExecStart=` + readOnlyRootScript + `
+
+[Install]
+WantedBy=sysinit.target
+EOF
+systemctl enable ` + readOnlyRootUnit + `
+{{- end -}}
+{{- end -}}`
+
+	readOnlyRootRebootTemplate = `{{- define "readOnlyRootReboot" -}}
+{{- if .ReadOnlyRootFilesystem }}
+systemctl reboot
+{{- end -}}
+{{- end -}}`
+)
+
+// defaultWritablePaths are the directories written to by the kubelet, the container runtimes,
+// the VPC CNI, which installs its plugins to /opt/cni, and the system services.
+var defaultWritablePaths = []string{"/etc", "/var", "/tmp", "/root", "/home", "/opt/cni"}
+
+// ReadOnlyRootWritablePathsOrDefault returns the default writable paths followed by the user provided ones.
+func (ni *NodeInput) ReadOnlyRootWritablePathsOrDefault() []string {
+	paths := make([]string, 0, len(defaultWritablePaths)+len(ni.ReadOnlyRootWritablePaths))
+	seen := map[string]bool{}
+	for _, path := range append(append([]string{}, defaultWritablePaths...), ni.ReadOnlyRootWritablePaths...) {
+		if seen[path] {
+			continue
+		}
+		seen[path] = true
+		paths = append(paths, path)
+	}
+	return paths
+}
+
+// ContainerRuntimeStateDirs returns the state directories of the container runtime of the node.
+func (ni *NodeInput) ContainerRuntimeStateDirs() []string {
+	if ni.ContainerRuntime != nil && *ni.ContainerRuntime == "dockerd" {
+		return []string{"/var/lib/docker"}
+	}
+	return []string{"/var/lib/containerd"}
+}
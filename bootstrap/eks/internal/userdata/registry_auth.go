@@ -0,0 +1,56 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userdata
+
+const (
+	containerdConfigFile = "/etc/containerd/config.toml"
+
+	// registryAuthTemplate appends the registry credentials to the containerd configuration template
+	// copied by the bootstrap script. The credentials are fetched from AWS Secrets Manager when the
+	// node boots so they aren't part of the user data, and neither the template nor the copy made by
+	// the bootstrap script are world-readable. The JSON strings output by jq are valid TOML strings.
+	registryAuthTemplate = `{{- define "registryAuth" -}}
+{{- if .RegistryAuths }}
+chmod 600 ` + containerdConfigTemplate + `
+mkdir -p "$(dirname ` + containerdConfigFile + `)"
+install -m 600 /dev/null ` + containerdConfigFile + `
+{{- range .RegistryAuths }}
+echo '[plugins."io.containerd.grpc.v1.cri".registry.configs."{{.Registry}}".auth]' >> ` + containerdConfigTemplate + `
+REGISTRY_CREDENTIALS="$(aws secretsmanager get-secret-value --region {{.Region}} --secret-id '{{.SecretARN}}' --query SecretString --output text)" || exit 1
+echo "${REGISTRY_CREDENTIALS}" | jq -r '"  username = \(.username | tojson)\n  password = \(.password | tojson)"' >> ` + containerdConfigTemplate + ` || exit 1
+{{- end }}
+unset REGISTRY_CREDENTIALS
+{{- end -}}
+{{- end -}}`
+
+	// registryAuthPermissionsTemplate makes sure the containerd configuration written by the bootstrap
+	// script, which holds the registry credentials, isn't world-readable.
+	registryAuthPermissionsTemplate = `{{- define "registryAuthPermissions" -}}
+{{- if .RegistryAuths }}
+chmod 600 ` + containerdConfigFile + `
+{{- end -}}
+{{- end -}}`
+)
+
+// RegistryAuth defines the AWS Secrets Manager secret holding the credentials containerd
+// authenticates to a registry with.
+type RegistryAuth struct {
+	Registry  string
+	SecretARN string
+	// Region is the region of the secret.
+	Region string
+}
@@ -87,6 +87,7 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:AuthorizeSecurityGroupIngress",
 				"ec2:CreateInternetGateway",
 				"ec2:CreateNatGateway",
+				"ec2:CreateNetworkInterface",
 				"ec2:CreateRoute",
 				"ec2:CreateRouteTable",
 				"ec2:CreateSecurityGroup",
@@ -96,6 +97,8 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:ModifyVpcAttribute",
 				"ec2:DeleteInternetGateway",
 				"ec2:DeleteNatGateway",
+				"ec2:DeleteNetworkInterface",
+				"ec2:DeleteRoute",
 				"ec2:DeleteRouteTable",
 				"ec2:ReplaceRoute",
 				"ec2:DeleteSecurityGroup",
@@ -106,6 +109,7 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:DescribeAddresses",
 				"ec2:DescribeAvailabilityZones",
 				"ec2:DescribeInstances",
+				"ec2:DescribeInstanceTypes",
 				"ec2:DescribeInternetGateways",
 				"ec2:DescribeImages",
 				"ec2:DescribeNatGateways",
@@ -120,6 +124,8 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:DetachInternetGateway",
 				"ec2:DisassociateRouteTable",
 				"ec2:DisassociateAddress",
+				"ec2:EnableVgwRoutePropagation",
+				"ec2:DisableVgwRoutePropagation",
 				"ec2:ModifyInstanceAttribute",
 				"ec2:ModifyNetworkInterfaceAttribute",
 				"ec2:ModifySubnetAttribute",
@@ -142,6 +148,8 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"elasticloadbalancing:RemoveTags",
 				"autoscaling:DescribeAutoScalingGroups",
 				"autoscaling:DescribeInstanceRefreshes",
+				"autoscaling:DescribeScalingActivities",
+				"autoscaling:DescribePolicies",
 				"ec2:CreateLaunchTemplate",
 				"ec2:CreateLaunchTemplateVersion",
 				"ec2:DescribeLaunchTemplates",
@@ -149,6 +157,7 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:DeleteLaunchTemplate",
 				"ec2:DeleteLaunchTemplateVersions",
 				"ec2:DescribeKeyPairs",
+				"ec2:DescribeCapacityReservations",
 			},
 		},
 		{
@@ -163,6 +172,8 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"autoscaling:StartInstanceRefresh",
 				"autoscaling:DeleteAutoScalingGroup",
 				"autoscaling:DeleteTags",
+				"autoscaling:PutScalingPolicy",
+				"autoscaling:DeletePolicy",
 			},
 		},
 		{
@@ -208,6 +219,62 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"iam:PassRole",
 			},
 		},
+		{
+			Effect:   iamv1.EffectAllow,
+			Resource: iamv1.Resources{iamv1.Any},
+			Action: iamv1.Actions{
+				"servicediscovery:CreatePrivateDnsNamespace",
+				"servicediscovery:DeleteNamespace",
+				"servicediscovery:GetNamespace",
+				"servicediscovery:GetOperation",
+				"servicediscovery:ListNamespaces",
+				"servicediscovery:ListTagsForResource",
+				"servicediscovery:TagResource",
+				"route53:CreateHostedZone",
+				"route53:DeleteHostedZone",
+				"route53:GetHostedZone",
+				"route53:ListHostedZonesByName",
+				"route53:ChangeTagsForResource",
+			},
+		},
+		{
+			Effect:   iamv1.EffectAllow,
+			Resource: iamv1.Resources{iamv1.Any},
+			Action: iamv1.Actions{
+				"backup:CreateBackupPlan",
+				"backup:UpdateBackupPlan",
+				"backup:DeleteBackupPlan",
+				"backup:GetBackupPlan",
+				"backup:ListBackupPlans",
+				"backup:CreateBackupSelection",
+				"backup:GetBackupSelection",
+				"backup:DeleteBackupSelection",
+				"backup:ListBackupSelections",
+				"backup:DescribeBackupVault",
+				"backup:ListTags",
+				"backup:TagResource",
+			},
+		},
+		{
+			Effect:   iamv1.EffectAllow,
+			Resource: iamv1.Resources{iamv1.Any},
+			Action: iamv1.Actions{
+				"servicequotas:GetServiceQuota",
+				"servicequotas:GetAWSDefaultServiceQuota",
+			},
+		},
+		{
+			Effect: iamv1.EffectAllow,
+			Resource: iamv1.Resources{
+				"arn:*:iam::*:role/*",
+			},
+			Action: iamv1.Actions{
+				"iam:PassRole",
+			},
+			Condition: iamv1.Conditions{
+				iamv1.StringEquals: map[string]string{"iam:PassedToService": "backup.amazonaws.com"},
+			},
+		},
 	}
 	for _, secureSecretBackend := range t.Spec.SecureSecretsBackends {
 		switch secureSecretBackend {
@@ -298,6 +365,30 @@ func (t Template) ControllersPolicyEKS() *iamv1.PolicyDocument {
 		},
 	})
 
+	statement = append(statement, iamv1.StatementEntry{
+		Effect: iamv1.EffectAllow,
+		Resource: iamv1.Resources{
+			"arn:*:ssm:*:*:parameter/cluster.x-k8s.io/*",
+		},
+		Action: iamv1.Actions{
+			"ssm:GetParameter",
+			"ssm:PutParameter",
+			"ssm:DeleteParameter",
+			"ssm:AddTagsToResource",
+			"ssm:ListTagsForResource",
+		},
+	})
+
+	statement = append(statement, iamv1.StatementEntry{
+		Effect: iamv1.EffectAllow,
+		Resource: iamv1.Resources{
+			"*",
+		},
+		Action: iamv1.Actions{
+			"ssm:DescribeParameters",
+		},
+	})
+
 	statement = append(statement, iamv1.StatementEntry{
 		Effect: iamv1.EffectAllow,
 		Action: iamv1.Actions{
@@ -344,6 +435,10 @@ func (t Template) ControllersPolicyEKS() *iamv1.PolicyDocument {
 			"iam:CreateRole",
 			"iam:TagRole",
 			"iam:AttachRolePolicy",
+			"iam:GetRolePolicy",
+			"iam:PutRolePolicy",
+			"iam:DeleteRolePolicy",
+			"iam:ListRolePolicies",
 		}...)
 
 		statement = append(statement, iamv1.StatementEntry{
@@ -353,6 +448,17 @@ func (t Template) ControllersPolicyEKS() *iamv1.PolicyDocument {
 				"iam:AddClientIDToOpenIDConnectProvider",
 				"iam:UpdateOpenIDConnectProviderThumbprint",
 				"iam:DeleteOpenIDConnectProvider",
+				"iam:GetOpenIDConnectProvider",
+				"iam:RemoveClientIDFromOpenIDConnectProvider",
+				"iam:TagOpenIDConnectProvider",
+				"iam:ListOpenIDConnectProviderTags",
+				"iam:ListSAMLProviders",
+				"iam:ListSAMLProviderTags",
+				"iam:CreateSAMLProvider",
+				"iam:GetSAMLProvider",
+				"iam:UpdateSAMLProvider",
+				"iam:DeleteSAMLProvider",
+				"iam:TagSAMLProvider",
 			},
 			Resource: iamv1.Resources{
 				"*",
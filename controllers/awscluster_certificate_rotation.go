@@ -0,0 +1,124 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const (
+	// kubeadmControlPlaneKind is the kind of the self-managed control plane supporting certificate rotation.
+	kubeadmControlPlaneKind = "KubeadmControlPlane"
+
+	certificateRotationRequeueAfter = 30 * time.Second
+)
+
+// reconcileControlPlaneCertificateRotation handles a certificate rotation requested with the
+// ControlPlaneCertificateRotationAnnotation. Control plane machines get new certificates signed by
+// the cluster CA when they are created, so the rotation is performed by asking the KubeadmControlPlane
+// to roll out its machines and waiting until the rollout has completed.
+func (r *AWSClusterReconciler) reconcileControlPlaneCertificateRotation(ctx context.Context, clusterScope *scope.ClusterScope) (reconcile.Result, error) {
+	awsCluster := clusterScope.AWSCluster
+	if _, ok := awsCluster.Annotations[infrav1.ControlPlaneCertificateRotationAnnotation]; !ok {
+		return reconcile.Result{}, nil
+	}
+
+	ref := clusterScope.Cluster.Spec.ControlPlaneRef
+	if ref == nil || ref.Kind != kubeadmControlPlaneKind {
+		conditions.MarkFalse(awsCluster, infrav1.ControlPlaneCertificatesRotatedCondition, infrav1.ControlPlaneCertificatesRotationFailedReason,
+			clusterv1.ConditionSeverityWarning, "certificate rotation is only supported for control planes of kind %s", kubeadmControlPlaneKind)
+		return reconcile.Result{}, nil
+	}
+
+	controlPlane := &unstructured.Unstructured{}
+	controlPlane.SetGroupVersionKind(ref.GroupVersionKind())
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}, controlPlane); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "failed to get control plane %s/%s", ref.Namespace, ref.Name)
+	}
+
+	if conditions.GetReason(awsCluster, infrav1.ControlPlaneCertificatesRotatedCondition) == infrav1.ControlPlaneCertificatesRotationInProgressReason {
+		if !isControlPlaneRolledOut(controlPlane) {
+			clusterScope.Info("Waiting for the control plane rollout to complete to rotate certificates")
+			return reconcile.Result{RequeueAfter: certificateRotationRequeueAfter}, nil
+		}
+
+		clusterScope.Info("Rotated control plane certificates")
+		conditions.MarkTrue(awsCluster, infrav1.ControlPlaneCertificatesRotatedCondition)
+		delete(awsCluster.Annotations, infrav1.ControlPlaneCertificateRotationAnnotation)
+		record.Eventf(awsCluster, "SuccessfulRotateControlPlaneCertificates", "Rotated certificates of control plane %s", ref.Name)
+		return reconcile.Result{}, nil
+	}
+
+	// Only start the rotation once the control plane is stable, so that the machines are
+	// replaced one at a time without losing quorum.
+	if !isControlPlaneRolledOut(controlPlane) {
+		clusterScope.Info("Waiting for the control plane to be stable before rotating certificates")
+		conditions.MarkFalse(awsCluster, infrav1.ControlPlaneCertificatesRotatedCondition, infrav1.ControlPlaneCertificatesRotationPendingReason,
+			clusterv1.ConditionSeverityInfo, "waiting for the control plane to be stable")
+		return reconcile.Result{RequeueAfter: certificateRotationRequeueAfter}, nil
+	}
+
+	clusterScope.Info("Rolling out control plane to rotate certificates", "control-plane", ref.Name)
+	patch := client.MergeFrom(controlPlane.DeepCopy())
+	if err := unstructured.SetNestedField(controlPlane.Object, time.Now().UTC().Format(time.RFC3339), "spec", "rolloutAfter"); err != nil {
+		return reconcile.Result{}, errors.Wrap(err, "failed to set control plane rolloutAfter")
+	}
+	if err := r.Client.Patch(ctx, controlPlane, patch); err != nil {
+		conditions.MarkFalse(awsCluster, infrav1.ControlPlaneCertificatesRotatedCondition, infrav1.ControlPlaneCertificatesRotationFailedReason,
+			clusterv1.ConditionSeverityWarning, err.Error())
+		record.Warnf(awsCluster, "FailedRotateControlPlaneCertificates", "Failed to roll out control plane %s: %v", ref.Name, err)
+		return reconcile.Result{}, errors.Wrapf(err, "failed to roll out control plane %s/%s", ref.Namespace, ref.Name)
+	}
+
+	conditions.MarkFalse(awsCluster, infrav1.ControlPlaneCertificatesRotatedCondition, infrav1.ControlPlaneCertificatesRotationInProgressReason,
+		clusterv1.ConditionSeverityInfo, "rolling out control plane %s", ref.Name)
+	record.Eventf(awsCluster, "RotatingControlPlaneCertificates", "Rolling out control plane %s to rotate certificates", ref.Name)
+
+	return reconcile.Result{RequeueAfter: certificateRotationRequeueAfter}, nil
+}
+
+// isControlPlaneRolledOut returns true when the control plane has observed its latest spec and
+// all of its replicas are up to date and available.
+func isControlPlaneRolledOut(controlPlane *unstructured.Unstructured) bool {
+	observedGeneration, _, _ := unstructured.NestedInt64(controlPlane.Object, "status", "observedGeneration")
+	if observedGeneration < controlPlane.GetGeneration() {
+		return false
+	}
+
+	desired, found, _ := unstructured.NestedInt64(controlPlane.Object, "spec", "replicas")
+	if !found {
+		return false
+	}
+	replicas, _, _ := unstructured.NestedInt64(controlPlane.Object, "status", "replicas")
+	updated, _, _ := unstructured.NestedInt64(controlPlane.Object, "status", "updatedReplicas")
+	ready, _, _ := unstructured.NestedInt64(controlPlane.Object, "status", "readyReplicas")
+	unavailable, _, _ := unstructured.NestedInt64(controlPlane.Object, "status", "unavailableReplicas")
+
+	return replicas == desired && updated == desired && ready == desired && unavailable == 0
+}
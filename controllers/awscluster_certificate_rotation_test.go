@@ -0,0 +1,178 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	kcpv1 "sigs.k8s.io/cluster-api/controlplane/kubeadm/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestAWSClusterReconcileControlPlaneCertificateRotation(t *testing.T) {
+	stableStatus := kcpv1.KubeadmControlPlaneStatus{
+		Replicas:        3,
+		UpdatedReplicas: 3,
+		ReadyReplicas:   3,
+	}
+	rollingStatus := kcpv1.KubeadmControlPlaneStatus{
+		Replicas:            3,
+		UpdatedReplicas:     1,
+		ReadyReplicas:       2,
+		UnavailableReplicas: 1,
+	}
+
+	testCases := []struct {
+		name               string
+		annotated          bool
+		controlPlaneKind   string
+		condition          *clusterv1.Condition
+		controlPlaneStatus kcpv1.KubeadmControlPlaneStatus
+		expectReason       string
+		expectReady        bool
+		expectRolloutAfter bool
+		expectAnnotation   bool
+		expectRequeue      bool
+	}{
+		{
+			name:             "does nothing without the rotation annotation",
+			controlPlaneKind: kubeadmControlPlaneKind,
+		},
+		{
+			name:             "fails for control planes that are not KubeadmControlPlanes",
+			annotated:        true,
+			controlPlaneKind: "AWSManagedControlPlane",
+			expectReason:     infrav1.ControlPlaneCertificatesRotationFailedReason,
+			expectAnnotation: true,
+		},
+		{
+			name:               "waits for an unstable control plane before rolling out",
+			annotated:          true,
+			controlPlaneKind:   kubeadmControlPlaneKind,
+			controlPlaneStatus: rollingStatus,
+			expectReason:       infrav1.ControlPlaneCertificatesRotationPendingReason,
+			expectAnnotation:   true,
+			expectRequeue:      true,
+		},
+		{
+			name:               "rolls out a stable control plane",
+			annotated:          true,
+			controlPlaneKind:   kubeadmControlPlaneKind,
+			controlPlaneStatus: stableStatus,
+			expectReason:       infrav1.ControlPlaneCertificatesRotationInProgressReason,
+			expectRolloutAfter: true,
+			expectAnnotation:   true,
+			expectRequeue:      true,
+		},
+		{
+			name:               "waits for the rollout to complete",
+			annotated:          true,
+			controlPlaneKind:   kubeadmControlPlaneKind,
+			condition:          conditions.FalseCondition(infrav1.ControlPlaneCertificatesRotatedCondition, infrav1.ControlPlaneCertificatesRotationInProgressReason, clusterv1.ConditionSeverityInfo, ""),
+			controlPlaneStatus: rollingStatus,
+			expectReason:       infrav1.ControlPlaneCertificatesRotationInProgressReason,
+			expectAnnotation:   true,
+			expectRequeue:      true,
+		},
+		{
+			name:               "completes the rotation once the rollout has completed",
+			annotated:          true,
+			controlPlaneKind:   kubeadmControlPlaneKind,
+			condition:          conditions.FalseCondition(infrav1.ControlPlaneCertificatesRotatedCondition, infrav1.ControlPlaneCertificatesRotationInProgressReason, clusterv1.ConditionSeverityInfo, ""),
+			controlPlaneStatus: stableStatus,
+			expectReady:        true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			g.Expect(infrav1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(kcpv1.AddToScheme(scheme)).To(Succeed())
+
+			controlPlane := &kcpv1.KubeadmControlPlane{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-control-plane", Namespace: "default"},
+				Spec:       kcpv1.KubeadmControlPlaneSpec{Replicas: pointer.Int32(3)},
+				Status:     tc.controlPlaneStatus,
+			}
+			awsCluster := &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			}
+			if tc.annotated {
+				awsCluster.Annotations = map[string]string{infrav1.ControlPlaneCertificateRotationAnnotation: ""}
+			}
+			if tc.condition != nil {
+				conditions.Set(awsCluster, tc.condition)
+			}
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+				Spec: clusterv1.ClusterSpec{
+					ControlPlaneRef: &corev1.ObjectReference{
+						APIVersion: kcpv1.GroupVersion.String(),
+						Kind:       tc.controlPlaneKind,
+						Name:       "test-control-plane",
+						Namespace:  "default",
+					},
+				},
+			}
+
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(controlPlane, awsCluster).Build()
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:     c,
+				Cluster:    cluster,
+				AWSCluster: awsCluster,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			reconciler := &AWSClusterReconciler{Client: c}
+			result, err := reconciler.reconcileControlPlaneCertificateRotation(context.TODO(), clusterScope)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(result.RequeueAfter > 0).To(Equal(tc.expectRequeue))
+
+			switch {
+			case tc.expectReady:
+				g.Expect(conditions.IsTrue(awsCluster, infrav1.ControlPlaneCertificatesRotatedCondition)).To(BeTrue())
+			case tc.expectReason != "":
+				g.Expect(conditions.GetReason(awsCluster, infrav1.ControlPlaneCertificatesRotatedCondition)).To(Equal(tc.expectReason))
+			default:
+				g.Expect(conditions.Has(awsCluster, infrav1.ControlPlaneCertificatesRotatedCondition)).To(BeFalse())
+			}
+
+			_, annotated := awsCluster.Annotations[infrav1.ControlPlaneCertificateRotationAnnotation]
+			g.Expect(annotated).To(Equal(tc.expectAnnotation))
+
+			updated := &kcpv1.KubeadmControlPlane{}
+			g.Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(controlPlane), updated)).To(Succeed())
+			g.Expect(updated.Spec.RolloutAfter != nil).To(Equal(tc.expectRolloutAfter))
+		})
+	}
+}
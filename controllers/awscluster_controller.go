@@ -42,12 +42,15 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/feature"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/backup"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/elb"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/instancestate"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/network"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/s3"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/securitygroup"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/servicediscovery"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/servicequotas"
 	infrautilconditions "sigs.k8s.io/cluster-api-provider-aws/util/conditions"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
@@ -125,6 +128,7 @@ func (r *AWSClusterReconciler) getSecurityGroupService(scope scope.ClusterScope)
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters;clusters/status,verbs=get;list;watch
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusterroleidentities;awsclusterstaticidentities,verbs=get;list;watch
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclustercontrolleridentities,verbs=get;list;watch;create;
+// +kubebuilder:rbac:groups=controlplane.cluster.x-k8s.io,resources=kubeadmcontrolplanes,verbs=get;list;watch;patch
 
 func (r *AWSClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
 	log := ctrl.LoggerFrom(ctx)
@@ -200,7 +204,7 @@ func (r *AWSClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	}
 
 	// Handle non-deleted clusters
-	return r.reconcileNormal(clusterScope)
+	return r.reconcileNormal(ctx, clusterScope)
 }
 
 func (r *AWSClusterReconciler) reconcileDelete(clusterScope *scope.ClusterScope) (reconcile.Result, error) {
@@ -211,6 +215,8 @@ func (r *AWSClusterReconciler) reconcileDelete(clusterScope *scope.ClusterScope)
 	networkSvc := r.getNetworkService(*clusterScope)
 	sgService := r.getSecurityGroupService(*clusterScope)
 	s3Service := s3.NewService(clusterScope)
+	serviceDiscoverySvc := servicediscovery.NewService(clusterScope)
+	backupSvc := backup.NewService(clusterScope)
 
 	if feature.Gates.Enabled(feature.EventBridgeInstanceState) {
 		instancestateSvc := instancestate.NewService(clusterScope)
@@ -235,6 +241,16 @@ func (r *AWSClusterReconciler) reconcileDelete(clusterScope *scope.ClusterScope)
 		return reconcile.Result{}, err
 	}
 
+	if err := backupSvc.DeleteBackupPlan(); err != nil {
+		clusterScope.Error(err, "error deleting backup plan")
+		return reconcile.Result{}, err
+	}
+
+	if err := serviceDiscoverySvc.DeleteNamespace(); err != nil {
+		clusterScope.Error(err, "error deleting service discovery namespace")
+		return reconcile.Result{}, err
+	}
+
 	if err := networkSvc.DeleteNetwork(); err != nil {
 		clusterScope.Error(err, "error deleting network")
 		return reconcile.Result{}, err
@@ -250,7 +266,7 @@ func (r *AWSClusterReconciler) reconcileDelete(clusterScope *scope.ClusterScope)
 	return reconcile.Result{}, nil
 }
 
-func (r *AWSClusterReconciler) reconcileNormal(clusterScope *scope.ClusterScope) (reconcile.Result, error) {
+func (r *AWSClusterReconciler) reconcileNormal(ctx context.Context, clusterScope *scope.ClusterScope) (reconcile.Result, error) {
 	clusterScope.Info("Reconciling AWSCluster")
 
 	awsCluster := clusterScope.AWSCluster
@@ -267,8 +283,14 @@ func (r *AWSClusterReconciler) reconcileNormal(clusterScope *scope.ClusterScope)
 	networkSvc := r.getNetworkService(*clusterScope)
 	sgService := r.getSecurityGroupService(*clusterScope)
 	s3Service := s3.NewService(clusterScope)
+	serviceDiscoverySvc := servicediscovery.NewService(clusterScope)
+	backupSvc := backup.NewService(clusterScope)
 
 	if err := networkSvc.ReconcileNetwork(); err != nil {
+		if servicequotas.IsInsufficientQuota(err) {
+			clusterScope.Info("Insufficient service quota headroom, not creating network resources", "reason", err.Error())
+			return reconcile.Result{RequeueAfter: servicequotas.InsufficientQuotaRequeueAfter}, nil
+		}
 		clusterScope.Error(err, "failed to reconcile network")
 		return reconcile.Result{}, err
 	}
@@ -285,6 +307,10 @@ func (r *AWSClusterReconciler) reconcileNormal(clusterScope *scope.ClusterScope)
 	}
 
 	if err := ec2Service.ReconcileBastion(); err != nil {
+		if servicequotas.IsInsufficientQuota(err) {
+			clusterScope.Info("Insufficient service quota headroom, not creating bastion host", "reason", err.Error())
+			return reconcile.Result{RequeueAfter: servicequotas.InsufficientQuotaRequeueAfter}, nil
+		}
 		conditions.MarkFalse(awsCluster, infrav1.BastionHostReadyCondition, infrav1.BastionHostFailedReason, infrautilconditions.ErrorConditionAfterInit(clusterScope.ClusterObj()), err.Error())
 		clusterScope.Error(err, "failed to reconcile bastion host")
 		return reconcile.Result{}, err
@@ -309,6 +335,35 @@ func (r *AWSClusterReconciler) reconcileNormal(clusterScope *scope.ClusterScope)
 		return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile S3 Bucket for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
 	}
 
+	// The service discovery namespace is optional, so waiting on its creation doesn't hold back the cluster.
+	var serviceDiscoveryResult reconcile.Result
+	switch err := serviceDiscoverySvc.ReconcileNamespace(); {
+	case errors.Is(err, servicediscovery.ErrNamespaceNotReady):
+		conditions.MarkFalse(awsCluster, infrav1.ServiceDiscoveryNamespaceReadyCondition, infrav1.ServiceDiscoveryNamespaceCreatingReason, clusterv1.ConditionSeverityInfo, "")
+		clusterScope.Info("Waiting on service discovery namespace to be created")
+		serviceDiscoveryResult = reconcile.Result{RequeueAfter: servicediscovery.NamespaceNotReadyRequeueAfter}
+	case err != nil:
+		conditions.MarkFalse(awsCluster, infrav1.ServiceDiscoveryNamespaceReadyCondition, infrav1.ServiceDiscoveryNamespaceFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+		return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile service discovery namespace for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+	case clusterScope.ServiceDiscovery() != nil:
+		conditions.MarkTrue(awsCluster, infrav1.ServiceDiscoveryNamespaceReadyCondition)
+	default:
+		conditions.Delete(awsCluster, infrav1.ServiceDiscoveryNamespaceReadyCondition)
+	}
+
+	if clusterScope.Backup() != nil {
+		if err := backupSvc.ReconcileBackupPlan(); err != nil {
+			conditions.MarkFalse(awsCluster, infrav1.BackupPlanReadyCondition, infrav1.BackupPlanFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+			return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile backup plan for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+		}
+		conditions.MarkTrue(awsCluster, infrav1.BackupPlanReadyCondition)
+	}
+
+	// Cost allocation tags removed from now on are removed from the resources tagged above.
+	if err := clusterScope.SetCostAllocationTagsLastApplied(); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "failed to record cost allocation tags for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+	}
+
 	if awsCluster.Status.Network.APIServerELB.DNSName == "" {
 		conditions.MarkFalse(awsCluster, infrav1.LoadBalancerReadyCondition, infrav1.WaitForDNSNameReason, clusterv1.ConditionSeverityInfo, "")
 		clusterScope.Info("Waiting on API server ELB DNS name")
@@ -342,7 +397,12 @@ func (r *AWSClusterReconciler) reconcileNormal(clusterScope *scope.ClusterScope)
 	}
 
 	awsCluster.Status.Ready = true
-	return reconcile.Result{}, nil
+
+	result, err := r.reconcileControlPlaneCertificateRotation(ctx, clusterScope)
+	if err != nil {
+		return result, err
+	}
+	return util.LowestNonZeroResult(result, serviceDiscoveryResult), nil
 }
 
 func (r *AWSClusterReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
@@ -146,7 +146,7 @@ func TestAWSClusterReconciler_IntegrationTests(t *testing.T) {
 				IsPublic:         false,
 			},
 		})
-		_, err = reconciler.reconcileNormal(cs)
+		_, err = reconciler.reconcileNormal(ctx, cs)
 		g.Expect(err).To(BeNil())
 		g.Expect(cs.VPC().ID).To(Equal("vpc-exists"))
 		expectAWSClusterConditions(g, cs.AWSCluster, []conditionAssertion{
@@ -201,7 +201,7 @@ func TestAWSClusterReconciler_IntegrationTests(t *testing.T) {
 		reconciler.networkServiceFactory = func(clusterScope scope.ClusterScope) services.NetworkInterface {
 			return s
 		}
-		_, err = reconciler.reconcileNormal(cs)
+		_, err = reconciler.reconcileNormal(ctx, cs)
 		g.Expect(err.Error()).To(ContainSubstring("The maximum number of VPCs has been reached"))
 	})
 	t.Run("Should successfully delete AWSCluster with managed VPC", func(t *testing.T) {
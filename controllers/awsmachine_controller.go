@@ -50,6 +50,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/instancestate"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/s3"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/secretsmanager"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/servicequotas"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ssm"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/userdata"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -74,6 +75,7 @@ type AWSMachineReconciler struct {
 	secretsManagerServiceFactory func(cloud.ClusterScoper) services.SecretInterface
 	SSMServiceFactory            func(cloud.ClusterScoper) services.SecretInterface
 	objectStoreServiceFactory    func(cloud.ClusterScoper) services.ObjectStoreInterface
+	serviceQuotaServiceFactory   func(scope.ServiceQuotaScope) services.ServiceQuotaInterface
 	Endpoints                    []scope.ServiceEndpoint
 	WatchFilterValue             string
 }
@@ -131,6 +133,14 @@ func (r *AWSMachineReconciler) getObjectStoreService(scope scope.S3Scope) servic
 	return s3.NewService(scope)
 }
 
+func (r *AWSMachineReconciler) getServiceQuotaService(scope scope.ServiceQuotaScope) services.ServiceQuotaInterface {
+	if r.serviceQuotaServiceFactory != nil {
+		return r.serviceQuotaServiceFactory(scope)
+	}
+
+	return servicequotas.NewService(scope)
+}
+
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines;machines/status,verbs=get;list;watch
@@ -492,6 +502,15 @@ func (r *AWSMachineReconciler) reconcileNormal(_ context.Context, machineScope *
 			objectStoreSvc = r.getObjectStoreService(objectStoreScope)
 		}
 
+		if err := r.reconcileServiceQuotas(machineScope, ec2Scope); err != nil {
+			if servicequotas.IsInsufficientQuota(err) {
+				machineScope.Info("Insufficient service quota headroom, not creating instance", "reason", err.Error())
+				return ctrl.Result{RequeueAfter: servicequotas.InsufficientQuotaRequeueAfter}, nil
+			}
+			machineScope.Error(err, "unable to check service quotas")
+			return ctrl.Result{}, err
+		}
+
 		instance, err = r.createInstance(ec2svc, machineScope, clusterScope, objectStoreSvc)
 		if err != nil {
 			machineScope.Error(err, "unable to create instance")
@@ -632,6 +651,27 @@ func (r *AWSMachineReconciler) deleteEncryptedBootstrapDataSecret(machineScope *
 	return nil
 }
 
+// reconcileServiceQuotas checks the headroom of the service quotas for the instance to create and its network
+// interface, when enabled for the cluster.
+func (r *AWSMachineReconciler) reconcileServiceQuotas(machineScope *scope.MachineScope, ec2Scope scope.EC2Scope) error {
+	if ec2Scope.ServiceQuotaChecks() == nil {
+		return nil
+	}
+
+	req := servicequotas.Request{}
+	// Spot instances have their own quotas.
+	if machineScope.AWSMachine.Spec.SpotMarketOptions == nil {
+		req.InstanceType = machineScope.AWSMachine.Spec.InstanceType
+		req.Instances = 1
+	}
+	// The instance gets a new primary network interface unless it uses existing ones.
+	if len(machineScope.AWSMachine.Spec.NetworkInterfaces) == 0 {
+		req.NetworkInterfaces = 1
+	}
+
+	return r.getServiceQuotaService(ec2Scope).ReconcileHeadroom(machineScope.AWSMachine, req)
+}
+
 func (r *AWSMachineReconciler) createInstance(ec2svc services.EC2Interface, machineScope *scope.MachineScope, clusterScope cloud.ClusterScoper, objectStoreSvc services.ObjectStoreInterface) (*infrav1.Instance, error) {
 	machineScope.Info("Creating EC2 instance")
 
@@ -47,6 +47,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/mock_services"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/servicequotas"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/controllers/noderefutil"
 	capierrors "sigs.k8s.io/cluster-api/errors"
@@ -65,6 +66,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 		elbSvc         *mock_services.MockELBInterface
 		secretSvc      *mock_services.MockSecretInterface
 		objectStoreSvc *mock_services.MockObjectStoreInterface
+		quotaSvc       *mock_services.MockServiceQuotaInterface
 		recorder       *record.FakeRecorder
 	)
 
@@ -165,6 +167,7 @@ func TestAWSMachineReconciler(t *testing.T) {
 		secretSvc = mock_services.NewMockSecretInterface(mockCtrl)
 		elbSvc = mock_services.NewMockELBInterface(mockCtrl)
 		objectStoreSvc = mock_services.NewMockObjectStoreInterface(mockCtrl)
+		quotaSvc = mock_services.NewMockServiceQuotaInterface(mockCtrl)
 
 		// If your test hangs for 9 minutes, increase the value here to the number of events during a reconciliation loop
 		recorder = record.NewFakeRecorder(2)
@@ -179,6 +182,9 @@ func TestAWSMachineReconciler(t *testing.T) {
 			objectStoreServiceFactory: func(cloud.ClusterScoper) services.ObjectStoreInterface {
 				return objectStoreSvc
 			},
+			serviceQuotaServiceFactory: func(scope.ServiceQuotaScope) services.ServiceQuotaInterface {
+				return quotaSvc
+			},
 			Recorder: recorder,
 			Log:      klogr.New(),
 		}
@@ -312,6 +318,45 @@ func TestAWSMachineReconciler(t *testing.T) {
 				g.Expect(ms.AWSMachine.Finalizers).To(ContainElement(infrav1.MachineFinalizer))
 				g.Expect(errors.Cause(err)).To(MatchError(expectedErr))
 			})
+
+			t.Run("should requeue without creating the instance when a service quota doesn't have enough headroom", func(t *testing.T) {
+				g := NewWithT(t)
+				awsMachine := getAWSMachine()
+				setup(t, g, awsMachine)
+				defer teardown(t, g)
+
+				providerID(t, g)
+				cs.AWSCluster.Spec.ServiceQuotaChecks = &infrav1.ServiceQuotaChecks{}
+				ms.AWSMachine.Spec.InstanceType = "m5.large"
+				ec2Svc.EXPECT().InstanceIfExists(gomock.Any()).Return(nil, nil)
+				quotaSvc.EXPECT().ReconcileHeadroom(ms.AWSMachine, servicequotas.Request{
+					InstanceType:      "m5.large",
+					Instances:         1,
+					NetworkInterfaces: 1,
+				}).Return(&servicequotas.InsufficientQuotaError{Quota: infrav1.ServiceQuotaVCPU})
+				ec2Svc.EXPECT().CreateInstance(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+				res, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+				g.Expect(err).To(BeNil())
+				g.Expect(res.RequeueAfter).To(Equal(servicequotas.InsufficientQuotaRequeueAfter))
+			})
+
+			t.Run("should return an error when the service quotas can't be checked", func(t *testing.T) {
+				g := NewWithT(t)
+				awsMachine := getAWSMachine()
+				setup(t, g, awsMachine)
+				defer teardown(t, g)
+
+				providerID(t, g)
+				cs.AWSCluster.Spec.ServiceQuotaChecks = &infrav1.ServiceQuotaChecks{}
+				expectedErr := errors.New("access denied")
+				ec2Svc.EXPECT().InstanceIfExists(gomock.Any()).Return(nil, nil)
+				quotaSvc.EXPECT().ReconcileHeadroom(gomock.Any(), gomock.Any()).Return(expectedErr)
+				ec2Svc.EXPECT().CreateInstance(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+				_, err := reconciler.reconcileNormal(context.Background(), ms, cs, cs, cs, cs)
+				g.Expect(errors.Cause(err)).To(MatchError(expectedErr))
+			})
 		})
 
 		t.Run("should fail to find instance if no provider ID provided", func(t *testing.T) {
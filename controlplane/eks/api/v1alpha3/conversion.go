@@ -44,7 +44,27 @@ func (r *AWSManagedControlPlane) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Status.Bastion = restored.Status.Bastion
 	dst.Spec.OIDCIdentityProviderConfig = restored.Spec.OIDCIdentityProviderConfig
 	dst.Spec.KubeProxy = restored.Spec.KubeProxy
+	dst.Spec.NodeProblemDetector = restored.Spec.NodeProblemDetector
+	dst.Status.Health = restored.Status.Health
+	dst.Spec.CACertificateParameter = restored.Spec.CACertificateParameter
+	dst.Status.CACertificateParameterName = restored.Status.CACertificateParameterName
+	dst.Spec.OperatorAccess = restored.Spec.OperatorAccess
+	dst.Status.OperatorAccess = restored.Status.OperatorAccess
+	dst.Spec.GPUTimeSlicing = restored.Spec.GPUTimeSlicing
 	dst.Spec.VpcCni = restored.Spec.VpcCni
+	dst.Spec.CostAllocationTags = restored.Spec.CostAllocationTags
+	dst.Spec.ServiceQuotaChecks = restored.Spec.ServiceQuotaChecks
+	dst.Spec.KubeconfigExec = restored.Spec.KubeconfigExec
+	dst.Spec.KubernetesNetworkConfig = restored.Spec.KubernetesNetworkConfig
+	dst.Spec.NetworkSpec.VPC.RoutePropagation = restored.Spec.NetworkSpec.VPC.RoutePropagation
+	dst.Spec.NetworkSpec.VPC.InstanceTenancy = restored.Spec.NetworkSpec.VPC.InstanceTenancy
+	dst.Spec.NetworkSpec.VPC.BlackholeCIDRs = restored.Spec.NetworkSpec.VPC.BlackholeCIDRs
+	dst.Status.Network.BlackholeNetworkInterfaceID = restored.Status.Network.BlackholeNetworkInterfaceID
+	dst.Status.Network.RoutePropagationGatewayIDs = restored.Status.Network.RoutePropagationGatewayIDs
+	dst.Spec.NetworkSpec.VPC.NatGateway = restored.Spec.NetworkSpec.VPC.NatGateway
+	dst.Spec.NetworkSpec.VPC.SubnetFreeIPThreshold = restored.Spec.NetworkSpec.VPC.SubnetFreeIPThreshold
+	dst.Spec.NetworkSpec.VPC.SubnetTiers = restored.Spec.NetworkSpec.VPC.SubnetTiers
+	infrav1alpha3.RestoreSubnets(restored.Spec.NetworkSpec.Subnets, dst.Spec.NetworkSpec.Subnets)
 
 	return nil
 }
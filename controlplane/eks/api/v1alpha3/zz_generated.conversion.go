@@ -347,6 +347,8 @@ func autoConvert_v1beta1_AWSManagedControlPlaneSpec_To_v1alpha3_AWSManagedContro
 	out.Logging = (*ControlPlaneLoggingSpec)(unsafe.Pointer(in.Logging))
 	out.EncryptionConfig = (*EncryptionConfig)(unsafe.Pointer(in.EncryptionConfig))
 	out.AdditionalTags = *(*apiv1alpha3.Tags)(unsafe.Pointer(&in.AdditionalTags))
+	// WARNING: in.CostAllocationTags requires manual conversion: does not exist in peer-type
+	// WARNING: in.ServiceQuotaChecks requires manual conversion: does not exist in peer-type
 	out.IAMAuthenticatorConfig = (*IAMAuthenticatorConfig)(unsafe.Pointer(in.IAMAuthenticatorConfig))
 	if err := Convert_v1beta1_EndpointAccess_To_v1alpha3_EndpointAccess(&in.EndpointAccess, &out.EndpointAccess, s); err != nil {
 		return err
@@ -361,12 +363,18 @@ func autoConvert_v1beta1_AWSManagedControlPlaneSpec_To_v1alpha3_AWSManagedContro
 		return err
 	}
 	out.TokenMethod = (*EKSTokenMethod)(unsafe.Pointer(in.TokenMethod))
+	// WARNING: in.KubeconfigExec requires manual conversion: does not exist in peer-type
 	out.AssociateOIDCProvider = in.AssociateOIDCProvider
 	out.Addons = (*[]Addon)(unsafe.Pointer(in.Addons))
 	// WARNING: in.OIDCIdentityProviderConfig requires manual conversion: does not exist in peer-type
 	out.DisableVPCCNI = in.DisableVPCCNI
 	// WARNING: in.VpcCni requires manual conversion: does not exist in peer-type
 	// WARNING: in.KubeProxy requires manual conversion: does not exist in peer-type
+	// WARNING: in.KubernetesNetworkConfig requires manual conversion: does not exist in peer-type
+	// WARNING: in.NodeProblemDetector requires manual conversion: does not exist in peer-type
+	// WARNING: in.CACertificateParameter requires manual conversion: does not exist in peer-type
+	// WARNING: in.OperatorAccess requires manual conversion: does not exist in peer-type
+	// WARNING: in.GPUTimeSlicing requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -469,6 +477,9 @@ func autoConvert_v1beta1_AWSManagedControlPlaneStatus_To_v1alpha3_AWSManagedCont
 	}
 	out.Addons = *(*[]AddonState)(unsafe.Pointer(&in.Addons))
 	// WARNING: in.IdentityProviderStatus requires manual conversion: does not exist in peer-type
+	// WARNING: in.Health requires manual conversion: does not exist in peer-type
+	// WARNING: in.CACertificateParameterName requires manual conversion: does not exist in peer-type
+	// WARNING: in.OperatorAccess requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -41,7 +41,27 @@ func (r *AWSManagedControlPlane) ConvertTo(dstRaw conversion.Hub) error {
 	}
 
 	dst.Spec.KubeProxy = restored.Spec.KubeProxy
+	dst.Spec.NodeProblemDetector = restored.Spec.NodeProblemDetector
+	dst.Status.Health = restored.Status.Health
+	dst.Spec.CACertificateParameter = restored.Spec.CACertificateParameter
+	dst.Status.CACertificateParameterName = restored.Status.CACertificateParameterName
+	dst.Spec.OperatorAccess = restored.Spec.OperatorAccess
+	dst.Status.OperatorAccess = restored.Status.OperatorAccess
+	dst.Spec.GPUTimeSlicing = restored.Spec.GPUTimeSlicing
 	dst.Spec.VpcCni = restored.Spec.VpcCni
+	dst.Spec.CostAllocationTags = restored.Spec.CostAllocationTags
+	dst.Spec.ServiceQuotaChecks = restored.Spec.ServiceQuotaChecks
+	dst.Spec.KubeconfigExec = restored.Spec.KubeconfigExec
+	dst.Spec.KubernetesNetworkConfig = restored.Spec.KubernetesNetworkConfig
+	dst.Spec.NetworkSpec.VPC.RoutePropagation = restored.Spec.NetworkSpec.VPC.RoutePropagation
+	dst.Spec.NetworkSpec.VPC.InstanceTenancy = restored.Spec.NetworkSpec.VPC.InstanceTenancy
+	dst.Spec.NetworkSpec.VPC.BlackholeCIDRs = restored.Spec.NetworkSpec.VPC.BlackholeCIDRs
+	dst.Status.Network.BlackholeNetworkInterfaceID = restored.Status.Network.BlackholeNetworkInterfaceID
+	dst.Status.Network.RoutePropagationGatewayIDs = restored.Status.Network.RoutePropagationGatewayIDs
+	dst.Spec.NetworkSpec.VPC.NatGateway = restored.Spec.NetworkSpec.VPC.NatGateway
+	dst.Spec.NetworkSpec.VPC.SubnetFreeIPThreshold = restored.Spec.NetworkSpec.VPC.SubnetFreeIPThreshold
+	dst.Spec.NetworkSpec.VPC.SubnetTiers = restored.Spec.NetworkSpec.VPC.SubnetTiers
+	infrav1alpha4.RestoreSubnets(restored.Spec.NetworkSpec.Subnets, dst.Spec.NetworkSpec.Subnets)
 
 	return nil
 }
@@ -128,3 +148,7 @@ func Convert_v1alpha4_Instance_To_v1beta1_Instance(in *infrav1alpha4.Instance, o
 func Convert_v1beta1_AWSManagedControlPlaneSpec_To_v1alpha4_AWSManagedControlPlaneSpec(in *v1beta1.AWSManagedControlPlaneSpec, out *AWSManagedControlPlaneSpec, scope apiconversion.Scope) error {
 	return autoConvert_v1beta1_AWSManagedControlPlaneSpec_To_v1alpha4_AWSManagedControlPlaneSpec(in, out, scope)
 }
+
+func Convert_v1beta1_AWSManagedControlPlaneStatus_To_v1alpha4_AWSManagedControlPlaneStatus(in *v1beta1.AWSManagedControlPlaneStatus, out *AWSManagedControlPlaneStatus, scope apiconversion.Scope) error {
+	return autoConvert_v1beta1_AWSManagedControlPlaneStatus_To_v1alpha4_AWSManagedControlPlaneStatus(in, out, scope)
+}
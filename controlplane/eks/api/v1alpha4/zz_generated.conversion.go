@@ -70,11 +70,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta1.AWSManagedControlPlaneStatus)(nil), (*AWSManagedControlPlaneStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_AWSManagedControlPlaneStatus_To_v1alpha4_AWSManagedControlPlaneStatus(a.(*v1beta1.AWSManagedControlPlaneStatus), b.(*AWSManagedControlPlaneStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*Addon)(nil), (*v1beta1.Addon)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha4_Addon_To_v1beta1_Addon(a.(*Addon), b.(*v1beta1.Addon), scope)
 	}); err != nil {
@@ -230,6 +225,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta1.AWSManagedControlPlaneStatus)(nil), (*AWSManagedControlPlaneStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AWSManagedControlPlaneStatus_To_v1alpha4_AWSManagedControlPlaneStatus(a.(*v1beta1.AWSManagedControlPlaneStatus), b.(*AWSManagedControlPlaneStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*apiv1beta1.Bastion)(nil), (*apiv1alpha4.Bastion)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Bastion_To_v1alpha4_Bastion(a.(*apiv1beta1.Bastion), b.(*apiv1alpha4.Bastion), scope)
 	}); err != nil {
@@ -245,11 +245,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddConversionFunc((*apiv1beta1.NetworkStatus)(nil), (*apiv1alpha4.NetworkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_NetworkStatus_To_v1alpha4_NetworkStatus(a.(*apiv1beta1.NetworkStatus), b.(*apiv1alpha4.NetworkStatus), scope)
-	}); err != nil {
-		return err
-	}
 	return nil
 }
 
@@ -383,6 +378,8 @@ func autoConvert_v1beta1_AWSManagedControlPlaneSpec_To_v1alpha4_AWSManagedContro
 	out.Logging = (*ControlPlaneLoggingSpec)(unsafe.Pointer(in.Logging))
 	out.EncryptionConfig = (*EncryptionConfig)(unsafe.Pointer(in.EncryptionConfig))
 	out.AdditionalTags = *(*apiv1alpha4.Tags)(unsafe.Pointer(&in.AdditionalTags))
+	// WARNING: in.CostAllocationTags requires manual conversion: does not exist in peer-type
+	// WARNING: in.ServiceQuotaChecks requires manual conversion: does not exist in peer-type
 	out.IAMAuthenticatorConfig = (*IAMAuthenticatorConfig)(unsafe.Pointer(in.IAMAuthenticatorConfig))
 	if err := Convert_v1beta1_EndpointAccess_To_v1alpha4_EndpointAccess(&in.EndpointAccess, &out.EndpointAccess, s); err != nil {
 		return err
@@ -397,12 +394,18 @@ func autoConvert_v1beta1_AWSManagedControlPlaneSpec_To_v1alpha4_AWSManagedContro
 		return err
 	}
 	out.TokenMethod = (*EKSTokenMethod)(unsafe.Pointer(in.TokenMethod))
+	// WARNING: in.KubeconfigExec requires manual conversion: does not exist in peer-type
 	out.AssociateOIDCProvider = in.AssociateOIDCProvider
 	out.Addons = (*[]Addon)(unsafe.Pointer(in.Addons))
 	out.OIDCIdentityProviderConfig = (*OIDCIdentityProviderConfig)(unsafe.Pointer(in.OIDCIdentityProviderConfig))
 	out.DisableVPCCNI = in.DisableVPCCNI
 	// WARNING: in.VpcCni requires manual conversion: does not exist in peer-type
 	// WARNING: in.KubeProxy requires manual conversion: does not exist in peer-type
+	// WARNING: in.KubernetesNetworkConfig requires manual conversion: does not exist in peer-type
+	// WARNING: in.NodeProblemDetector requires manual conversion: does not exist in peer-type
+	// WARNING: in.CACertificateParameter requires manual conversion: does not exist in peer-type
+	// WARNING: in.OperatorAccess requires manual conversion: does not exist in peer-type
+	// WARNING: in.GPUTimeSlicing requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -463,7 +466,7 @@ func Convert_v1alpha4_AWSManagedControlPlaneStatus_To_v1beta1_AWSManagedControlP
 }
 
 func autoConvert_v1beta1_AWSManagedControlPlaneStatus_To_v1alpha4_AWSManagedControlPlaneStatus(in *v1beta1.AWSManagedControlPlaneStatus, out *AWSManagedControlPlaneStatus, s conversion.Scope) error {
-	if err := Convert_v1beta1_NetworkStatus_To_v1alpha4_NetworkStatus(&in.Network, &out.Network, s); err != nil {
+	if err := apiv1alpha4.Convert_v1beta1_NetworkStatus_To_v1alpha4_NetworkStatus(&in.Network, &out.Network, s); err != nil {
 		return err
 	}
 	if in.FailureDomains != nil {
@@ -510,14 +513,12 @@ func autoConvert_v1beta1_AWSManagedControlPlaneStatus_To_v1alpha4_AWSManagedCont
 	if err := Convert_v1beta1_IdentityProviderStatus_To_v1alpha4_IdentityProviderStatus(&in.IdentityProviderStatus, &out.IdentityProviderStatus, s); err != nil {
 		return err
 	}
+	// WARNING: in.Health requires manual conversion: does not exist in peer-type
+	// WARNING: in.CACertificateParameterName requires manual conversion: does not exist in peer-type
+	// WARNING: in.OperatorAccess requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta1_AWSManagedControlPlaneStatus_To_v1alpha4_AWSManagedControlPlaneStatus is an autogenerated conversion function.
-func Convert_v1beta1_AWSManagedControlPlaneStatus_To_v1alpha4_AWSManagedControlPlaneStatus(in *v1beta1.AWSManagedControlPlaneStatus, out *AWSManagedControlPlaneStatus, s conversion.Scope) error {
-	return autoConvert_v1beta1_AWSManagedControlPlaneStatus_To_v1alpha4_AWSManagedControlPlaneStatus(in, out, s)
-}
-
 func autoConvert_v1alpha4_Addon_To_v1beta1_Addon(in *Addon, out *v1beta1.Addon, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Version = in.Version
@@ -19,6 +19,7 @@ package v1beta1
 import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -93,6 +94,22 @@ type AWSManagedControlPlaneSpec struct { //nolint: maligned
 	// +optional
 	AdditionalTags infrav1.Tags `json:"additionalTags,omitempty"`
 
+	// CostAllocationTags is an optional set of tags used for cost allocation, e.g. the cost center or the
+	// team owning the cluster. They are added to every AWS resource managed by the AWS provider for the
+	// cluster, including the resources of its node groups, Fargate profiles and machines, and take precedence over the additional tags.
+	// The keys can't be set in the additional tags too. Removed keys are removed from the resources as well.
+	// +optional
+	CostAllocationTags infrav1.Tags `json:"costAllocationTags,omitempty"`
+
+	// ServiceQuotaChecks enables checking the headroom of the AWS Service Quotas before creating
+	// the instances, network interfaces, Elastic IPs and NAT gateways of the cluster, i.e. of its
+	// network, bastion host, machines, machine pools and managed node groups. When a quota doesn't
+	// have enough headroom, the resources aren't created, the ServiceQuotaHeadroom condition of the
+	// object reports the quota and the reconciliation is retried later. The controller needs the servicequotas:GetServiceQuota,
+	// servicequotas:GetAWSDefaultServiceQuota and ec2:DescribeInstanceTypes permissions.
+	// +optional
+	ServiceQuotaChecks *infrav1.ServiceQuotaChecks `json:"serviceQuotaChecks,omitempty"`
+
 	// IAMAuthenticatorConfig allows the specification of any additional user or role mappings
 	// for use when generating the aws-iam-authenticator configuration. If this is nil the
 	// default configuration is still generated for the cluster.
@@ -146,6 +163,11 @@ type AWSManagedControlPlaneSpec struct { //nolint: maligned
 	// +kubebuilder:validation:Enum=iam-authenticator;aws-cli
 	TokenMethod *EKSTokenMethod `json:"tokenMethod,omitempty"`
 
+	// KubeconfigExec configures the exec plugin of the user kubeconfig, whose command is
+	// chosen by TokenMethod. The user kubeconfig secret is updated when it changes.
+	// +optional
+	KubeconfigExec *KubeconfigExec `json:"kubeconfigExec,omitempty"`
+
 	// AssociateOIDCProvider can be enabled to automatically create an identity
 	// provider for the controller for use with IAM roles for service accounts
 	// +kubebuilder:default=false
@@ -174,6 +196,211 @@ type AWSManagedControlPlaneSpec struct { //nolint: maligned
 
 	// KubeProxy defines managed attributes of the kube-proxy daemonset
 	KubeProxy KubeProxy `json:"kubeProxy,omitempty"`
+
+	// KubernetesNetworkConfig specifies the Kubernetes network configuration of the EKS cluster.
+	// +optional
+	KubernetesNetworkConfig *KubernetesNetworkConfig `json:"kubernetesNetworkConfig,omitempty"`
+
+	// NodeProblemDetector installs node-problem-detector as a DaemonSet in the cluster to surface
+	// node level faults as node conditions and events.
+	// +optional
+	NodeProblemDetector *NodeProblemDetector `json:"nodeProblemDetector,omitempty"`
+
+	// CACertificateParameter publishes the CA certificate of the cluster to an SSM parameter, for
+	// tools that bootstrap against the cluster. The parameter is updated when the CA is rotated
+	// and deleted with the cluster.
+	// +optional
+	CACertificateParameter *CACertificateParameter `json:"caCertificateParameter,omitempty"`
+
+	// OperatorAccess creates an IAM identity provider and roles in the cluster account that human
+	// operators federate into from an external SSO identity provider. This is separate from the
+	// OIDC provider used for IAM roles for service accounts. Requires the EKSEnableIAM feature flag.
+	// +optional
+	OperatorAccess *OperatorAccess `json:"operatorAccess,omitempty"`
+
+	// GPUTimeSlicing reconciles the ConfigMap the NVIDIA device plugin reads its configuration
+	// from, so that pods on GPU nodes share each GPU by time-slicing. The device plugin itself
+	// isn't installed and must be deployed with the name of this ConfigMap as its config.
+	// +optional
+	GPUTimeSlicing *GPUTimeSlicing `json:"gpuTimeSlicing,omitempty"`
+}
+
+// GPUTimeSlicing defines the time-slicing configuration of the NVIDIA device plugin.
+type GPUTimeSlicing struct {
+	// ConfigMapName is the name of the device plugin ConfigMap.
+	// +kubebuilder:default=nvidia-device-plugin-config
+	// +optional
+	ConfigMapName string `json:"configMapName,omitempty"`
+
+	// Namespace is the namespace the device plugin runs in.
+	// +kubebuilder:default=kube-system
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Replicas is the number of pods each GPU is shared between.
+	// +kubebuilder:validation:Minimum=2
+	Replicas int32 `json:"replicas"`
+
+	// RenameByDefault advertises the shared GPUs as nvidia.com/gpu.shared instead of
+	// nvidia.com/gpu, so that workloads opt in to shared GPUs explicitly.
+	// +optional
+	RenameByDefault bool `json:"renameByDefault,omitempty"`
+}
+
+// OperatorAccess defines the IAM identity provider and roles used for operator SSO access.
+// Exactly one of SAML or OIDC must be set.
+type OperatorAccess struct {
+	// SAML configures an IAM SAML identity provider.
+	// +optional
+	SAML *OperatorAccessSAMLProvider `json:"saml,omitempty"`
+
+	// OIDC configures an IAM OpenID Connect identity provider.
+	// +optional
+	OIDC *OperatorAccessOIDCProvider `json:"oidc,omitempty"`
+
+	// Roles are the IAM roles operators can assume through the identity provider.
+	// +kubebuilder:validation:MinItems=1
+	Roles []OperatorAccessRole `json:"roles"`
+}
+
+// OperatorAccessSAMLProvider defines an IAM SAML identity provider.
+type OperatorAccessSAMLProvider struct {
+	// Name is the name of the SAML provider in IAM.
+	// +kubebuilder:validation:Pattern=`^[\w._-]+$`
+	// +kubebuilder:validation:MaxLength=128
+	Name string `json:"name"`
+
+	// MetadataDocument is the SAML metadata XML document issued by the identity provider.
+	// +kubebuilder:validation:MinLength=1000
+	MetadataDocument string `json:"metadataDocument"`
+}
+
+// OperatorAccessOIDCProvider defines an IAM OpenID Connect identity provider.
+type OperatorAccessOIDCProvider struct {
+	// IssuerURL is the URL of the OpenID Connect issuer. It can't be changed in place, the
+	// provider is replaced instead.
+	// +kubebuilder:validation:Pattern=`^https://`
+	IssuerURL string `json:"issuerURL"`
+
+	// ClientIDs are the audiences of the ID tokens accepted by the provider.
+	// +kubebuilder:validation:MinItems=1
+	ClientIDs []string `json:"clientIDs"`
+
+	// Thumbprints are the SHA-1 thumbprints of the certificates of the issuer.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=5
+	Thumbprints []string `json:"thumbprints"`
+}
+
+// OperatorAccessRole defines an IAM role operators can assume.
+type OperatorAccessRole struct {
+	// Name is the name of the IAM role.
+	// +kubebuilder:validation:MaxLength=64
+	Name string `json:"name"`
+
+	// Subjects are the federated identities allowed to assume the role, matched against the
+	// sub claim of OIDC ID tokens or the subject of SAML assertions. Wildcards are supported.
+	// +kubebuilder:validation:MinItems=1
+	Subjects []string `json:"subjects"`
+
+	// PolicyARNs are the ARNs of the managed policies attached to the role. Requires the
+	// AllowAdditionalRoles feature flag.
+	// +optional
+	PolicyARNs []string `json:"policyARNs,omitempty"`
+}
+
+// CACertificateParameterPrefix is the path the CA certificate parameter must be created under.
+// The controller policy only grants write access to parameters beneath it.
+const CACertificateParameterPrefix = "/cluster.x-k8s.io/"
+
+// CACertificateParameter defines the SSM parameter the cluster CA certificate is published to.
+type CACertificateParameter struct {
+	// Name is the fully qualified name of the parameter and must begin with
+	// /cluster.x-k8s.io/, e.g. /cluster.x-k8s.io/prod/ca.crt.
+	// +kubebuilder:validation:Pattern=`^/[A-Za-z0-9_.\-/]+$`
+	// +kubebuilder:validation:MaxLength=1011
+	Name string `json:"name"`
+	// KMSKeyID is the ID or ARN of the KMS key the SecureString parameter is encrypted with.
+	// Defaults to the AWS managed key of the account for SSM.
+	// +optional
+	KMSKeyID string `json:"kmsKeyID,omitempty"`
+}
+
+// NodeProblemDetector specifies how node-problem-detector is installed in the cluster.
+type NodeProblemDetector struct {
+	// Image is the node-problem-detector container image.
+	// +kubebuilder:default="registry.k8s.io/node-problem-detector/node-problem-detector:v0.8.12"
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Monitors are the names of the system log monitor configurations shipped with the
+	// node-problem-detector image to enable, e.g. kernel-monitor or docker-monitor.
+	// +kubebuilder:default={"kernel-monitor"}
+	// +optional
+	Monitors []string `json:"monitors,omitempty"`
+
+	// Rules are additional rules matched against the kernel log of the nodes.
+	// +optional
+	Rules []NodeProblemDetectorRule `json:"rules,omitempty"`
+}
+
+// NodeProblemDetectorRuleType defines how a problem matched by a rule is reported.
+type NodeProblemDetectorRuleType string
+
+const (
+	// NodeProblemDetectorRuleTemporary reports a matched problem as a node event.
+	NodeProblemDetectorRuleTemporary = NodeProblemDetectorRuleType("temporary")
+	// NodeProblemDetectorRulePermanent reports a matched problem as a node condition.
+	NodeProblemDetectorRulePermanent = NodeProblemDetectorRuleType("permanent")
+)
+
+// NodeProblemDetectorRule is a kernel log rule of node-problem-detector.
+type NodeProblemDetectorRule struct {
+	// Type is temporary for problems reported as node events and permanent for problems
+	// reported as node conditions.
+	// +kubebuilder:validation:Enum=temporary;permanent
+	Type NodeProblemDetectorRuleType `json:"type"`
+
+	// Condition is the node condition set when a permanent rule matches.
+	// +optional
+	Condition string `json:"condition,omitempty"`
+
+	// Reason is the short reason reported when the rule matches.
+	// +kubebuilder:validation:MinLength=1
+	Reason string `json:"reason"`
+
+	// Pattern is the regular expression matched against the kernel log messages.
+	// +kubebuilder:validation:MinLength=1
+	Pattern string `json:"pattern"`
+}
+
+// KubernetesNetworkConfig specifies the Kubernetes network configuration of the EKS cluster.
+type KubernetesNetworkConfig struct {
+	// IPFamily is the IP family used to assign Kubernetes pod and service addresses. Defaults to ipv4.
+	// The ipv6 family requires Kubernetes 1.21 or greater, the vpc-cni addon and an existing VPC
+	// with an IPv6 CIDR block. With ipv6, EKS assigns service addresses from the IPv6 unique local
+	// address range and the services CIDR of the cluster network is ignored.
+	// +kubebuilder:validation:Enum=ipv4;ipv6
+	// +optional
+	IPFamily EKSIPFamily `json:"ipFamily,omitempty"`
+}
+
+// EKSIPFamily defines the IP family of the Kubernetes pod and service addresses of an EKS cluster.
+type EKSIPFamily string
+
+const (
+	// EKSIPFamilyIPv4 assigns IPv4 addresses to pods and services.
+	EKSIPFamilyIPv4 = EKSIPFamily("ipv4")
+	// EKSIPFamilyIPv6 assigns IPv6 addresses to pods and services.
+	EKSIPFamilyIPv6 = EKSIPFamily("ipv6")
+)
+
+// GetIPFamily returns the IP family of the cluster, defaulting to ipv4.
+func (c *KubernetesNetworkConfig) GetIPFamily() EKSIPFamily {
+	if c == nil || c.IPFamily == "" {
+		return EKSIPFamilyIPv4
+	}
+	return c.IPFamily
 }
 
 // KubeProxy specifies how the kube-proxy daemonset is managed.
@@ -192,6 +419,101 @@ type VpcCni struct {
 	// Env defines a list of environment variables to apply to the `aws-node` DaemonSet
 	// +optional
 	Env []corev1.EnvVar `json:"env,omitempty"`
+	// PodDisruptionBudget configures a PodDisruptionBudget for the `aws-node` DaemonSet.
+	// If not specified no PodDisruptionBudget is created, and any previously created one is removed.
+	// An aws-node PodDisruptionBudget not created by CAPA is left untouched. The PodDisruptionBudget
+	// requires Kubernetes 1.21 or later and is skipped on earlier versions.
+	// +optional
+	PodDisruptionBudget *VpcCniPodDisruptionBudget `json:"podDisruptionBudget,omitempty"`
+	// ENIConfigLabel is the node label used by the VPC CNI to select the ENIConfig of a node when
+	// custom networking is enabled with a SecondaryCidrBlock. The ENIConfigs are named after the
+	// availability zones, so the label must hold the zone of the node. It is set in both the
+	// amazon-vpc-cni ConfigMap and the ENI_CONFIG_LABEL_DEF environment variable of the `aws-node` DaemonSet.
+	// Defaults to failure-domain.beta.kubernetes.io/zone.
+	// +kubebuilder:validation:Enum=failure-domain.beta.kubernetes.io/zone;topology.kubernetes.io/zone
+	// +optional
+	ENIConfigLabel string `json:"eniConfigLabel,omitempty"`
+	// SecurityContext hardens the security context of the `aws-node` container. The aws-vpc-cni-init
+	// init container is left privileged as it sets the sysctls required by the VPC CNI.
+	// +optional
+	SecurityContext *VpcCniSecurityContext `json:"securityContext,omitempty"`
+	// PodMTU is the MTU of the pod network interfaces, set as the AWS_VPC_ENI_MTU environment
+	// variable of the `aws-node` DaemonSet. It can't be combined with AWS_VPC_ENI_MTU in Env.
+	// Unsetting it removes the environment variable, restoring the VPC CNI default.
+	// +kubebuilder:validation:Minimum=576
+	// +kubebuilder:validation:Maximum=9001
+	// +optional
+	PodMTU *int `json:"podMTU,omitempty"`
+	// Rollout rolls changes of the `aws-node` DaemonSet out gradually. The DaemonSet is switched to
+	// the OnDelete update strategy and the outdated pods are restarted in batches, the next batch
+	// once the pods of the previous one are ready.
+	// +optional
+	Rollout *VpcCniRollout `json:"rollout,omitempty"`
+	// PriorityClassName is the priority class of the `aws-node` pods, so that they are scheduled
+	// ahead of and are not preempted by the workloads relying on them.
+	// Defaults to system-node-critical.
+	// +kubebuilder:default=system-node-critical
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+	// Affinity replaces the scheduling constraints of the `aws-node` pods, e.g. to keep them off
+	// the nodes of a given compute type with a node affinity.
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+	// PodAnnotations are added to the `aws-node` pods. Existing annotations with other keys are
+	// left untouched.
+	// +optional
+	PodAnnotations map[string]string `json:"podAnnotations,omitempty"`
+	// MetricsHelper installs the cni-metrics-helper, which pushes the ENI and IP address metrics of
+	// the VPC CNI to CloudWatch. If not specified the metrics helper is not installed, and any
+	// previously installed one is removed.
+	// +optional
+	MetricsHelper *VpcCniMetricsHelper `json:"metricsHelper,omitempty"`
+}
+
+// VpcCniMetricsHelper defines the cni-metrics-helper Deployment.
+type VpcCniMetricsHelper struct {
+	// RoleARN is the IAM role assumed by the metrics helper through IAM roles for service accounts.
+	// It must allow cloudwatch:PutMetricData and trust the OIDC provider of the cluster.
+	// +kubebuilder:validation:Pattern=`^arn:aws[a-z-]*:iam::[0-9]{12}:role/.+$`
+	RoleARN string `json:"roleARN"`
+	// Image is the cni-metrics-helper image. Defaults to the image of the VPC CNI version of the
+	// `aws-node` DaemonSet.
+	// +optional
+	Image string `json:"image,omitempty"`
+}
+
+// VpcCniRollout defines how changes of the `aws-node` DaemonSet are rolled out.
+type VpcCniRollout struct {
+	// BatchSize is the number of outdated `aws-node` pods restarted at a time.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=1
+	// +optional
+	BatchSize int32 `json:"batchSize,omitempty"`
+}
+
+// VpcCniSecurityContext defines the security context applied to the `aws-node` container.
+// Privilege escalation is disabled when it is set, unless the container is privileged or has the
+// SYS_ADMIN capability.
+type VpcCniSecurityContext struct {
+	// DropCapabilities are the capabilities dropped from the `aws-node` container, e.g. SYS_ADMIN.
+	// NET_ADMIN and NET_RAW are required by the VPC CNI to manage routes and iptables rules, so they
+	// are always added to the container. They can't be dropped, with or without the CAP_ prefix,
+	// and neither can ALL.
+	// +optional
+	DropCapabilities []corev1.Capability `json:"dropCapabilities,omitempty"`
+	// ReadOnlyRootFilesystem mounts the root filesystem of the `aws-node` container read-only.
+	// The VPC CNI only writes to its host path volumes.
+	// +optional
+	ReadOnlyRootFilesystem bool `json:"readOnlyRootFilesystem,omitempty"`
+}
+
+// VpcCniPodDisruptionBudget specifies the PodDisruptionBudget for the `aws-node` DaemonSet.
+type VpcCniPodDisruptionBudget struct {
+	// MinAvailable is the number or percentage of `aws-node` pods that must remain available
+	// during a voluntary disruption. Defaults to 1.
+	// +kubebuilder:validation:XIntOrString
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
 }
 
 // EndpointAccess specifies how control plane endpoints are accessible.
@@ -270,6 +592,27 @@ type AWSManagedControlPlaneStatus struct {
 	// associated identity provider
 	// +optional
 	IdentityProviderStatus IdentityProviderStatus `json:"identityProviderStatus,omitempty"`
+	// Health is the summary of the cluster health as seen by the controller, computed on
+	// each reconcile.
+	// +optional
+	Health *HealthSummary `json:"health,omitempty"`
+	// CACertificateParameterName is the name of the SSM parameter the cluster CA certificate
+	// has been published to.
+	// +optional
+	CACertificateParameterName string `json:"caCertificateParameterName,omitempty"`
+	// OperatorAccess holds the status of the operator access identity provider and roles.
+	// +optional
+	OperatorAccess *OperatorAccessStatus `json:"operatorAccess,omitempty"`
+}
+
+// OperatorAccessStatus holds the status of the operator access identity provider and roles.
+type OperatorAccessStatus struct {
+	// ProviderARN is the ARN of the IAM identity provider.
+	// +optional
+	ProviderARN string `json:"providerARN,omitempty"`
+	// Roles are the names of the IAM roles created for operator access.
+	// +optional
+	Roles []string `json:"roles,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -281,6 +624,7 @@ type AWSManagedControlPlaneStatus struct {
 // +kubebuilder:printcolumn:name="VPC",type="string",JSONPath=".spec.network.vpc.id",description="AWS VPC the control plane is using"
 // +kubebuilder:printcolumn:name="Endpoint",type="string",JSONPath=".spec.controlPlaneEndpoint.host",description="API Endpoint",priority=1
 // +kubebuilder:printcolumn:name="Bastion IP",type="string",JSONPath=".status.bastion.publicIp",description="Bastion IP address for breakglass access"
+// +kubebuilder:printcolumn:name="Health",type="string",JSONPath=".status.health.overall",description="Overall health of the cluster",priority=1
 
 // AWSManagedControlPlane is the schema for the Amazon EKS Managed Control Plane API.
 type AWSManagedControlPlane struct {
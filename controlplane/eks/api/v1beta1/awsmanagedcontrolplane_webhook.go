@@ -19,9 +19,12 @@ package v1beta1
 import (
 	"fmt"
 	"net"
+	"regexp"
+	"strings"
 
 	"github.com/apparentlymart/go-cidr/cidr"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -36,6 +39,7 @@ import (
 
 const (
 	minAddonVersion      = "v1.18.0"
+	minIPv6Version       = "v1.21.0"
 	maxClusterNameLength = 100
 )
 
@@ -47,8 +51,14 @@ const (
 	cidrSizeMin    = 16
 	vpcCniAddon    = "vpc-cni"
 	kubeProxyAddon = "kube-proxy"
+	podMTUEnvName  = "AWS_VPC_ENI_MTU"
+	minPodMTU      = 576
+	maxPodMTU      = 9001
 )
 
+// vpcCniRequiredCapabilities are the capabilities the aws-node container needs to manage routes and iptables rules.
+var vpcCniRequiredCapabilities = []corev1.Capability{"NET_ADMIN", "NET_RAW"}
+
 // SetupWebhookWithManager will setup the webhooks for the AWSManagedControlPlane.
 func (r *AWSManagedControlPlane) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
@@ -95,8 +105,17 @@ func (r *AWSManagedControlPlane) ValidateCreate() error {
 	allErrs = append(allErrs, r.validateSecondaryCIDR()...)
 	allErrs = append(allErrs, r.validateEKSAddons()...)
 	allErrs = append(allErrs, r.validateDisableVPCCNI()...)
+	allErrs = append(allErrs, r.validateVpcCniSecurityContext()...)
+	allErrs = append(allErrs, r.validatePodMTU()...)
+	allErrs = append(allErrs, r.validateNodeProblemDetector()...)
+	allErrs = append(allErrs, r.validateCACertificateParameter()...)
+	allErrs = append(allErrs, r.validateOperatorAccess()...)
 	allErrs = append(allErrs, r.validateKubeProxy()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.Validate()...)
+	allErrs = append(allErrs, r.validateBastionReachable()...)
+	allErrs = append(allErrs, r.validateIPFamily(nil)...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
+	allErrs = append(allErrs, r.Spec.CostAllocationTags.ValidateCostAllocation(r.Spec.AdditionalTags)...)
 
 	if len(allErrs) == 0 {
 		return nil
@@ -128,8 +147,18 @@ func (r *AWSManagedControlPlane) ValidateUpdate(old runtime.Object) error {
 	allErrs = append(allErrs, r.validateSecondaryCIDR()...)
 	allErrs = append(allErrs, r.validateEKSAddons()...)
 	allErrs = append(allErrs, r.validateDisableVPCCNI()...)
+	allErrs = append(allErrs, r.validateVpcCniSecurityContext()...)
+	allErrs = append(allErrs, r.validatePodMTU()...)
+	allErrs = append(allErrs, r.validateNodeProblemDetector()...)
+	allErrs = append(allErrs, r.validateCACertificateParameter()...)
+	allErrs = append(allErrs, r.validateOperatorAccess()...)
 	allErrs = append(allErrs, r.validateKubeProxy()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.Validate()...)
+	allErrs = append(allErrs, r.validateBastionReachable()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.ValidateUpdate(&oldAWSManagedControlplane.Spec.NetworkSpec)...)
+	allErrs = append(allErrs, r.validateIPFamily(oldAWSManagedControlplane)...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
+	allErrs = append(allErrs, r.Spec.CostAllocationTags.ValidateCostAllocation(r.Spec.AdditionalTags)...)
 
 	if r.Spec.Region != oldAWSManagedControlplane.Spec.Region {
 		allErrs = append(allErrs,
@@ -353,6 +382,188 @@ func (r *AWSManagedControlPlane) validateDisableVPCCNI() field.ErrorList {
 	return allErrs
 }
 
+func (r *AWSManagedControlPlane) validateVpcCniSecurityContext() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.VpcCni.SecurityContext == nil {
+		return nil
+	}
+
+	dropField := field.NewPath("spec", "vpcCni", "securityContext", "dropCapabilities")
+	for i, capability := range r.Spec.VpcCni.SecurityContext.DropCapabilities {
+		// Container runtimes accept capabilities with or without the CAP_ prefix.
+		name := corev1.Capability(strings.TrimPrefix(strings.ToUpper(string(capability)), "CAP_"))
+		if name == "ALL" {
+			allErrs = append(allErrs, field.Invalid(dropField.Index(i), capability, "dropping all capabilities drops NET_ADMIN and NET_RAW, which are required by the vpc cni"))
+			continue
+		}
+		for _, required := range vpcCniRequiredCapabilities {
+			if name == required {
+				allErrs = append(allErrs, field.Invalid(dropField.Index(i), capability, "capability is required by the vpc cni and cannot be dropped"))
+			}
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return allErrs
+}
+
+func (r *AWSManagedControlPlane) validatePodMTU() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.VpcCni.PodMTU == nil {
+		return nil
+	}
+
+	mtuField := field.NewPath("spec", "vpcCni", "podMTU")
+	if *r.Spec.VpcCni.PodMTU < minPodMTU || *r.Spec.VpcCni.PodMTU > maxPodMTU {
+		allErrs = append(allErrs, field.Invalid(mtuField, *r.Spec.VpcCni.PodMTU, fmt.Sprintf("must be between %d and %d", minPodMTU, maxPodMTU)))
+	}
+	for _, env := range r.Spec.VpcCni.Env {
+		if env.Name == podMTUEnvName {
+			allErrs = append(allErrs, field.Invalid(mtuField, *r.Spec.VpcCni.PodMTU, fmt.Sprintf("cannot be set together with %s in env", podMTUEnvName)))
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return allErrs
+}
+
+func (r *AWSManagedControlPlane) validateNodeProblemDetector() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.NodeProblemDetector == nil {
+		return nil
+	}
+
+	npdField := field.NewPath("spec", "nodeProblemDetector")
+	for i, monitor := range r.Spec.NodeProblemDetector.Monitors {
+		if monitor == "" {
+			allErrs = append(allErrs, field.Required(npdField.Child("monitors").Index(i), "monitor name is required"))
+		}
+	}
+	for i, rule := range r.Spec.NodeProblemDetector.Rules {
+		ruleField := npdField.Child("rules").Index(i)
+		if rule.Type == NodeProblemDetectorRulePermanent && rule.Condition == "" {
+			allErrs = append(allErrs, field.Required(ruleField.Child("condition"), "condition is required for permanent rules"))
+		}
+		if rule.Type == NodeProblemDetectorRuleTemporary && rule.Condition != "" {
+			allErrs = append(allErrs, field.Invalid(ruleField.Child("condition"), rule.Condition, "condition can only be set for permanent rules"))
+		}
+		if _, err := regexp.Compile(rule.Pattern); err != nil {
+			allErrs = append(allErrs, field.Invalid(ruleField.Child("pattern"), rule.Pattern, fmt.Sprintf("invalid regular expression: %v", err)))
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return allErrs
+}
+
+func (r *AWSManagedControlPlane) validateBastionReachable() field.ErrorList {
+	if !r.Spec.Bastion.Enabled {
+		return nil
+	}
+	return r.Spec.NetworkSpec.VPC.ValidateInternetFacing(field.NewPath("spec", "bastion", "enabled"))
+}
+
+func (r *AWSManagedControlPlane) validateCACertificateParameter() field.ErrorList {
+	if r.Spec.CACertificateParameter == nil {
+		return nil
+	}
+
+	name := r.Spec.CACertificateParameter.Name
+	if !strings.HasPrefix(name, CACertificateParameterPrefix) || name == CACertificateParameterPrefix {
+		return field.ErrorList{
+			field.Invalid(field.NewPath("spec", "caCertificateParameter", "name"), name, fmt.Sprintf("parameter name must begin with %s", CACertificateParameterPrefix)),
+		}
+	}
+	return nil
+}
+
+func (r *AWSManagedControlPlane) validateOperatorAccess() field.ErrorList {
+	if r.Spec.OperatorAccess == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	path := field.NewPath("spec", "operatorAccess")
+	if (r.Spec.OperatorAccess.SAML == nil) == (r.Spec.OperatorAccess.OIDC == nil) {
+		allErrs = append(allErrs, field.Invalid(path, r.Spec.OperatorAccess, "exactly one of saml or oidc must be set"))
+	}
+
+	names := make(map[string]bool, len(r.Spec.OperatorAccess.Roles))
+	for i, role := range r.Spec.OperatorAccess.Roles {
+		rolePath := path.Child("roles").Index(i)
+		if names[role.Name] {
+			allErrs = append(allErrs, field.Duplicate(rolePath.Child("name"), role.Name))
+		}
+		names[role.Name] = true
+		if len(role.Subjects) == 0 {
+			allErrs = append(allErrs, field.Required(rolePath.Child("subjects"), "at least one subject is required to restrict who can assume the role"))
+		}
+		for j, subject := range role.Subjects {
+			if subject == "" || subject == "*" {
+				allErrs = append(allErrs, field.Invalid(rolePath.Child("subjects").Index(j), subject, "subject must identify the operators allowed to assume the role"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func (r *AWSManagedControlPlane) validateIPFamily(old *AWSManagedControlPlane) field.ErrorList {
+	var allErrs field.ErrorList
+
+	ipFamilyField := field.NewPath("spec", "kubernetesNetworkConfig", "ipFamily")
+	ipFamily := r.Spec.KubernetesNetworkConfig.GetIPFamily()
+
+	if old != nil && ipFamily != old.Spec.KubernetesNetworkConfig.GetIPFamily() {
+		allErrs = append(allErrs, field.Invalid(ipFamilyField, ipFamily, "field is immutable"))
+	}
+
+	if ipFamily != EKSIPFamilyIPv6 {
+		return allErrs
+	}
+
+	if r.Spec.Version != nil {
+		v, err := parseEKSVersion(*r.Spec.Version)
+		minVersion, _ := version.ParseSemantic(minIPv6Version)
+		if err == nil && v.LessThan(minVersion) {
+			message := fmt.Sprintf("ipv6 requires Kubernetes %s or greater", minIPv6Version)
+			allErrs = append(allErrs, field.Invalid(ipFamilyField, ipFamily, message))
+		}
+	}
+
+	if r.Spec.NetworkSpec.VPC.ID == "" {
+		allErrs = append(allErrs, field.Invalid(ipFamilyField, ipFamily, "ipv6 requires an existing VPC with an IPv6 CIDR block to be specified in spec.network.vpc.id"))
+	}
+
+	if r.Spec.SecondaryCidrBlock != nil {
+		allErrs = append(allErrs, field.Invalid(ipFamilyField, ipFamily, "ipv6 cannot be used with a secondary CIDR block"))
+	}
+
+	hasVpcCniAddon := false
+	if r.Spec.Addons != nil {
+		for _, addon := range *r.Spec.Addons {
+			if addon.Name == vpcCniAddon {
+				hasVpcCniAddon = true
+				break
+			}
+		}
+	}
+	if !hasVpcCniAddon {
+		allErrs = append(allErrs, field.Invalid(ipFamilyField, ipFamily, "ipv6 requires the vpc-cni addon"))
+	}
+
+	return allErrs
+}
+
 // Default will set default values for the AWSManagedControlPlane.
 func (r *AWSManagedControlPlane) Default() {
 	mcpLog.Info("AWSManagedControlPlane setting defaults", "name", r.Name)
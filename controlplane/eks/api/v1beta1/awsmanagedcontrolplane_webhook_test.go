@@ -23,6 +23,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
 
@@ -676,3 +677,644 @@ func TestValidatingWebhookUpdate_SecondaryCidr(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatingWebhookCreate_VpcCniSecurityContext(t *testing.T) {
+	tests := []struct {
+		name             string
+		dropCapabilities []corev1.Capability
+		expectError      bool
+	}{
+		{
+			name:             "drop unrequired capabilities",
+			dropCapabilities: []corev1.Capability{"SYS_ADMIN", "CAP_SYS_PTRACE"},
+			expectError:      false,
+		},
+		{
+			name:             "drop all capabilities",
+			dropCapabilities: []corev1.Capability{"ALL"},
+			expectError:      true,
+		},
+		{
+			name:             "drop NET_ADMIN",
+			dropCapabilities: []corev1.Capability{"SYS_ADMIN", "NET_ADMIN"},
+			expectError:      true,
+		},
+		{
+			name:             "drop NET_RAW",
+			dropCapabilities: []corev1.Capability{"NET_RAW"},
+			expectError:      true,
+		},
+		{
+			name:             "drop CAP_NET_ADMIN",
+			dropCapabilities: []corev1.Capability{"CAP_NET_ADMIN"},
+			expectError:      true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &AWSManagedControlPlane{
+				Spec: AWSManagedControlPlaneSpec{
+					EKSClusterName: "default_cluster1",
+					VpcCni: VpcCni{
+						SecurityContext: &VpcCniSecurityContext{
+							DropCapabilities:       tc.dropCapabilities,
+							ReadOnlyRootFilesystem: true,
+						},
+					},
+				},
+			}
+			err := mcp.ValidateCreate()
+
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestValidatingWebhookCreate_PodMTU(t *testing.T) {
+	tests := []struct {
+		name        string
+		podMTU      int
+		env         []corev1.EnvVar
+		expectError bool
+	}{
+		{
+			name:        "jumbo frames",
+			podMTU:      9001,
+			expectError: false,
+		},
+		{
+			name:        "vpn overlay",
+			podMTU:      1400,
+			expectError: false,
+		},
+		{
+			name:        "too small",
+			podMTU:      500,
+			expectError: true,
+		},
+		{
+			name:        "too large",
+			podMTU:      9216,
+			expectError: true,
+		},
+		{
+			name:        "conflicts with env",
+			podMTU:      1500,
+			env:         []corev1.EnvVar{{Name: "AWS_VPC_ENI_MTU", Value: "9001"}},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &AWSManagedControlPlane{
+				Spec: AWSManagedControlPlaneSpec{
+					EKSClusterName: "default_cluster1",
+					VpcCni: VpcCni{
+						Env:    tc.env,
+						PodMTU: pointer.Int(tc.podMTU),
+					},
+				},
+			}
+			err := mcp.ValidateCreate()
+
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestValidatingWebhookCreate_NodeProblemDetector(t *testing.T) {
+	tests := []struct {
+		name        string
+		npd         *NodeProblemDetector
+		expectError bool
+	}{
+		{
+			name:        "builtin monitors",
+			npd:         &NodeProblemDetector{Monitors: []string{"kernel-monitor", "docker-monitor"}},
+			expectError: false,
+		},
+		{
+			name: "valid rules",
+			npd: &NodeProblemDetector{Rules: []NodeProblemDetectorRule{
+				{Type: NodeProblemDetectorRuleTemporary, Reason: "EBSStuck", Pattern: "nvme.*timeout"},
+				{Type: NodeProblemDetectorRulePermanent, Condition: "ReadonlyFilesystem", Reason: "FilesystemIsReadOnly", Pattern: "Remounting filesystem read-only"},
+			}},
+			expectError: false,
+		},
+		{
+			name:        "empty monitor name",
+			npd:         &NodeProblemDetector{Monitors: []string{""}},
+			expectError: true,
+		},
+		{
+			name: "permanent rule without condition",
+			npd: &NodeProblemDetector{Rules: []NodeProblemDetectorRule{
+				{Type: NodeProblemDetectorRulePermanent, Reason: "FilesystemIsReadOnly", Pattern: "Remounting filesystem read-only"},
+			}},
+			expectError: true,
+		},
+		{
+			name: "temporary rule with condition",
+			npd: &NodeProblemDetector{Rules: []NodeProblemDetectorRule{
+				{Type: NodeProblemDetectorRuleTemporary, Condition: "ReadonlyFilesystem", Reason: "EBSStuck", Pattern: "nvme.*timeout"},
+			}},
+			expectError: true,
+		},
+		{
+			name: "invalid pattern",
+			npd: &NodeProblemDetector{Rules: []NodeProblemDetectorRule{
+				{Type: NodeProblemDetectorRuleTemporary, Reason: "EBSStuck", Pattern: "nvme.*(timeout"},
+			}},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &AWSManagedControlPlane{
+				Spec: AWSManagedControlPlaneSpec{
+					EKSClusterName:      "default_cluster1",
+					NodeProblemDetector: tc.npd,
+				},
+			}
+			err := mcp.ValidateCreate()
+
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestValidatingWebhookCreate_CACertificateParameter(t *testing.T) {
+	tests := []struct {
+		name        string
+		param       *CACertificateParameter
+		expectError bool
+	}{
+		{
+			name:        "not set",
+			expectError: false,
+		},
+		{
+			name:        "valid name",
+			param:       &CACertificateParameter{Name: "/cluster.x-k8s.io/prod/ca.crt"},
+			expectError: false,
+		},
+		{
+			name:        "outside the capa prefix",
+			param:       &CACertificateParameter{Name: "/clusters/prod/ca.crt"},
+			expectError: true,
+		},
+		{
+			name:        "reserved aws prefix",
+			param:       &CACertificateParameter{Name: "/aws/clusters/ca.crt"},
+			expectError: true,
+		},
+		{
+			name:        "prefix only",
+			param:       &CACertificateParameter{Name: "/cluster.x-k8s.io/"},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &AWSManagedControlPlane{
+				Spec: AWSManagedControlPlaneSpec{
+					EKSClusterName:         "default_cluster1",
+					CACertificateParameter: tc.param,
+				},
+			}
+			err := mcp.ValidateCreate()
+
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestValidatingWebhookCreate_OperatorAccess(t *testing.T) {
+	saml := &OperatorAccessSAMLProvider{Name: "corp-sso", MetadataDocument: "<md:EntityDescriptor/>"}
+	oidc := &OperatorAccessOIDCProvider{IssuerURL: "https://sso.example.com", ClientIDs: []string{"capa"}, Thumbprints: []string{"9e99a48a9960b14926bb7f3b02e22da2b0ab7280"}}
+	roles := []OperatorAccessRole{{Name: "cluster-admins", Subjects: []string{"admin@example.com"}}}
+
+	tests := []struct {
+		name        string
+		access      *OperatorAccess
+		expectError bool
+	}{
+		{
+			name:        "not set",
+			expectError: false,
+		},
+		{
+			name:        "saml provider",
+			access:      &OperatorAccess{SAML: saml, Roles: roles},
+			expectError: false,
+		},
+		{
+			name:        "oidc provider",
+			access:      &OperatorAccess{OIDC: oidc, Roles: roles},
+			expectError: false,
+		},
+		{
+			name:        "no provider",
+			access:      &OperatorAccess{Roles: roles},
+			expectError: true,
+		},
+		{
+			name:        "both providers",
+			access:      &OperatorAccess{SAML: saml, OIDC: oidc, Roles: roles},
+			expectError: true,
+		},
+		{
+			name:        "duplicate role names",
+			access:      &OperatorAccess{SAML: saml, Roles: append(roles, OperatorAccessRole{Name: "cluster-admins", Subjects: []string{"ops@example.com"}})},
+			expectError: true,
+		},
+		{
+			name:        "role without subjects",
+			access:      &OperatorAccess{OIDC: oidc, Roles: []OperatorAccessRole{{Name: "cluster-admins"}}},
+			expectError: true,
+		},
+		{
+			name:        "role assumable by any subject",
+			access:      &OperatorAccess{OIDC: oidc, Roles: []OperatorAccessRole{{Name: "cluster-admins", Subjects: []string{"*"}}}},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &AWSManagedControlPlane{
+				Spec: AWSManagedControlPlaneSpec{
+					EKSClusterName: "default_cluster1",
+					OperatorAccess: tc.access,
+				},
+			}
+			err := mcp.ValidateCreate()
+
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestValidatingWebhookCreate_IPFamily(t *testing.T) {
+	vpcCni := &[]Addon{{Name: vpcCniAddon, Version: "v1.10.1-eksbuild.1"}}
+
+	tests := []struct {
+		name        string
+		version     string
+		vpcID       string
+		addons      *[]Addon
+		secondary   *string
+		ipFamily    EKSIPFamily
+		expectError bool
+	}{
+		{
+			name:        "ipv4 without prerequisites",
+			version:     "v1.20",
+			ipFamily:    EKSIPFamilyIPv4,
+			expectError: false,
+		},
+		{
+			name:        "ipv6 with prerequisites",
+			version:     "v1.21",
+			vpcID:       "vpc-123",
+			addons:      vpcCni,
+			ipFamily:    EKSIPFamilyIPv6,
+			expectError: false,
+		},
+		{
+			name:        "ipv6 with unsupported version",
+			version:     "v1.20",
+			vpcID:       "vpc-123",
+			addons:      vpcCni,
+			ipFamily:    EKSIPFamilyIPv6,
+			expectError: true,
+		},
+		{
+			name:        "ipv6 with managed vpc",
+			version:     "v1.21",
+			addons:      vpcCni,
+			ipFamily:    EKSIPFamilyIPv6,
+			expectError: true,
+		},
+		{
+			name:        "ipv6 without vpc-cni addon",
+			version:     "v1.21",
+			vpcID:       "vpc-123",
+			ipFamily:    EKSIPFamilyIPv6,
+			expectError: true,
+		},
+		{
+			name:        "ipv6 with secondary cidr",
+			version:     "v1.21",
+			vpcID:       "vpc-123",
+			addons:      vpcCni,
+			secondary:   aws.String("100.64.0.0/16"),
+			ipFamily:    EKSIPFamilyIPv6,
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &AWSManagedControlPlane{
+				Spec: AWSManagedControlPlaneSpec{
+					EKSClusterName:     "default_cluster1",
+					Version:            aws.String(tc.version),
+					Addons:             tc.addons,
+					SecondaryCidrBlock: tc.secondary,
+					NetworkSpec: infrav1.NetworkSpec{
+						VPC: infrav1.VPCSpec{ID: tc.vpcID},
+					},
+					KubernetesNetworkConfig: &KubernetesNetworkConfig{IPFamily: tc.ipFamily},
+				},
+			}
+			err := mcp.ValidateCreate()
+
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestValidatingWebhookUpdate_IPFamily(t *testing.T) {
+	tests := []struct {
+		name        string
+		oldConfig   *KubernetesNetworkConfig
+		newConfig   *KubernetesNetworkConfig
+		expectError bool
+	}{
+		{
+			name:        "unset to explicit ipv4",
+			oldConfig:   nil,
+			newConfig:   &KubernetesNetworkConfig{IPFamily: EKSIPFamilyIPv4},
+			expectError: false,
+		},
+		{
+			name:        "ipv4 to ipv6",
+			oldConfig:   &KubernetesNetworkConfig{IPFamily: EKSIPFamilyIPv4},
+			newConfig:   &KubernetesNetworkConfig{IPFamily: EKSIPFamilyIPv6},
+			expectError: true,
+		},
+		{
+			name:        "ipv6 to unset",
+			oldConfig:   &KubernetesNetworkConfig{IPFamily: EKSIPFamilyIPv6},
+			newConfig:   nil,
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			spec := func(config *KubernetesNetworkConfig) AWSManagedControlPlaneSpec {
+				return AWSManagedControlPlaneSpec{
+					EKSClusterName: "default_cluster1",
+					Version:        aws.String("v1.22"),
+					Addons:         &[]Addon{{Name: vpcCniAddon, Version: "v1.10.1-eksbuild.1"}},
+					NetworkSpec: infrav1.NetworkSpec{
+						VPC: infrav1.VPCSpec{ID: "vpc-123"},
+					},
+					KubernetesNetworkConfig: config,
+				}
+			}
+			newMCP := &AWSManagedControlPlane{Spec: spec(tc.newConfig)}
+			oldMCP := &AWSManagedControlPlane{Spec: spec(tc.oldConfig)}
+
+			err := newMCP.ValidateUpdate(oldMCP)
+
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestValidatingWebhookCreate_Network(t *testing.T) {
+	tests := []struct {
+		name        string
+		network     infrav1.NetworkSpec
+		bastion     infrav1.Bastion
+		expectError bool
+	}{
+		{
+			name: "route propagation on a managed vpc",
+			network: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					RoutePropagation: &infrav1.RoutePropagationSpec{EnableRoutePropagation: true, GatewayID: "vgw-0123456789abcdef0"},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "route propagation on an unmanaged vpc",
+			network: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID:               "vpc-123",
+					RoutePropagation: &infrav1.RoutePropagationSpec{EnableRoutePropagation: true, GatewayID: "vgw-0123456789abcdef0"},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "subnet tiers including the lb and node tiers",
+			network: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					SubnetTiers: []infrav1.SubnetTier{infrav1.SubnetTierLB, infrav1.SubnetTierNode, infrav1.SubnetTierDatabase},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "subnet tiers without the node tier",
+			network: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					SubnetTiers: []infrav1.SubnetTier{infrav1.SubnetTierLB, infrav1.SubnetTierPod},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "subnet tiers on an unmanaged vpc",
+			network: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID:          "vpc-123",
+					SubnetTiers: []infrav1.SubnetTier{infrav1.SubnetTierLB, infrav1.SubnetTierNode},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "instance tenancy on an unmanaged vpc",
+			network: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID:              "vpc-123",
+					InstanceTenancy: "dedicated",
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "private nat gateways with a transit gateway",
+			network: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					NatGateway: &infrav1.NatGatewaySpec{ConnectivityType: infrav1.NatGatewayConnectivityTypePrivate, TransitGatewayID: "tgw-0123456789abcdef0"},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "private nat gateways without a transit gateway",
+			network: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					NatGateway: &infrav1.NatGatewaySpec{ConnectivityType: infrav1.NatGatewayConnectivityTypePrivate},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "bastion with private nat gateways",
+			network: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					NatGateway: &infrav1.NatGatewaySpec{ConnectivityType: infrav1.NatGatewayConnectivityTypePrivate, TransitGatewayID: "tgw-0123456789abcdef0"},
+				},
+			},
+			bastion:     infrav1.Bastion{Enabled: true},
+			expectError: true,
+		},
+		{
+			name: "blackhole cidr blocks on a managed vpc",
+			network: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					CidrBlock:      "10.0.0.0/16",
+					BlackholeCIDRs: []string{"198.51.100.0/24"},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "blackhole cidr block which isn't a network address",
+			network: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					BlackholeCIDRs: []string{"198.51.100.7/24"},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "blackhole cidr block overlapping the vpc cidr block",
+			network: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					CidrBlock:      "10.0.0.0/16",
+					BlackholeCIDRs: []string{"10.0.0.0/8"},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "blackhole cidr blocks on an unmanaged vpc",
+			network: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID:             "vpc-123",
+					BlackholeCIDRs: []string{"198.51.100.0/24"},
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &AWSManagedControlPlane{
+				Spec: AWSManagedControlPlaneSpec{
+					EKSClusterName: "default_cluster1",
+					NetworkSpec:    tc.network,
+					Bastion:        tc.bastion,
+				},
+			}
+			err := mcp.ValidateCreate()
+
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestValidatingWebhookUpdate_NatGateway(t *testing.T) {
+	g := NewWithT(t)
+
+	oldMCP := &AWSManagedControlPlane{
+		Spec: AWSManagedControlPlaneSpec{
+			EKSClusterName: "default_cluster1",
+		},
+	}
+	newMCP := oldMCP.DeepCopy()
+	newMCP.Spec.NetworkSpec.VPC.NatGateway = &infrav1.NatGatewaySpec{
+		ConnectivityType: infrav1.NatGatewayConnectivityTypePrivate,
+		TransitGatewayID: "tgw-0123456789abcdef0",
+	}
+
+	g.Expect(newMCP.ValidateUpdate(oldMCP)).ToNot(Succeed())
+}
+
+func TestValidatingWebhookUpdate_InstanceTenancy(t *testing.T) {
+	g := NewWithT(t)
+
+	oldMCP := &AWSManagedControlPlane{
+		Spec: AWSManagedControlPlaneSpec{
+			EKSClusterName: "default_cluster1",
+		},
+	}
+	newMCP := oldMCP.DeepCopy()
+	newMCP.Spec.NetworkSpec.VPC.InstanceTenancy = "default"
+	g.Expect(newMCP.ValidateUpdate(oldMCP)).To(Succeed())
+
+	newMCP.Spec.NetworkSpec.VPC.InstanceTenancy = "dedicated"
+	g.Expect(newMCP.ValidateUpdate(oldMCP)).ToNot(Succeed())
+}
@@ -58,3 +58,57 @@ const (
 	// EKSIdentityProviderConfiguredFailedReason used to report failures while reconciling the identity provider config association.
 	EKSIdentityProviderConfiguredFailedReason = "EKSIdentityProviderConfiguredFailed"
 )
+
+const (
+	// ControlPlaneReachableCondition condition reports whether the API server of the cluster answers requests.
+	ControlPlaneReachableCondition clusterv1.ConditionType = "ControlPlaneReachable"
+	// ControlPlaneUnreachableReason used when the API server of the cluster can't be reached.
+	ControlPlaneUnreachableReason = "ControlPlaneUnreachable"
+	// AddonsHealthyCondition condition reports whether all the EKS addons are active.
+	AddonsHealthyCondition clusterv1.ConditionType = "AddonsHealthy"
+	// AddonsUnhealthyReason used when one or more EKS addons are not active.
+	AddonsUnhealthyReason = "AddonsUnhealthy"
+	// NodeGroupsHealthyCondition condition reports whether all the managed node groups of the cluster are ready.
+	NodeGroupsHealthyCondition clusterv1.ConditionType = "NodeGroupsHealthy"
+	// NodeGroupsUnhealthyReason used when one or more managed node groups are not ready.
+	NodeGroupsUnhealthyReason = "NodeGroupsUnhealthy"
+)
+
+const (
+	// AWSNodeRolloutCompleteCondition condition reports whether all the `aws-node` pods run the
+	// current DaemonSet template when a gradual rollout is configured.
+	AWSNodeRolloutCompleteCondition clusterv1.ConditionType = "AWSNodeRolloutComplete"
+	// AWSNodeRolloutInProgressReason used while outdated `aws-node` pods are restarted in batches.
+	AWSNodeRolloutInProgressReason = "RolloutInProgress"
+)
+
+const (
+	// NodeProblemDetectorReadyCondition condition reports on the successful reconciliation of
+	// node-problem-detector in the workload cluster. It is removed once node-problem-detector
+	// is uninstalled.
+	NodeProblemDetectorReadyCondition clusterv1.ConditionType = "NodeProblemDetectorReady"
+	// NodeProblemDetectorReconciliationFailedReason used to report failures while reconciling node-problem-detector.
+	NodeProblemDetectorReconciliationFailedReason = "NodeProblemDetectorReconciliationFailed"
+)
+
+const (
+	// CNIMetricsHelperReadyCondition condition reports on the successful reconciliation of the
+	// cni-metrics-helper in the workload cluster. It is removed once the cni-metrics-helper is
+	// uninstalled.
+	CNIMetricsHelperReadyCondition clusterv1.ConditionType = "CNIMetricsHelperReady"
+	// CNIMetricsHelperReconciliationFailedReason used to report failures while reconciling the cni-metrics-helper.
+	CNIMetricsHelperReconciliationFailedReason = "CNIMetricsHelperReconciliationFailed"
+)
+
+const (
+	// AWSNodePodDisruptionBudgetReadyCondition condition reports on the successful reconciliation of the
+	// aws-node PodDisruptionBudget in the workload cluster. It is removed once the PodDisruptionBudget is
+	// no longer configured.
+	AWSNodePodDisruptionBudgetReadyCondition clusterv1.ConditionType = "AWSNodePodDisruptionBudgetReady"
+	// AWSNodePodDisruptionBudgetReconciliationFailedReason used to report failures while reconciling the aws-node PodDisruptionBudget.
+	AWSNodePodDisruptionBudgetReconciliationFailedReason = "AWSNodePodDisruptionBudgetReconciliationFailed"
+	// AWSNodePodDisruptionBudgetUnsupportedReason used when the workload cluster doesn't serve the policy/v1 API.
+	AWSNodePodDisruptionBudgetUnsupportedReason = "AWSNodePodDisruptionBudgetUnsupported"
+	// AWSNodePodDisruptionBudgetUnmanagedReason used when an aws-node PodDisruptionBudget not created by CAPA exists.
+	AWSNodePodDisruptionBudgetUnmanagedReason = "AWSNodePodDisruptionBudgetUnmanaged"
+)
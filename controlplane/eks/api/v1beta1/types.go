@@ -79,6 +79,19 @@ var (
 	EKSTokenMethodAWSCli = EKSTokenMethod("aws-cli")
 )
 
+// KubeconfigExec defines the exec plugin settings of the user kubeconfig.
+type KubeconfigExec struct {
+	// Profile is the AWS profile used by the exec plugin to obtain the token, set with
+	// the AWS_PROFILE environment variable.
+	// +optional
+	Profile string `json:"profile,omitempty"`
+
+	// AdditionalArgs are appended to the arguments of the exec plugin, e.g. to assume a role
+	// with --role-arn for aws-cli or --role for iam-authenticator.
+	// +optional
+	AdditionalArgs []string `json:"additionalArgs,omitempty"`
+}
+
 var (
 	// DefaultEKSControlPlaneRole is the name of the default IAM role to use for the EKS control plane
 	// if no other role is supplied in the spec and if iam role creation is not enabled. The default
@@ -199,6 +212,35 @@ type AddonState struct {
 	Issues []AddonIssue `json:"issues,omitempty"`
 }
 
+// HealthState is the overall health of a cluster.
+type HealthState string
+
+var (
+	// HealthStateHealthy indicates that the control plane is reachable and that the addons
+	// and node groups are healthy.
+	HealthStateHealthy = HealthState("Healthy")
+
+	// HealthStateDegraded indicates that the control plane is reachable but the addons or
+	// node groups are not healthy.
+	HealthStateDegraded = HealthState("Degraded")
+
+	// HealthStateUnhealthy indicates that the control plane is not reachable.
+	HealthStateUnhealthy = HealthState("Unhealthy")
+)
+
+// HealthSummary summarizes the health of a cluster.
+type HealthSummary struct {
+	// ControlPlaneReachable is true when the API server of the cluster answers requests.
+	ControlPlaneReachable bool `json:"controlPlaneReachable"`
+	// AddonsHealthy is true when all the EKS addons are active.
+	AddonsHealthy bool `json:"addonsHealthy"`
+	// NodeGroupsHealthy is true when all the managed node groups of the cluster are ready.
+	NodeGroupsHealthy bool `json:"nodeGroupsHealthy"`
+	// Overall is the rollup of the individual health checks.
+	// +kubebuilder:validation:Enum=Healthy;Degraded;Unhealthy
+	Overall HealthState `json:"overall"`
+}
+
 // AddonIssue represents an issue with an addon.
 type AddonIssue struct {
 	// Code is the issue code
@@ -24,6 +24,7 @@ package v1beta1
 import (
 	"k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	apiv1beta1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
 	cluster_apiapiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
 )
@@ -142,6 +143,18 @@ func (in *AWSManagedControlPlaneSpec) DeepCopyInto(out *AWSManagedControlPlaneSp
 			(*out)[key] = val
 		}
 	}
+	if in.CostAllocationTags != nil {
+		in, out := &in.CostAllocationTags, &out.CostAllocationTags
+		*out = make(apiv1beta1.Tags, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ServiceQuotaChecks != nil {
+		in, out := &in.ServiceQuotaChecks, &out.ServiceQuotaChecks
+		*out = new(apiv1beta1.ServiceQuotaChecks)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.IAMAuthenticatorConfig != nil {
 		in, out := &in.IAMAuthenticatorConfig, &out.IAMAuthenticatorConfig
 		*out = new(IAMAuthenticatorConfig)
@@ -155,6 +168,11 @@ func (in *AWSManagedControlPlaneSpec) DeepCopyInto(out *AWSManagedControlPlaneSp
 		*out = new(EKSTokenMethod)
 		**out = **in
 	}
+	if in.KubeconfigExec != nil {
+		in, out := &in.KubeconfigExec, &out.KubeconfigExec
+		*out = new(KubeconfigExec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Addons != nil {
 		in, out := &in.Addons, &out.Addons
 		*out = new([]Addon)
@@ -173,6 +191,31 @@ func (in *AWSManagedControlPlaneSpec) DeepCopyInto(out *AWSManagedControlPlaneSp
 	}
 	in.VpcCni.DeepCopyInto(&out.VpcCni)
 	out.KubeProxy = in.KubeProxy
+	if in.KubernetesNetworkConfig != nil {
+		in, out := &in.KubernetesNetworkConfig, &out.KubernetesNetworkConfig
+		*out = new(KubernetesNetworkConfig)
+		**out = **in
+	}
+	if in.NodeProblemDetector != nil {
+		in, out := &in.NodeProblemDetector, &out.NodeProblemDetector
+		*out = new(NodeProblemDetector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CACertificateParameter != nil {
+		in, out := &in.CACertificateParameter, &out.CACertificateParameter
+		*out = new(CACertificateParameter)
+		**out = **in
+	}
+	if in.OperatorAccess != nil {
+		in, out := &in.OperatorAccess, &out.OperatorAccess
+		*out = new(OperatorAccess)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GPUTimeSlicing != nil {
+		in, out := &in.GPUTimeSlicing, &out.GPUTimeSlicing
+		*out = new(GPUTimeSlicing)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSManagedControlPlaneSpec.
@@ -227,6 +270,16 @@ func (in *AWSManagedControlPlaneStatus) DeepCopyInto(out *AWSManagedControlPlane
 		}
 	}
 	out.IdentityProviderStatus = in.IdentityProviderStatus
+	if in.Health != nil {
+		in, out := &in.Health, &out.Health
+		*out = new(HealthSummary)
+		**out = **in
+	}
+	if in.OperatorAccess != nil {
+		in, out := &in.OperatorAccess, &out.OperatorAccess
+		*out = new(OperatorAccessStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSManagedControlPlaneStatus.
@@ -328,6 +381,21 @@ func (in *AddonState) DeepCopy() *AddonState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CACertificateParameter) DeepCopyInto(out *CACertificateParameter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CACertificateParameter.
+func (in *CACertificateParameter) DeepCopy() *CACertificateParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(CACertificateParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControlPlaneLoggingSpec) DeepCopyInto(out *ControlPlaneLoggingSpec) {
 	*out = *in
@@ -410,6 +478,36 @@ func (in *EndpointAccess) DeepCopy() *EndpointAccess {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GPUTimeSlicing) DeepCopyInto(out *GPUTimeSlicing) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPUTimeSlicing.
+func (in *GPUTimeSlicing) DeepCopy() *GPUTimeSlicing {
+	if in == nil {
+		return nil
+	}
+	out := new(GPUTimeSlicing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthSummary) DeepCopyInto(out *HealthSummary) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthSummary.
+func (in *HealthSummary) DeepCopy() *HealthSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IAMAuthenticatorConfig) DeepCopyInto(out *IAMAuthenticatorConfig) {
 	*out = *in
@@ -469,6 +567,26 @@ func (in *KubeProxy) DeepCopy() *KubeProxy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeconfigExec) DeepCopyInto(out *KubeconfigExec) {
+	*out = *in
+	if in.AdditionalArgs != nil {
+		in, out := &in.AdditionalArgs, &out.AdditionalArgs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeconfigExec.
+func (in *KubeconfigExec) DeepCopy() *KubeconfigExec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeconfigExec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesMapping) DeepCopyInto(out *KubernetesMapping) {
 	*out = *in
@@ -489,6 +607,61 @@ func (in *KubernetesMapping) DeepCopy() *KubernetesMapping {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesNetworkConfig) DeepCopyInto(out *KubernetesNetworkConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesNetworkConfig.
+func (in *KubernetesNetworkConfig) DeepCopy() *KubernetesNetworkConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesNetworkConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeProblemDetector) DeepCopyInto(out *NodeProblemDetector) {
+	*out = *in
+	if in.Monitors != nil {
+		in, out := &in.Monitors, &out.Monitors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]NodeProblemDetectorRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeProblemDetector.
+func (in *NodeProblemDetector) DeepCopy() *NodeProblemDetector {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeProblemDetector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeProblemDetectorRule) DeepCopyInto(out *NodeProblemDetectorRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeProblemDetectorRule.
+func (in *NodeProblemDetectorRule) DeepCopy() *NodeProblemDetectorRule {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeProblemDetectorRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OIDCIdentityProviderConfig) DeepCopyInto(out *OIDCIdentityProviderConfig) {
 	*out = *in
@@ -553,6 +726,123 @@ func (in *OIDCProviderStatus) DeepCopy() *OIDCProviderStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorAccess) DeepCopyInto(out *OperatorAccess) {
+	*out = *in
+	if in.SAML != nil {
+		in, out := &in.SAML, &out.SAML
+		*out = new(OperatorAccessSAMLProvider)
+		**out = **in
+	}
+	if in.OIDC != nil {
+		in, out := &in.OIDC, &out.OIDC
+		*out = new(OperatorAccessOIDCProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]OperatorAccessRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorAccess.
+func (in *OperatorAccess) DeepCopy() *OperatorAccess {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorAccess)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorAccessOIDCProvider) DeepCopyInto(out *OperatorAccessOIDCProvider) {
+	*out = *in
+	if in.ClientIDs != nil {
+		in, out := &in.ClientIDs, &out.ClientIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Thumbprints != nil {
+		in, out := &in.Thumbprints, &out.Thumbprints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorAccessOIDCProvider.
+func (in *OperatorAccessOIDCProvider) DeepCopy() *OperatorAccessOIDCProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorAccessOIDCProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorAccessRole) DeepCopyInto(out *OperatorAccessRole) {
+	*out = *in
+	if in.Subjects != nil {
+		in, out := &in.Subjects, &out.Subjects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PolicyARNs != nil {
+		in, out := &in.PolicyARNs, &out.PolicyARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorAccessRole.
+func (in *OperatorAccessRole) DeepCopy() *OperatorAccessRole {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorAccessRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorAccessSAMLProvider) DeepCopyInto(out *OperatorAccessSAMLProvider) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorAccessSAMLProvider.
+func (in *OperatorAccessSAMLProvider) DeepCopy() *OperatorAccessSAMLProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorAccessSAMLProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorAccessStatus) DeepCopyInto(out *OperatorAccessStatus) {
+	*out = *in
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorAccessStatus.
+func (in *OperatorAccessStatus) DeepCopy() *OperatorAccessStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorAccessStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RoleMapping) DeepCopyInto(out *RoleMapping) {
 	*out = *in
@@ -595,6 +885,43 @@ func (in *VpcCni) DeepCopyInto(out *VpcCni) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(VpcCniPodDisruptionBudget)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(VpcCniSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodMTU != nil {
+		in, out := &in.PodMTU, &out.PodMTU
+		*out = new(int)
+		**out = **in
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(VpcCniRollout)
+		**out = **in
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodAnnotations != nil {
+		in, out := &in.PodAnnotations, &out.PodAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MetricsHelper != nil {
+		in, out := &in.MetricsHelper, &out.MetricsHelper
+		*out = new(VpcCniMetricsHelper)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VpcCni.
@@ -606,3 +933,73 @@ func (in *VpcCni) DeepCopy() *VpcCni {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VpcCniMetricsHelper) DeepCopyInto(out *VpcCniMetricsHelper) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VpcCniMetricsHelper.
+func (in *VpcCniMetricsHelper) DeepCopy() *VpcCniMetricsHelper {
+	if in == nil {
+		return nil
+	}
+	out := new(VpcCniMetricsHelper)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VpcCniPodDisruptionBudget) DeepCopyInto(out *VpcCniPodDisruptionBudget) {
+	*out = *in
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VpcCniPodDisruptionBudget.
+func (in *VpcCniPodDisruptionBudget) DeepCopy() *VpcCniPodDisruptionBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(VpcCniPodDisruptionBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VpcCniRollout) DeepCopyInto(out *VpcCniRollout) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VpcCniRollout.
+func (in *VpcCniRollout) DeepCopy() *VpcCniRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(VpcCniRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VpcCniSecurityContext) DeepCopyInto(out *VpcCniSecurityContext) {
+	*out = *in
+	if in.DropCapabilities != nil {
+		in, out := &in.DropCapabilities, &out.DropCapabilities
+		*out = make([]v1.Capability, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VpcCniSecurityContext.
+func (in *VpcCniSecurityContext) DeepCopy() *VpcCniSecurityContext {
+	if in == nil {
+		return nil
+	}
+	out := new(VpcCniSecurityContext)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/tools/record"
@@ -40,10 +41,13 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/awsnode"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/eks"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/gputimeslicing"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/iamauth"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/kubeproxy"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/network"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/nodeproblemdetector"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/securitygroup"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/servicequotas"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/annotations"
@@ -55,6 +59,10 @@ const (
 	// deleteRequeueAfter is how long to wait before checking again to see if the control plane still
 	// has dependencies during deletion.
 	deleteRequeueAfter = 20 * time.Second
+
+	// awsNodeRolloutRequeueAfter is how long to wait before checking whether the next batch of
+	// aws-node pods can be restarted.
+	awsNodeRolloutRequeueAfter = 30 * time.Second
 )
 
 var (
@@ -97,6 +105,16 @@ func (r *AWSManagedControlPlaneReconciler) SetupWithManager(ctx context.Context,
 		return fmt.Errorf("failed adding a watch for ready clusters: %w", err)
 	}
 
+	// The health summary reports the status of the managed node groups.
+	if feature.Gates.Enabled(feature.MachinePool) {
+		if err = c.Watch(
+			&source.Kind{Type: &expinfrav1.AWSManagedMachinePool{}},
+			handler.EnqueueRequestsFromMapFunc(r.awsManagedMachinePoolToAWSManagedControlPlane(ctx, log)),
+		); err != nil {
+			return fmt.Errorf("failed adding a watch for AWSManagedMachinePools: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -213,8 +231,14 @@ func (r *AWSManagedControlPlaneReconciler) reconcileNormal(ctx context.Context,
 	authService := iamauth.NewService(managedScope, iamauth.BackendTypeConfigMap, managedScope.Client)
 	awsnodeService := awsnode.NewService(managedScope)
 	kubeproxyService := kubeproxy.NewService(managedScope)
+	npdService := nodeproblemdetector.NewService(managedScope)
+	gpuTimeSlicingService := gputimeslicing.NewService(managedScope)
 
 	if err := networkSvc.ReconcileNetwork(); err != nil {
+		if servicequotas.IsInsufficientQuota(err) {
+			managedScope.Info("Insufficient service quota headroom, not creating network resources", "reason", err.Error())
+			return reconcile.Result{RequeueAfter: servicequotas.InsufficientQuotaRequeueAfter}, nil
+		}
 		return reconcile.Result{}, fmt.Errorf("failed to reconcile network for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
 	}
 
@@ -224,6 +248,10 @@ func (r *AWSManagedControlPlaneReconciler) reconcileNormal(ctx context.Context,
 	}
 
 	if err := ec2Service.ReconcileBastion(); err != nil {
+		if servicequotas.IsInsufficientQuota(err) {
+			managedScope.Info("Insufficient service quota headroom, not creating bastion host", "reason", err.Error())
+			return reconcile.Result{RequeueAfter: servicequotas.InsufficientQuotaRequeueAfter}, nil
+		}
 		conditions.MarkFalse(awsManagedControlPlane, infrav1.BastionHostReadyCondition, infrav1.BastionHostFailedReason, clusterv1.ConditionSeverityError, err.Error())
 		return reconcile.Result{}, fmt.Errorf("failed to reconcile bastion host for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
 	}
@@ -232,28 +260,54 @@ func (r *AWSManagedControlPlaneReconciler) reconcileNormal(ctx context.Context,
 		return reconcile.Result{}, fmt.Errorf("failed to reconcile control plane for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
 	}
 
+	// The health summary is computed before reconciling the workload cluster, which fails when
+	// the cluster is unhealthy, so that it's reported precisely then.
+	if err := ekssvc.ReconcileHealthSummary(ctx); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to reconcile health summary for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
+	}
+
 	if err := awsnodeService.ReconcileCNI(ctx); err != nil {
 		conditions.MarkFalse(managedScope.InfraCluster(), infrav1.SecondaryCidrsReadyCondition, infrav1.SecondaryCidrReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
 		return reconcile.Result{}, fmt.Errorf("failed to reconcile control plane for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
 	}
 
+	// Restarted aws-node pods don't trigger a reconcile of the control plane, so a gradual rollout
+	// only advances to its next batch if the control plane is requeued while batches are pending.
+	var result reconcile.Result
+	if conditions.IsFalse(awsManagedControlPlane, ekscontrolplanev1.AWSNodeRolloutCompleteCondition) {
+		result.RequeueAfter = awsNodeRolloutRequeueAfter
+	}
+
 	if err := kubeproxyService.ReconcileKubeProxy(ctx); err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed to reconcile control plane for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
 	}
 
+	if err := npdService.ReconcileNodeProblemDetector(ctx); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to reconcile node-problem-detector for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
+	}
+
+	if err := gpuTimeSlicingService.ReconcileGPUTimeSlicing(ctx); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to reconcile GPU time-slicing for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
+	}
+
 	if err := authService.ReconcileIAMAuthenticator(ctx); err != nil {
 		conditions.MarkFalse(awsManagedControlPlane, ekscontrolplanev1.IAMAuthenticatorConfiguredCondition, ekscontrolplanev1.IAMAuthenticatorConfigurationFailedReason, clusterv1.ConditionSeverityError, err.Error())
 		return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile aws-iam-authenticator config for AWSManagedControlPlane %s/%s", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name)
 	}
 	conditions.MarkTrue(awsManagedControlPlane, ekscontrolplanev1.IAMAuthenticatorConfiguredCondition)
 
+	// Cost allocation tags removed from now on are removed from the resources tagged above.
+	if err := managedScope.SetCostAllocationTagsLastApplied(); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "failed to record cost allocation tags for AWSManagedControlPlane %s/%s", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name)
+	}
+
 	for _, subnet := range managedScope.Subnets().FilterPrivate() {
 		managedScope.SetFailureDomain(subnet.AvailabilityZone, clusterv1.FailureDomainSpec{
 			ControlPlane: true,
 		})
 	}
 
-	return reconcile.Result{}, nil
+	return result, nil
 }
 
 func (r *AWSManagedControlPlaneReconciler) reconcileDelete(ctx context.Context, managedScope *scope.ManagedControlPlaneScope) (_ ctrl.Result, reterr error) {
@@ -324,6 +378,33 @@ func (r *AWSManagedControlPlaneReconciler) ClusterToAWSManagedControlPlane(o cli
 	return nil
 }
 
+// awsManagedMachinePoolToAWSManagedControlPlane enqueues the AWSManagedControlPlane of the cluster
+// an AWSManagedMachinePool belongs to.
+func (r *AWSManagedControlPlaneReconciler) awsManagedMachinePoolToAWSManagedControlPlane(ctx context.Context, log logr.Logger) handler.MapFunc {
+	return func(o client.Object) []ctrl.Request {
+		pool, ok := o.(*expinfrav1.AWSManagedMachinePool)
+		if !ok {
+			panic(fmt.Sprintf("Expected a AWSManagedMachinePool but got a %T", o))
+		}
+
+		clusterName, ok := pool.Labels[clusterv1.ClusterLabelName]
+		if !ok {
+			return nil
+		}
+
+		cluster := &clusterv1.Cluster{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Namespace: pool.Namespace, Name: clusterName}, cluster); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			log.Error(err, "failed to get owning cluster", "namespace", pool.Namespace, "name", clusterName)
+			return nil
+		}
+
+		return r.ClusterToAWSManagedControlPlane(cluster)
+	}
+}
+
 func (r *AWSManagedControlPlaneReconciler) dependencyCount(ctx context.Context, managedScope *scope.ManagedControlPlaneScope) (int, error) {
 	log := ctrl.LoggerFrom(ctx)
 
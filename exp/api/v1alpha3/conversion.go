@@ -45,6 +45,17 @@ func (r *AWSMachinePool) ConvertTo(dstRaw conversion.Hub) error {
 		}
 		infrav1alpha3.RestoreRootVolume(restored.Spec.AWSLaunchTemplate.RootVolume, dst.Spec.AWSLaunchTemplate.RootVolume)
 	}
+	dst.Spec.ScalingActivityEvents = restored.Spec.ScalingActivityEvents
+	dst.Spec.PredictiveScaling = restored.Spec.PredictiveScaling
+	dst.Spec.AWSLaunchTemplate.KernelID = restored.Spec.AWSLaunchTemplate.KernelID
+	dst.Spec.AWSLaunchTemplate.RamdiskID = restored.Spec.AWSLaunchTemplate.RamdiskID
+	dst.Spec.StaggeredScaleUp = restored.Spec.StaggeredScaleUp
+	if restored.Spec.RefreshPreferences != nil && dst.Spec.RefreshPreferences != nil {
+		dst.Spec.RefreshPreferences.CheckpointPercentages = restored.Spec.RefreshPreferences.CheckpointPercentages
+		dst.Spec.RefreshPreferences.CheckpointDelay = restored.Spec.RefreshPreferences.CheckpointDelay
+	}
+	dst.Status.LastStaggeredScaleUpTime = restored.Status.LastStaggeredScaleUpTime
+	dst.Status.LastScalingActivityTime = restored.Status.LastScalingActivityTime
 	return nil
 }
 
@@ -92,6 +103,9 @@ func (r *AWSManagedMachinePool) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.CapacityType = restored.Spec.CapacityType
 	dst.Spec.RoleAdditionalPolicies = restored.Spec.RoleAdditionalPolicies
 	dst.Spec.UpdateConfig = restored.Spec.UpdateConfig
+	dst.Spec.CapacityBlockReservationID = restored.Spec.CapacityBlockReservationID
+	dst.Spec.CrossAccountECRRegistries = restored.Spec.CrossAccountECRRegistries
+	dst.Spec.AvailabilityZoneSubnets = restored.Spec.AvailabilityZoneSubnets
 
 	return nil
 }
@@ -177,3 +191,23 @@ func Convert_v1alpha3_Instance_To_v1beta1_Instance(in *infrav1alpha3.Instance, o
 func Convert_v1alpha3_Volume_To_v1beta1_Volume(in *infrav1alpha3.Volume, out *infrav1.Volume, s apiconversion.Scope) error {
 	return infrav1alpha3.Convert_v1alpha3_Volume_To_v1beta1_Volume(in, out, s)
 }
+
+// Convert_v1beta1_AWSMachinePoolSpec_To_v1alpha3_AWSMachinePoolSpec is a conversion function.
+func Convert_v1beta1_AWSMachinePoolSpec_To_v1alpha3_AWSMachinePoolSpec(in *infrav1exp.AWSMachinePoolSpec, out *AWSMachinePoolSpec, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_AWSMachinePoolSpec_To_v1alpha3_AWSMachinePoolSpec(in, out, s)
+}
+
+// Convert_v1beta1_AWSMachinePoolStatus_To_v1alpha3_AWSMachinePoolStatus is a conversion function.
+func Convert_v1beta1_AWSMachinePoolStatus_To_v1alpha3_AWSMachinePoolStatus(in *infrav1exp.AWSMachinePoolStatus, out *AWSMachinePoolStatus, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_AWSMachinePoolStatus_To_v1alpha3_AWSMachinePoolStatus(in, out, s)
+}
+
+// Convert_v1beta1_RefreshPreferences_To_v1alpha3_RefreshPreferences is a conversion function.
+func Convert_v1beta1_RefreshPreferences_To_v1alpha3_RefreshPreferences(in *infrav1exp.RefreshPreferences, out *RefreshPreferences, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_RefreshPreferences_To_v1alpha3_RefreshPreferences(in, out, s)
+}
+
+// Convert_v1beta1_AWSLaunchTemplate_To_v1alpha3_AWSLaunchTemplate is a conversion function.
+func Convert_v1beta1_AWSLaunchTemplate_To_v1alpha3_AWSLaunchTemplate(in *infrav1exp.AWSLaunchTemplate, out *AWSLaunchTemplate, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_AWSLaunchTemplate_To_v1alpha3_AWSLaunchTemplate(in, out, s)
+}
@@ -28,14 +28,39 @@ import (
 // ConvertTo converts the v1alpha4 AWSMachinePool receiver to a v1beta1 AWSMachinePool.
 func (src *AWSMachinePool) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*infrav1exp.AWSMachinePool)
-	return Convert_v1alpha4_AWSMachinePool_To_v1beta1_AWSMachinePool(src, dst, nil)
+	if err := Convert_v1alpha4_AWSMachinePool_To_v1beta1_AWSMachinePool(src, dst, nil); err != nil {
+		return err
+	}
+
+	restored := &infrav1exp.AWSMachinePool{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	dst.Spec.ScalingActivityEvents = restored.Spec.ScalingActivityEvents
+	dst.Spec.PredictiveScaling = restored.Spec.PredictiveScaling
+	dst.Spec.AWSLaunchTemplate.KernelID = restored.Spec.AWSLaunchTemplate.KernelID
+	dst.Spec.AWSLaunchTemplate.RamdiskID = restored.Spec.AWSLaunchTemplate.RamdiskID
+	dst.Spec.StaggeredScaleUp = restored.Spec.StaggeredScaleUp
+	if restored.Spec.RefreshPreferences != nil && dst.Spec.RefreshPreferences != nil {
+		dst.Spec.RefreshPreferences.CheckpointPercentages = restored.Spec.RefreshPreferences.CheckpointPercentages
+		dst.Spec.RefreshPreferences.CheckpointDelay = restored.Spec.RefreshPreferences.CheckpointDelay
+	}
+	dst.Status.LastStaggeredScaleUpTime = restored.Status.LastStaggeredScaleUpTime
+	dst.Status.LastScalingActivityTime = restored.Status.LastScalingActivityTime
+
+	return nil
 }
 
 // ConvertFrom converts the v1beta1 AWSMachinePool receiver to v1alpha4 AWSMachinePool.
 func (r *AWSMachinePool) ConvertFrom(srcRaw conversion.Hub) error {
 	src := srcRaw.(*infrav1exp.AWSMachinePool)
 
-	return Convert_v1beta1_AWSMachinePool_To_v1alpha4_AWSMachinePool(src, r, nil)
+	if err := Convert_v1beta1_AWSMachinePool_To_v1alpha4_AWSMachinePool(src, r, nil); err != nil {
+		return err
+	}
+
+	return utilconversion.MarshalData(src, r)
 }
 
 // ConvertTo converts the v1alpha4 AWSMachinePoolList receiver to a v1beta1 AWSMachinePoolList.
@@ -65,6 +90,9 @@ func (src *AWSManagedMachinePool) ConvertTo(dstRaw conversion.Hub) error {
 
 	dst.Spec.RoleAdditionalPolicies = restored.Spec.RoleAdditionalPolicies
 	dst.Spec.UpdateConfig = restored.Spec.UpdateConfig
+	dst.Spec.CapacityBlockReservationID = restored.Spec.CapacityBlockReservationID
+	dst.Spec.CrossAccountECRRegistries = restored.Spec.CrossAccountECRRegistries
+	dst.Spec.AvailabilityZoneSubnets = restored.Spec.AvailabilityZoneSubnets
 
 	return nil
 }
@@ -147,3 +175,23 @@ func Convert_v1beta1_Instance_To_v1alpha4_Instance(in *infrav1.Instance, out *in
 func Convert_v1alpha4_Instance_To_v1beta1_Instance(in *infrav1alpha4.Instance, out *infrav1.Instance, s apiconversion.Scope) error {
 	return infrav1alpha4.Convert_v1alpha4_Instance_To_v1beta1_Instance(in, out, s)
 }
+
+// Convert_v1beta1_AWSMachinePoolSpec_To_v1alpha4_AWSMachinePoolSpec is a conversion function.
+func Convert_v1beta1_AWSMachinePoolSpec_To_v1alpha4_AWSMachinePoolSpec(in *infrav1exp.AWSMachinePoolSpec, out *AWSMachinePoolSpec, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_AWSMachinePoolSpec_To_v1alpha4_AWSMachinePoolSpec(in, out, s)
+}
+
+// Convert_v1beta1_AWSMachinePoolStatus_To_v1alpha4_AWSMachinePoolStatus is a conversion function.
+func Convert_v1beta1_AWSMachinePoolStatus_To_v1alpha4_AWSMachinePoolStatus(in *infrav1exp.AWSMachinePoolStatus, out *AWSMachinePoolStatus, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_AWSMachinePoolStatus_To_v1alpha4_AWSMachinePoolStatus(in, out, s)
+}
+
+// Convert_v1beta1_RefreshPreferences_To_v1alpha4_RefreshPreferences is a conversion function.
+func Convert_v1beta1_RefreshPreferences_To_v1alpha4_RefreshPreferences(in *infrav1exp.RefreshPreferences, out *RefreshPreferences, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_RefreshPreferences_To_v1alpha4_RefreshPreferences(in, out, s)
+}
+
+// Convert_v1beta1_AWSLaunchTemplate_To_v1alpha4_AWSLaunchTemplate is a conversion function.
+func Convert_v1beta1_AWSLaunchTemplate_To_v1alpha4_AWSLaunchTemplate(in *infrav1exp.AWSLaunchTemplate, out *AWSLaunchTemplate, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_AWSLaunchTemplate_To_v1alpha4_AWSLaunchTemplate(in, out, s)
+}
@@ -86,6 +86,23 @@ type AWSMachinePoolSpec struct {
 	// Enable or disable the capacity rebalance autoscaling group feature
 	// +optional
 	CapacityRebalance bool `json:"capacityRebalance,omitempty"`
+
+	// ScalingActivityEvents enables emitting Kubernetes events for the scaling activities
+	// (scale-out, scale-in and failed launches) of the autoscaling group.
+	// +optional
+	ScalingActivityEvents bool `json:"scalingActivityEvents,omitempty"`
+
+	// PredictiveScaling configures a predictive scaling policy that scales the ASG ahead of
+	// forecasted load. The policy is removed when this is unset.
+	// In the ForecastAndScale mode the desired capacity of the ASG is left to the policy, and
+	// changes to the MachinePool replicas are no longer applied to the ASG.
+	// +optional
+	PredictiveScaling *PredictiveScalingPolicy `json:"predictiveScaling,omitempty"`
+
+	// StaggeredScaleUp scales the ASG up in batches when the number of replicas increases by
+	// more than the batch size. Scaling down is never staggered.
+	// +optional
+	StaggeredScaleUp *StaggeredScaleUp `json:"staggeredScaleUp,omitempty"`
 }
 
 // RefreshPreferences defines the specs for instance refreshing.
@@ -106,6 +123,19 @@ type RefreshPreferences struct {
 	// during an instance refresh. The default is 90.
 	// +optional
 	MinHealthyPercentage *int64 `json:"minHealthyPercentage,omitempty"`
+
+	// CheckpointPercentages are the percentages of replaced instances, in ascending order, at which
+	// the instance refresh pauses for CheckpointDelay before replacing the next instances, e.g. to
+	// verify the new instances. To replace all the instances, the last percentage must be 100.
+	// +optional
+	CheckpointPercentages []int64 `json:"checkpointPercentages,omitempty"`
+
+	// CheckpointDelay is the number of seconds the instance refresh waits at each checkpoint.
+	// Only applicable with CheckpointPercentages. The default is 3600.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=172800
+	// +optional
+	CheckpointDelay *int64 `json:"checkpointDelay,omitempty"`
 }
 
 // AWSMachinePoolStatus defines the observed state of AWSMachinePool.
@@ -129,6 +159,10 @@ type AWSMachinePoolStatus struct {
 	// The ID of the launch template
 	LaunchTemplateID string `json:"launchTemplateID,omitempty"`
 
+	// LastStaggeredScaleUpTime is the time the last batch of a staggered scale up was requested.
+	// +optional
+	LastStaggeredScaleUpTime *metav1.Time `json:"lastStaggeredScaleUpTime,omitempty"`
+
 	// FailureReason will be set in the event that there is a terminal problem
 	// reconciling the Machine and will contain a succinct value suitable
 	// for machine interpretation.
@@ -168,6 +202,12 @@ type AWSMachinePoolStatus struct {
 	FailureMessage *string `json:"failureMessage,omitempty"`
 
 	ASGStatus *ASGStatus `json:"asgStatus,omitempty"`
+
+	// LastScalingActivityTime is the end time of the most recent scaling activity
+	// of the autoscaling group that was reported as an event. Activities completed
+	// before scaling activity events were enabled aren't reported.
+	// +optional
+	LastScalingActivityTime *metav1.Time `json:"lastScalingActivityTime,omitempty"`
 }
 
 // AWSMachinePoolInstanceStatus defines the status of the AWSMachinePoolInstance.
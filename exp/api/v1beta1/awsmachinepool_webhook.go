@@ -114,6 +114,60 @@ func (r *AWSMachinePool) validateAdditionalSecurityGroups() field.ErrorList {
 	return allErrs
 }
 
+func (r *AWSMachinePool) validatePredictiveScaling() field.ErrorList {
+	var allErrs field.ErrorList
+
+	policy := r.Spec.PredictiveScaling
+	if policy == nil {
+		return allErrs
+	}
+
+	fldPath := field.NewPath("spec", "predictiveScaling")
+	if policy.MetricPair == PredictiveScalingMetricPairALBRequestCount && policy.ResourceLabel == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("resourceLabel"), "resourceLabel is required for the ALBRequestCount metric pair"))
+	}
+	if policy.MetricPair != PredictiveScalingMetricPairALBRequestCount && policy.ResourceLabel != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("resourceLabel"), "resourceLabel is only valid for the ALBRequestCount metric pair"))
+	}
+	if policy.MaxCapacityBuffer != nil && policy.MaxCapacityBreachBehavior != PredictiveScalingMaxCapacityBreachBehaviorIncreaseMaxCapacity {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("maxCapacityBuffer"), "maxCapacityBuffer is only valid with the IncreaseMaxCapacity breach behavior"))
+	}
+
+	return allErrs
+}
+
+func (r *AWSMachinePool) validateRefreshPreferences() field.ErrorList {
+	var allErrs field.ErrorList
+
+	prefs := r.Spec.RefreshPreferences
+	if prefs == nil {
+		return allErrs
+	}
+
+	fldPath := field.NewPath("spec", "refreshPreferences")
+	for i, percentage := range prefs.CheckpointPercentages {
+		if percentage < 1 || percentage > 100 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("checkpointPercentages").Index(i), percentage, "must be between 1 and 100"))
+		}
+		if i > 0 && percentage <= prefs.CheckpointPercentages[i-1] {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("checkpointPercentages").Index(i), percentage, "must be in ascending order"))
+		}
+	}
+	if prefs.CheckpointDelay != nil && len(prefs.CheckpointPercentages) == 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("checkpointDelay"), "checkpointDelay is only valid with checkpointPercentages"))
+	}
+
+	return allErrs
+}
+
+func (r *AWSMachinePool) validateKernel() field.ErrorList {
+	var allErrs field.ErrorList
+	if r.Spec.AWSLaunchTemplate.RamdiskID != "" && r.Spec.AWSLaunchTemplate.KernelID == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "awsLaunchTemplate", "kernelID"), "kernelID is required when ramdiskID is set"))
+	}
+	return allErrs
+}
+
 // ValidateCreate will do any extra validation when creating a AWSMachinePool.
 func (r *AWSMachinePool) ValidateCreate() error {
 	log.Info("AWSMachinePool validate create", "name", r.Name)
@@ -125,6 +179,9 @@ func (r *AWSMachinePool) ValidateCreate() error {
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, r.validateSubnets()...)
 	allErrs = append(allErrs, r.validateAdditionalSecurityGroups()...)
+	allErrs = append(allErrs, r.validatePredictiveScaling()...)
+	allErrs = append(allErrs, r.validateRefreshPreferences()...)
+	allErrs = append(allErrs, r.validateKernel()...)
 
 	if len(allErrs) == 0 {
 		return nil
@@ -145,6 +202,9 @@ func (r *AWSMachinePool) ValidateUpdate(old runtime.Object) error {
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, r.validateSubnets()...)
 	allErrs = append(allErrs, r.validateAdditionalSecurityGroups()...)
+	allErrs = append(allErrs, r.validatePredictiveScaling()...)
+	allErrs = append(allErrs, r.validateRefreshPreferences()...)
+	allErrs = append(allErrs, r.validateKernel()...)
 
 	if len(allErrs) == 0 {
 		return nil
@@ -124,6 +124,125 @@ func TestAWSMachinePool_ValidateCreate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "predictive scaling with a CPU metric pair is accepted",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					PredictiveScaling: &PredictiveScalingPolicy{
+						MetricPair:  PredictiveScalingMetricPairCPUUtilization,
+						TargetValue: 50,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "predictive scaling with the ALBRequestCount metric pair requires a resource label",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					PredictiveScaling: &PredictiveScalingPolicy{
+						MetricPair:  PredictiveScalingMetricPairALBRequestCount,
+						TargetValue: 1000,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "predictive scaling resource label is rejected for other metric pairs",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					PredictiveScaling: &PredictiveScalingPolicy{
+						MetricPair:    PredictiveScalingMetricPairNetworkIn,
+						ResourceLabel: "app/my-alb/778d41231b141a0f/targetgroup/my-alb-target-group/943f017f100becff",
+						TargetValue:   1000,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "predictive scaling max capacity buffer requires the IncreaseMaxCapacity breach behavior",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					PredictiveScaling: &PredictiveScalingPolicy{
+						MetricPair:                PredictiveScalingMetricPairCPUUtilization,
+						TargetValue:               50,
+						MaxCapacityBreachBehavior: PredictiveScalingMaxCapacityBreachBehaviorHonorMaxCapacity,
+						MaxCapacityBuffer:         aws.Int64(10),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "refresh checkpoints in ascending order are accepted",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					RefreshPreferences: &RefreshPreferences{
+						CheckpointPercentages: []int64{20, 50, 100},
+						CheckpointDelay:       aws.Int64(600),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "refresh checkpoints not in ascending order are rejected",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					RefreshPreferences: &RefreshPreferences{
+						CheckpointPercentages: []int64{50, 20},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "refresh checkpoints above 100 percent are rejected",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					RefreshPreferences: &RefreshPreferences{
+						CheckpointPercentages: []int64{50, 120},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "refresh checkpoint delay without checkpoints is rejected",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					RefreshPreferences: &RefreshPreferences{
+						CheckpointDelay: aws.Int64(600),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "kernel and ramdisk are accepted",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					AWSLaunchTemplate: AWSLaunchTemplate{
+						KernelID:  "aki-919dcaf8",
+						RamdiskID: "ari-9e7cb6f7",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "ramdisk without kernel is rejected",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					AWSLaunchTemplate: AWSLaunchTemplate{
+						RamdiskID: "ari-9e7cb6f7",
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -52,6 +52,8 @@ const (
 	ManagedMachinePoolCapacityTypeOnDemand ManagedMachinePoolCapacityType = "onDemand"
 	// ManagedMachinePoolCapacityTypeSpot is the spot instance capacity type to launch spot instances.
 	ManagedMachinePoolCapacityTypeSpot ManagedMachinePoolCapacityType = "spot"
+	// ManagedMachinePoolCapacityTypeCapacityBlock is the capacity type to launch instances into a capacity block reservation.
+	ManagedMachinePoolCapacityTypeCapacityBlock ManagedMachinePoolCapacityType = "capacityBlock"
 )
 
 var (
@@ -61,6 +63,15 @@ var (
 	DefaultEKSNodegroupRole = fmt.Sprintf("eks-nodegroup%s", iamv1.DefaultNameSuffix)
 )
 
+// AvailabilityZoneSubnet maps an availability zone to a subnet.
+type AvailabilityZoneSubnet struct {
+	// AvailabilityZone is the name of the availability zone, e.g. "us-east-1a".
+	AvailabilityZone string `json:"availabilityZone"`
+
+	// SubnetID is the ID of the subnet in the availability zone.
+	SubnetID string `json:"subnetID"`
+}
+
 // AWSManagedMachinePoolSpec defines the desired state of AWSManagedMachinePool.
 type AWSManagedMachinePoolSpec struct {
 	// EKSNodegroupName specifies the name of the nodegroup in AWS
@@ -78,6 +89,14 @@ type AWSManagedMachinePoolSpec struct {
 	// +optional
 	SubnetIDs []string `json:"subnetIDs,omitempty"`
 
+	// AvailabilityZoneSubnets maps each availability zone the nodegroup spans to the subnet of its
+	// nodes, e.g. to align the nodes with topology-aware routing or zonal volumes. All the failure
+	// domains of the MachinePool must be mapped. Mutually exclusive with AvailabilityZones and SubnetIDs.
+	// +listType=map
+	// +listMapKey=availabilityZone
+	// +optional
+	AvailabilityZoneSubnets []AvailabilityZoneSubnet `json:"availabilityZoneSubnets,omitempty"`
+
 	// AdditionalTags is an optional set of tags to add to AWS resources managed by the AWS provider, in addition to the
 	// ones added by default.
 	// +optional
@@ -89,6 +108,14 @@ type AWSManagedMachinePoolSpec struct {
 	// +optional
 	RoleAdditionalPolicies []string `json:"roleAdditionalPolicies,omitempty"`
 
+	// CrossAccountECRRegistries are the IDs of the AWS accounts hosting ECR registries the nodes
+	// pull images from. An inline policy granting read access to their repositories is put on the
+	// node group role when the role is managed by CAPA, they are ignored with a warning event
+	// otherwise. Each repository must also have a repository policy allowing the node group role
+	// to pull from it, CAPA doesn't manage it.
+	// +optional
+	CrossAccountECRRegistries []string `json:"crossAccountECRRegistries,omitempty"`
+
 	// RoleName specifies the name of IAM role for the node group.
 	// If the role is pre-existing we will treat it as unmanaged
 	// and not delete it on deletion. If the EKSEnableIAM feature
@@ -140,11 +167,19 @@ type AWSManagedMachinePoolSpec struct {
 	ProviderIDList []string `json:"providerIDList,omitempty"`
 
 	// CapacityType specifies the capacity type for the ASG behind this pool
-	// +kubebuilder:validation:Enum:=onDemand;spot
+	// +kubebuilder:validation:Enum:=onDemand;spot;capacityBlock
 	// +kubebuilder:default:=onDemand
 	// +optional
 	CapacityType *ManagedMachinePoolCapacityType `json:"capacityType,omitempty"`
 
+	// CapacityBlockReservationID is the ID of the capacity block reservation the nodes are
+	// launched into. It requires the capacityBlock capacity type and is applied through a
+	// launch template owned by CAPA. Changing it rolls the nodes to a new version of the launch
+	// template; it can't be set or unset after creation.
+	// +kubebuilder:validation:Pattern:=`^cr-[0-9a-f]+$`
+	// +optional
+	CapacityBlockReservationID *string `json:"capacityBlockReservationID,omitempty"`
+
 	// UpdateConfig holds the optional config to control the behaviour of the update
 	// to the nodegroup.
 	// +optional
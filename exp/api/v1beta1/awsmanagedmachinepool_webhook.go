@@ -19,6 +19,7 @@ package v1beta1
 import (
 	"fmt"
 	"reflect"
+	"regexp"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
@@ -36,6 +37,9 @@ const (
 	maxNodegroupNameLength = 64
 )
 
+// awsAccountIDRegex matches the ID of an AWS account, which is also the ID of its ECR registry.
+var awsAccountIDRegex = regexp.MustCompile(`^\d{12}$`)
+
 // log is for logging in this package.
 var mmpLog = logf.Log.WithName("awsmanagedmachinepool-resource")
 
@@ -116,6 +120,74 @@ func (r *AWSManagedMachinePool) validateRemoteAccess() field.ErrorList {
 	return allErrs
 }
 
+func (r *AWSManagedMachinePool) validateCapacityBlock() field.ErrorList {
+	var allErrs field.ErrorList
+	reservationPath := field.NewPath("spec", "capacityBlockReservationID")
+	isCapacityBlock := r.Spec.CapacityType != nil && *r.Spec.CapacityType == ManagedMachinePoolCapacityTypeCapacityBlock
+
+	if r.Spec.CapacityBlockReservationID == nil {
+		if isCapacityBlock {
+			allErrs = append(allErrs, field.Required(reservationPath, "must be set when capacityType is capacityBlock"))
+		}
+		return allErrs
+	}
+
+	if !isCapacityBlock {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "capacityType"), r.Spec.CapacityType, "must be capacityBlock when capacityBlockReservationID is set"))
+	}
+	if r.Spec.InstanceType == nil {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "instanceType"), "must be set when capacityBlockReservationID is set"))
+	}
+	// The nodegroup uses a launch template for the reservation, these settings
+	// can't be combined with a launch template.
+	if r.Spec.DiskSize != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "diskSize"), "cannot be set when capacityBlockReservationID is set"))
+	}
+	if r.Spec.RemoteAccess != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "remoteAccess"), "cannot be set when capacityBlockReservationID is set"))
+	}
+
+	return allErrs
+}
+
+func (r *AWSManagedMachinePool) validateCrossAccountECRRegistries() field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, accountID := range r.Spec.CrossAccountECRRegistries {
+		if !awsAccountIDRegex.MatchString(accountID) {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "crossAccountECRRegistries").Index(i), accountID, "must be a 12 digit AWS account ID"))
+		}
+	}
+
+	return allErrs
+}
+
+func (r *AWSManagedMachinePool) validateAvailabilityZoneSubnets() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(r.Spec.AvailabilityZoneSubnets) == 0 {
+		return allErrs
+	}
+
+	fldPath := field.NewPath("spec", "availabilityZoneSubnets")
+	if len(r.Spec.SubnetIDs) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "cannot be set together with subnetIDs"))
+	}
+	if len(r.Spec.AvailabilityZones) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "cannot be set together with availabilityZones"))
+	}
+
+	subnetIDs := map[string]bool{}
+	for i, zoneSubnet := range r.Spec.AvailabilityZoneSubnets {
+		if subnetIDs[zoneSubnet.SubnetID] {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i).Child("subnetID"), zoneSubnet.SubnetID))
+		}
+		subnetIDs[zoneSubnet.SubnetID] = true
+	}
+
+	return allErrs
+}
+
 // ValidateCreate will do any extra validation when creating a AWSManagedMachinePool.
 func (r *AWSManagedMachinePool) ValidateCreate() error {
 	mmpLog.Info("AWSManagedMachinePool validate create", "name", r.Name)
@@ -134,6 +206,15 @@ func (r *AWSManagedMachinePool) ValidateCreate() error {
 	if errs := r.validateNodegroupUpdateConfig(); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
+	if errs := r.validateCapacityBlock(); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := r.validateCrossAccountECRRegistries(); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := r.validateAvailabilityZoneSubnets(); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
 
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 
@@ -168,6 +249,9 @@ func (r *AWSManagedMachinePool) ValidateUpdate(old runtime.Object) error {
 	if errs := r.validateNodegroupUpdateConfig(); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
+	if errs := r.validateCrossAccountECRRegistries(); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
 
 	if len(allErrs) == 0 {
 		return nil
@@ -211,11 +295,17 @@ func (r *AWSManagedMachinePool) validateImmutable(old *AWSManagedMachinePool) fi
 		appendErrorIfMutated(old.Spec.EKSNodegroupName, r.Spec.EKSNodegroupName, "eksNodegroupName")
 	}
 	appendErrorIfMutated(old.Spec.SubnetIDs, r.Spec.SubnetIDs, "subnetIDs")
+	appendErrorIfMutated(old.Spec.AvailabilityZoneSubnets, r.Spec.AvailabilityZoneSubnets, "availabilityZoneSubnets")
 	appendErrorIfSetAndMutated(old.Spec.RoleName, r.Spec.RoleName, "roleName")
 	appendErrorIfMutated(old.Spec.DiskSize, r.Spec.DiskSize, "diskSize")
 	appendErrorIfMutated(old.Spec.AMIType, r.Spec.AMIType, "amiType")
 	appendErrorIfMutated(old.Spec.RemoteAccess, r.Spec.RemoteAccess, "remoteAccess")
 	appendErrorIfSetAndMutated(old.Spec.CapacityType, r.Spec.CapacityType, "capacityType")
+	// The capacity block reservation can be changed, e.g. to move the nodes to a new capacity block,
+	// but the nodegroup can't start or stop using a launch template.
+	if (old.Spec.CapacityBlockReservationID == nil) != (r.Spec.CapacityBlockReservationID == nil) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "capacityBlockReservationID"), r.Spec.CapacityBlockReservationID, "field can't be set or unset after creation"))
+	}
 
 	return allErrs
 }
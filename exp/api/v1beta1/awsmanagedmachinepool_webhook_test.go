@@ -126,6 +126,137 @@ func TestAWSManagedMachinePool_ValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "valid capacity block reservation",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName:           "eks-node-group-4",
+					InstanceType:               aws.String("p5.48xlarge"),
+					CapacityType:               capacityTypePtr(ManagedMachinePoolCapacityTypeCapacityBlock),
+					CapacityBlockReservationID: aws.String("cr-0123456789abcdef0"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "capacity block reservation requires the capacityBlock capacity type",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName:           "eks-node-group-4",
+					InstanceType:               aws.String("p5.48xlarge"),
+					CapacityType:               capacityTypePtr(ManagedMachinePoolCapacityTypeOnDemand),
+					CapacityBlockReservationID: aws.String("cr-0123456789abcdef0"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "capacityBlock capacity type requires a capacity block reservation",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-4",
+					InstanceType:     aws.String("p5.48xlarge"),
+					CapacityType:     capacityTypePtr(ManagedMachinePoolCapacityTypeCapacityBlock),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "capacity block reservation requires an instance type",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName:           "eks-node-group-4",
+					CapacityType:               capacityTypePtr(ManagedMachinePoolCapacityTypeCapacityBlock),
+					CapacityBlockReservationID: aws.String("cr-0123456789abcdef0"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "capacity block reservation can't be combined with disk size",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName:           "eks-node-group-4",
+					InstanceType:               aws.String("p5.48xlarge"),
+					DiskSize:                   aws.Int32(100),
+					CapacityType:               capacityTypePtr(ManagedMachinePoolCapacityTypeCapacityBlock),
+					CapacityBlockReservationID: aws.String("cr-0123456789abcdef0"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "cross-account ECR registries are AWS account IDs",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName:          "eks-node-group-5",
+					CrossAccountECRRegistries: []string{"111111111111", "222222222222"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "availability zone subnets are accepted",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-6",
+					AvailabilityZoneSubnets: []AvailabilityZoneSubnet{
+						{AvailabilityZone: "eu-west-1a", SubnetID: "subnet-1"},
+						{AvailabilityZone: "eu-west-1b", SubnetID: "subnet-2"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "availability zone subnets can't be combined with subnet IDs",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-6",
+					SubnetIDs:        []string{"subnet-1"},
+					AvailabilityZoneSubnets: []AvailabilityZoneSubnet{
+						{AvailabilityZone: "eu-west-1a", SubnetID: "subnet-1"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "availability zone subnets can't be combined with availability zones",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName:  "eks-node-group-6",
+					AvailabilityZones: []string{"eu-west-1a"},
+					AvailabilityZoneSubnets: []AvailabilityZoneSubnet{
+						{AvailabilityZone: "eu-west-1a", SubnetID: "subnet-1"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "subnet mapped to several availability zones is rejected",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-6",
+					AvailabilityZoneSubnets: []AvailabilityZoneSubnet{
+						{AvailabilityZone: "eu-west-1a", SubnetID: "subnet-1"},
+						{AvailabilityZone: "eu-west-1b", SubnetID: "subnet-1"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "cross-account ECR registry is not an AWS account ID",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName:          "eks-node-group-5",
+					CrossAccountECRRegistries: []string{"111111111111.dkr.ecr.us-east-1.amazonaws.com"},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -162,6 +293,39 @@ func TestAWSManagedMachinePool_ValidateUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "changing the capacity block reservation is accepted",
+			old: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName:           "eks-node-group-1",
+					CapacityType:               capacityTypePtr(ManagedMachinePoolCapacityTypeCapacityBlock),
+					CapacityBlockReservationID: aws.String("cr-0123456789abcdef0"),
+				},
+			},
+			new: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName:           "eks-node-group-1",
+					CapacityType:               capacityTypePtr(ManagedMachinePoolCapacityTypeCapacityBlock),
+					CapacityBlockReservationID: aws.String("cr-0123456789abcdef1"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "setting the capacity block reservation is rejected",
+			old: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-1",
+				},
+			},
+			new: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName:           "eks-node-group-1",
+					CapacityBlockReservationID: aws.String("cr-0123456789abcdef0"),
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "adding tags is accepted",
 			old: &AWSManagedMachinePool{
@@ -252,3 +416,7 @@ func TestAWSManagedMachinePool_ValidateUpdate(t *testing.T) {
 		})
 	}
 }
+
+func capacityTypePtr(capacityType ManagedMachinePoolCapacityType) *ManagedMachinePoolCapacityType {
+	return &capacityType
+}
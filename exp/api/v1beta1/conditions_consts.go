@@ -42,6 +42,16 @@ const (
 	InstanceRefreshNotReadyReason = "InstanceRefreshNotReady"
 	// InstanceRefreshFailedReason used to report when there instance refresh is not initiated.
 	InstanceRefreshFailedReason = "InstanceRefreshFailed"
+
+	// ScalingActivitySucceededCondition reports on the result of the most recent scaling activity of the autoscaling group.
+	ScalingActivitySucceededCondition clusterv1.ConditionType = "ScalingActivitySucceeded"
+	// ScalingActivityFailedReason used to report when the most recent scaling activity failed.
+	ScalingActivityFailedReason = "ScalingActivityFailed"
+
+	// PredictiveScalingPolicyReadyCondition reports on the predictive scaling policy of the autoscaling group.
+	PredictiveScalingPolicyReadyCondition clusterv1.ConditionType = "PredictiveScalingPolicyReady"
+	// PredictiveScalingPolicyFailedReason used to report failures while reconciling the predictive scaling policy.
+	PredictiveScalingPolicyFailedReason = "PredictiveScalingPolicyFailed"
 )
 
 const (
@@ -83,6 +93,14 @@ const (
 	// IAMNodegroupRolesReconciliationFailedReason used to report failures while
 	// reconciling EKS nodegroup iam roles.
 	IAMNodegroupRolesReconciliationFailedReason = "IAMNodegroupRolesReconciliationFailed"
+	// CrossAccountECRPolicyReadyCondition condition reports on the successful reconciliation of the
+	// cross-account ECR policy of the nodegroup role. It is removed once the policy is deleted.
+	CrossAccountECRPolicyReadyCondition clusterv1.ConditionType = "CrossAccountECRPolicyReady"
+	// CrossAccountECRPolicyFailedReason used to report failures while reconciling the cross-account ECR policy.
+	CrossAccountECRPolicyFailedReason = "CrossAccountECRPolicyFailed"
+	// CrossAccountECRPolicyUnmanagedRoleReason used when the policy can't be put on the nodegroup
+	// role as it isn't managed by CAPA.
+	CrossAccountECRPolicyUnmanagedRoleReason = "UnmanagedRole"
 	// IAMFargateRolesReadyCondition condition reports on the successful
 	// reconciliation of EKS nodegroup iam roles.
 	IAMFargateRolesReadyCondition clusterv1.ConditionType = "IAMFargateRolesReady"
@@ -17,6 +17,8 @@ limitations under the License.
 package v1beta1
 
 import (
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
@@ -113,6 +115,18 @@ type AWSLaunchTemplate struct {
 	// at the cluster level or in the actuator.
 	// +optional
 	AdditionalSecurityGroups []infrav1.AWSResourceReference `json:"additionalSecurityGroups,omitempty"`
+
+	// KernelID is the ID of the kernel image (AKI) instances boot with, for paravirtual AMIs that
+	// need a specific kernel. The AMI must use paravirtual virtualization.
+	// +kubebuilder:validation:Pattern=`^aki-[0-9a-f]+$`
+	// +optional
+	KernelID string `json:"kernelID,omitempty"`
+
+	// RamdiskID is the ID of the RAM disk image (ARI) holding the initramfs instances boot with.
+	// Requires KernelID.
+	// +kubebuilder:validation:Pattern=`^ari-[0-9a-f]+$`
+	// +optional
+	RamdiskID string `json:"ramdiskID,omitempty"`
 }
 
 // Overrides are used to override the instance type specified by the launch template with multiple
@@ -167,6 +181,119 @@ type MixedInstancesPolicy struct {
 	Overrides             []Overrides            `json:"overrides,omitempty"`
 }
 
+// PredictiveScalingMode defines whether the predictive scaling policy only forecasts or also scales.
+type PredictiveScalingMode string
+
+var (
+	// PredictiveScalingModeForecastOnly generates forecasts without scaling the Auto Scaling group.
+	PredictiveScalingModeForecastOnly = PredictiveScalingMode("ForecastOnly")
+
+	// PredictiveScalingModeForecastAndScale generates forecasts and scales the Auto Scaling group ahead of the forecasted load.
+	PredictiveScalingModeForecastAndScale = PredictiveScalingMode("ForecastAndScale")
+)
+
+// PredictiveScalingMetricPair is a predefined pair of load and scaling metrics used by predictive scaling.
+type PredictiveScalingMetricPair string
+
+var (
+	// PredictiveScalingMetricPairCPUUtilization forecasts on the total CPU and scales on the average CPU utilization.
+	PredictiveScalingMetricPairCPUUtilization = PredictiveScalingMetricPair("ASGCPUUtilization")
+
+	// PredictiveScalingMetricPairNetworkIn forecasts on the total and scales on the average bytes received.
+	PredictiveScalingMetricPairNetworkIn = PredictiveScalingMetricPair("ASGNetworkIn")
+
+	// PredictiveScalingMetricPairNetworkOut forecasts on the total and scales on the average bytes sent.
+	PredictiveScalingMetricPairNetworkOut = PredictiveScalingMetricPair("ASGNetworkOut")
+
+	// PredictiveScalingMetricPairALBRequestCount forecasts on the total and scales on the average request count
+	// of the Application Load Balancer target group identified by the resource label.
+	PredictiveScalingMetricPairALBRequestCount = PredictiveScalingMetricPair("ALBRequestCount")
+)
+
+// PredictiveScalingMaxCapacityBreachBehavior defines the behavior when the forecast capacity exceeds the maximum size.
+type PredictiveScalingMaxCapacityBreachBehavior string
+
+var (
+	// PredictiveScalingMaxCapacityBreachBehaviorHonorMaxCapacity never scales above the maximum size of the group.
+	PredictiveScalingMaxCapacityBreachBehaviorHonorMaxCapacity = PredictiveScalingMaxCapacityBreachBehavior("HonorMaxCapacity")
+
+	// PredictiveScalingMaxCapacityBreachBehaviorIncreaseMaxCapacity raises the maximum size of the group
+	// up to the forecast capacity plus the maximum capacity buffer.
+	PredictiveScalingMaxCapacityBreachBehaviorIncreaseMaxCapacity = PredictiveScalingMaxCapacityBreachBehavior("IncreaseMaxCapacity")
+)
+
+// PredictiveScalingPolicy configures a predictive scaling policy for an Auto Scaling group.
+type PredictiveScalingPolicy struct {
+	// Mode is the predictive scaling mode.
+	// +kubebuilder:validation:Enum=ForecastOnly;ForecastAndScale
+	// +kubebuilder:default=ForecastOnly
+	// +optional
+	Mode PredictiveScalingMode `json:"mode,omitempty"`
+
+	// MetricPair is the predefined pair of load and scaling metrics to use.
+	// +kubebuilder:validation:Enum=ASGCPUUtilization;ASGNetworkIn;ASGNetworkOut;ALBRequestCount
+	MetricPair PredictiveScalingMetricPair `json:"metricPair"`
+
+	// ResourceLabel identifies the Application Load Balancer target group, it is required
+	// when the metric pair is ALBRequestCount and forbidden otherwise.
+	// +optional
+	ResourceLabel string `json:"resourceLabel,omitempty"`
+
+	// TargetValue is the target utilization of the scaling metric, e.g. 50 for 50% CPU utilization.
+	// +kubebuilder:validation:Minimum=1
+	TargetValue int64 `json:"targetValue"`
+
+	// SchedulingBufferTime is the number of seconds by which instances are launched ahead of
+	// the forecasted load.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=3600
+	// +optional
+	SchedulingBufferTime *int64 `json:"schedulingBufferTime,omitempty"`
+
+	// MaxCapacityBreachBehavior defines what happens when the forecast capacity is above the maximum size of the group.
+	// +kubebuilder:validation:Enum=HonorMaxCapacity;IncreaseMaxCapacity
+	// +kubebuilder:default=HonorMaxCapacity
+	// +optional
+	MaxCapacityBreachBehavior PredictiveScalingMaxCapacityBreachBehavior `json:"maxCapacityBreachBehavior,omitempty"`
+
+	// MaxCapacityBuffer is the size, as a percentage of the forecast capacity, by which the maximum size
+	// of the group may be raised. Only valid with the IncreaseMaxCapacity breach behavior.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxCapacityBuffer *int64 `json:"maxCapacityBuffer,omitempty"`
+}
+
+// ScalesCapacity returns true if the policy scales the Auto Scaling group, in which case
+// the desired capacity of the group is owned by the policy rather than the MachinePool replicas.
+func (p *PredictiveScalingPolicy) ScalesCapacity() bool {
+	return p != nil && p.Mode == PredictiveScalingModeForecastAndScale
+}
+
+// DefaultStaggeredScaleUpInterval is the default time between two batches of a staggered scale up.
+const DefaultStaggeredScaleUpInterval = time.Minute
+
+// StaggeredScaleUp configures scaling up an Auto Scaling group in batches of instances,
+// to avoid API throttling and ENI attachment storms when launching many instances at once.
+type StaggeredScaleUp struct {
+	// BatchSize is the maximum number of instances added to the desired capacity of the
+	// group at once.
+	// +kubebuilder:validation:Minimum=1
+	BatchSize int32 `json:"batchSize"`
+
+	// Interval is the minimum time between two batches. Defaults to 1m.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+}
+
+// GetInterval returns the interval between two batches, defaulting to DefaultStaggeredScaleUpInterval.
+func (s *StaggeredScaleUp) GetInterval() time.Duration {
+	if s.Interval == nil || s.Interval.Duration <= 0 {
+		return DefaultStaggeredScaleUpInterval
+	}
+	return s.Interval.Duration
+}
+
 // Tags is a mapping for tags.
 type Tags map[string]string
 
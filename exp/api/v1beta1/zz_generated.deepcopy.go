@@ -22,6 +22,7 @@ limitations under the License.
 package v1beta1
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	apiv1beta1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
 	cluster_apiapiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -243,6 +244,16 @@ func (in *AWSMachinePoolSpec) DeepCopyInto(out *AWSMachinePoolSpec) {
 		*out = new(RefreshPreferences)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PredictiveScaling != nil {
+		in, out := &in.PredictiveScaling, &out.PredictiveScaling
+		*out = new(PredictiveScalingPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StaggeredScaleUp != nil {
+		in, out := &in.StaggeredScaleUp, &out.StaggeredScaleUp
+		*out = new(StaggeredScaleUp)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachinePoolSpec.
@@ -272,6 +283,10 @@ func (in *AWSMachinePoolStatus) DeepCopyInto(out *AWSMachinePoolStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LastStaggeredScaleUpTime != nil {
+		in, out := &in.LastStaggeredScaleUpTime, &out.LastStaggeredScaleUpTime
+		*out = (*in).DeepCopy()
+	}
 	if in.FailureReason != nil {
 		in, out := &in.FailureReason, &out.FailureReason
 		*out = new(errors.MachineStatusError)
@@ -287,6 +302,10 @@ func (in *AWSMachinePoolStatus) DeepCopyInto(out *AWSMachinePoolStatus) {
 		*out = new(ASGStatus)
 		**out = **in
 	}
+	if in.LastScalingActivityTime != nil {
+		in, out := &in.LastScalingActivityTime, &out.LastScalingActivityTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachinePoolStatus.
@@ -371,6 +390,11 @@ func (in *AWSManagedMachinePoolSpec) DeepCopyInto(out *AWSManagedMachinePoolSpec
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AvailabilityZoneSubnets != nil {
+		in, out := &in.AvailabilityZoneSubnets, &out.AvailabilityZoneSubnets
+		*out = make([]AvailabilityZoneSubnet, len(*in))
+		copy(*out, *in)
+	}
 	if in.AdditionalTags != nil {
 		in, out := &in.AdditionalTags, &out.AdditionalTags
 		*out = make(apiv1beta1.Tags, len(*in))
@@ -383,6 +407,11 @@ func (in *AWSManagedMachinePoolSpec) DeepCopyInto(out *AWSManagedMachinePoolSpec
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.CrossAccountECRRegistries != nil {
+		in, out := &in.CrossAccountECRRegistries, &out.CrossAccountECRRegistries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.AMIVersion != nil {
 		in, out := &in.AMIVersion, &out.AMIVersion
 		*out = new(string)
@@ -435,6 +464,11 @@ func (in *AWSManagedMachinePoolSpec) DeepCopyInto(out *AWSManagedMachinePoolSpec
 		*out = new(ManagedMachinePoolCapacityType)
 		**out = **in
 	}
+	if in.CapacityBlockReservationID != nil {
+		in, out := &in.CapacityBlockReservationID, &out.CapacityBlockReservationID
+		*out = new(string)
+		**out = **in
+	}
 	if in.UpdateConfig != nil {
 		in, out := &in.UpdateConfig, &out.UpdateConfig
 		*out = new(UpdateConfig)
@@ -529,6 +563,21 @@ func (in *AutoScalingGroup) DeepCopy() *AutoScalingGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AvailabilityZoneSubnet) DeepCopyInto(out *AvailabilityZoneSubnet) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvailabilityZoneSubnet.
+func (in *AvailabilityZoneSubnet) DeepCopy() *AvailabilityZoneSubnet {
+	if in == nil {
+		return nil
+	}
+	out := new(AvailabilityZoneSubnet)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BlockDeviceMapping) DeepCopyInto(out *BlockDeviceMapping) {
 	*out = *in
@@ -763,6 +812,31 @@ func (in *Overrides) DeepCopy() *Overrides {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PredictiveScalingPolicy) DeepCopyInto(out *PredictiveScalingPolicy) {
+	*out = *in
+	if in.SchedulingBufferTime != nil {
+		in, out := &in.SchedulingBufferTime, &out.SchedulingBufferTime
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxCapacityBuffer != nil {
+		in, out := &in.MaxCapacityBuffer, &out.MaxCapacityBuffer
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PredictiveScalingPolicy.
+func (in *PredictiveScalingPolicy) DeepCopy() *PredictiveScalingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PredictiveScalingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RefreshPreferences) DeepCopyInto(out *RefreshPreferences) {
 	*out = *in
@@ -781,6 +855,16 @@ func (in *RefreshPreferences) DeepCopyInto(out *RefreshPreferences) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.CheckpointPercentages != nil {
+		in, out := &in.CheckpointPercentages, &out.CheckpointPercentages
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
+	if in.CheckpointDelay != nil {
+		in, out := &in.CheckpointDelay, &out.CheckpointDelay
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RefreshPreferences.
@@ -793,6 +877,26 @@ func (in *RefreshPreferences) DeepCopy() *RefreshPreferences {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaggeredScaleUp) DeepCopyInto(out *StaggeredScaleUp) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaggeredScaleUp.
+func (in *StaggeredScaleUp) DeepCopy() *StaggeredScaleUp {
+	if in == nil {
+		return nil
+	}
+	out := new(StaggeredScaleUp)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in Tags) DeepCopyInto(out *Tags) {
 	{
@@ -45,6 +45,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
 	asg "sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/autoscaling"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/servicequotas"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/userdata"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	expclusterv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
@@ -56,10 +57,11 @@ import (
 // AWSMachinePoolReconciler reconciles a AWSMachinePool object.
 type AWSMachinePoolReconciler struct {
 	client.Client
-	Recorder          record.EventRecorder
-	WatchFilterValue  string
-	asgServiceFactory func(cloud.ClusterScoper) services.ASGInterface
-	ec2ServiceFactory func(scope.EC2Scope) services.EC2Interface
+	Recorder                   record.EventRecorder
+	WatchFilterValue           string
+	asgServiceFactory          func(cloud.ClusterScoper) services.ASGInterface
+	ec2ServiceFactory          func(scope.EC2Scope) services.EC2Interface
+	serviceQuotaServiceFactory func(scope.ServiceQuotaScope) services.ServiceQuotaInterface
 }
 
 func (r *AWSMachinePoolReconciler) getASGService(scope cloud.ClusterScoper) services.ASGInterface {
@@ -77,6 +79,14 @@ func (r *AWSMachinePoolReconciler) getEC2Service(scope scope.EC2Scope) services.
 	return ec2.NewService(scope)
 }
 
+func (r *AWSMachinePoolReconciler) getServiceQuotaService(scope scope.ServiceQuotaScope) services.ServiceQuotaInterface {
+	if r.serviceQuotaServiceFactory != nil {
+		return r.serviceQuotaServiceFactory(scope)
+	}
+
+	return servicequotas.NewService(scope)
+}
+
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachinepools,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachinepools/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinepools;machinepools/status,verbs=get;list;watch
@@ -241,6 +251,22 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 		return ctrl.Result{}, err
 	}
 
+	// Check the service quotas for the instances to launch, either for a new ASG or when scaling up an existing one.
+	var currentCapacity int32
+	if asg != nil && asg.DesiredCapacity != nil {
+		currentCapacity = *asg.DesiredCapacity
+	}
+	if asg == nil || asgNeedsUpdates(machinePoolScope, asg) {
+		if err := r.reconcileServiceQuotas(machinePoolScope, ec2Scope, currentCapacity); err != nil {
+			if servicequotas.IsInsufficientQuota(err) {
+				machinePoolScope.Info("Insufficient service quota headroom, not scaling Autoscaling Group", "reason", err.Error())
+				return ctrl.Result{RequeueAfter: servicequotas.InsufficientQuotaRequeueAfter}, nil
+			}
+			machinePoolScope.Error(err, "unable to check service quotas")
+			return ctrl.Result{}, err
+		}
+	}
+
 	if asg == nil {
 		// Create new ASG
 		if _, err := r.createPool(machinePoolScope, clusterScope); err != nil {
@@ -260,6 +286,11 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 		return ctrl.Result{}, errors.Wrap(err, "error updating tags")
 	}
 
+	if err := asgsvc.ReconcilePredictiveScalingPolicy(machinePoolScope); err != nil {
+		r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeWarning, "FailedPredictiveScalingPolicyReconcile", "Failed to reconcile predictive scaling policy: %v", err)
+		return ctrl.Result{}, errors.Wrap(err, "error reconciling predictive scaling policy")
+	}
+
 	// Make sure Spec.ProviderID is always set.
 	machinePoolScope.AWSMachinePool.Spec.ProviderID = asg.ID
 	providerIDList := make([]string, len(asg.Instances))
@@ -280,7 +311,25 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 		machinePoolScope.Info("Failed updating instances", "instances", asg.Instances)
 	}
 
-	return ctrl.Result{}, nil
+	result := ctrl.Result{}
+	if inProgress, interval := machinePoolScope.StaggeredScaleUpInProgress(); inProgress {
+		result.RequeueAfter = interval
+	}
+
+	if machinePoolScope.AWSMachinePool.Spec.ScalingActivityEvents {
+		if err := r.reconcileScalingActivities(machinePoolScope, asgsvc, asg.Name); err != nil {
+			machinePoolScope.Error(err, "failed to reconcile scaling activities")
+		}
+		if result.RequeueAfter == 0 || scalingActivityPollInterval < result.RequeueAfter {
+			result.RequeueAfter = scalingActivityPollInterval
+		}
+	} else {
+		// Activities are only reported again from the time the option is re-enabled.
+		machinePoolScope.AWSMachinePool.Status.LastScalingActivityTime = nil
+		conditions.Delete(machinePoolScope.AWSMachinePool, expinfrav1.ScalingActivitySucceededCondition)
+	}
+
+	return result, nil
 }
 
 func (r *AWSMachinePoolReconciler) reconcileDelete(machinePoolScope *scope.MachinePoolScope, clusterScope cloud.ClusterScoper, ec2Scope scope.EC2Scope) (ctrl.Result, error) {
@@ -356,6 +405,39 @@ func (r *AWSMachinePoolReconciler) updatePool(machinePoolScope *scope.MachinePoo
 	return nil
 }
 
+// reconcileServiceQuotas checks the headroom of the service quotas for the instances the ASG launches on top of
+// its current capacity and their network interfaces, when enabled for the cluster.
+func (r *AWSMachinePoolReconciler) reconcileServiceQuotas(machinePoolScope *scope.MachinePoolScope, ec2Scope scope.EC2Scope, currentCapacity int32) error {
+	if ec2Scope.ServiceQuotaChecks() == nil {
+		return nil
+	}
+
+	// The desired capacity is owned by the predictive scaling policy when it scales the group.
+	if machinePoolScope.AWSMachinePool.Spec.PredictiveScaling.ScalesCapacity() {
+		return nil
+	}
+
+	desiredCapacity := machinePoolScope.AWSMachinePool.Spec.MinSize
+	if machinePoolScope.MachinePool.Spec.Replicas != nil {
+		desiredCapacity = *machinePoolScope.MachinePool.Spec.Replicas
+	}
+	instances := int64(desiredCapacity - currentCapacity)
+	if instances <= 0 {
+		return nil
+	}
+
+	req := servicequotas.Request{
+		NetworkInterfaces: instances,
+	}
+	// The instance types and purchase options of a mixed instances policy are chosen by the ASG.
+	if machinePoolScope.AWSMachinePool.Spec.MixedInstancesPolicy == nil {
+		req.InstanceType = machinePoolScope.AWSMachinePool.Spec.AWSLaunchTemplate.InstanceType
+		req.Instances = instances
+	}
+
+	return r.getServiceQuotaService(ec2Scope).ReconcileHeadroom(machinePoolScope.AWSMachinePool, req)
+}
+
 func (r *AWSMachinePoolReconciler) createPool(machinePoolScope *scope.MachinePoolScope, clusterScope cloud.ClusterScoper) (*expinfrav1.AutoScalingGroup, error) {
 	clusterScope.Info("Initializing ASG client")
 
@@ -510,12 +592,15 @@ func (r *AWSMachinePoolReconciler) reconcileTags(machinePoolScope *scope.Machine
 
 // asgNeedsUpdates compares incoming AWSMachinePool and compares against existing ASG.
 func asgNeedsUpdates(machinePoolScope *scope.MachinePoolScope, existingASG *expinfrav1.AutoScalingGroup) bool {
-	if machinePoolScope.MachinePool.Spec.Replicas != nil {
-		if existingASG.DesiredCapacity == nil || *machinePoolScope.MachinePool.Spec.Replicas != *existingASG.DesiredCapacity {
+	// The desired capacity is owned by the predictive scaling policy when it scales the group.
+	if !machinePoolScope.AWSMachinePool.Spec.PredictiveScaling.ScalesCapacity() {
+		if machinePoolScope.MachinePool.Spec.Replicas != nil {
+			if existingASG.DesiredCapacity == nil || *machinePoolScope.MachinePool.Spec.Replicas != *existingASG.DesiredCapacity {
+				return true
+			}
+		} else if existingASG.DesiredCapacity != nil {
 			return true
 		}
-	} else if existingASG.DesiredCapacity != nil {
-		return true
 	}
 
 	if machinePoolScope.AWSMachinePool.Spec.MaxSize != existingASG.MaxSize {
@@ -41,6 +41,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/mock_services"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/servicequotas"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/controllers/noderefutil"
 	capierrors "sigs.k8s.io/cluster-api/errors"
@@ -384,6 +385,7 @@ func Test_asgNeedsUpdates(t *testing.T) {
 							Replicas: pointer.Int32(0),
 						},
 					},
+					AWSMachinePool: &expinfrav1.AWSMachinePool{},
 				},
 				existingASG: &expinfrav1.AutoScalingGroup{
 					DesiredCapacity: pointer.Int32(1),
@@ -400,6 +402,7 @@ func Test_asgNeedsUpdates(t *testing.T) {
 							Replicas: nil,
 						},
 					},
+					AWSMachinePool: &expinfrav1.AWSMachinePool{},
 				},
 				existingASG: &expinfrav1.AutoScalingGroup{
 					DesiredCapacity: pointer.Int32(1),
@@ -416,6 +419,7 @@ func Test_asgNeedsUpdates(t *testing.T) {
 							Replicas: pointer.Int32(0),
 						},
 					},
+					AWSMachinePool: &expinfrav1.AWSMachinePool{},
 				},
 				existingASG: &expinfrav1.AutoScalingGroup{
 					DesiredCapacity: nil,
@@ -575,3 +579,90 @@ func Test_asgNeedsUpdates(t *testing.T) {
 		})
 	}
 }
+
+func Test_reconcileServiceQuotas(t *testing.T) {
+	tests := []struct {
+		name                 string
+		replicas             *int32
+		currentCapacity      int32
+		mixedInstancesPolicy *expinfrav1.MixedInstancesPolicy
+		expect               func(m *mock_services.MockServiceQuotaInterfaceMockRecorder)
+	}{
+		{
+			name:            "checks the instances to launch on top of the current capacity",
+			replicas:        pointer.Int32(3),
+			currentCapacity: 1,
+			expect: func(m *mock_services.MockServiceQuotaInterfaceMockRecorder) {
+				m.ReconcileHeadroom(gomock.Any(), servicequotas.Request{
+					InstanceType:      "m5.large",
+					Instances:         2,
+					NetworkInterfaces: 2,
+				}).Return(nil)
+			},
+		},
+		{
+			name:            "defaults the desired capacity to the minimum size",
+			currentCapacity: 0,
+			expect: func(m *mock_services.MockServiceQuotaInterfaceMockRecorder) {
+				m.ReconcileHeadroom(gomock.Any(), servicequotas.Request{
+					InstanceType:      "m5.large",
+					Instances:         1,
+					NetworkInterfaces: 1,
+				}).Return(nil)
+			},
+		},
+		{
+			name:                 "only checks the network interfaces with a mixed instances policy",
+			replicas:             pointer.Int32(2),
+			mixedInstancesPolicy: &expinfrav1.MixedInstancesPolicy{},
+			expect: func(m *mock_services.MockServiceQuotaInterfaceMockRecorder) {
+				m.ReconcileHeadroom(gomock.Any(), servicequotas.Request{
+					NetworkInterfaces: 2,
+				}).Return(nil)
+			},
+		},
+		{
+			name:            "doesn't check when scaling down",
+			replicas:        pointer.Int32(1),
+			currentCapacity: 2,
+			expect:          func(m *mock_services.MockServiceQuotaInterfaceMockRecorder) {},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			quotaSvc := mock_services.NewMockServiceQuotaInterface(mockCtrl)
+			tt.expect(quotaSvc.EXPECT())
+
+			cs, err := setupCluster("test-cluster")
+			g.Expect(err).To(BeNil())
+			cs.AWSCluster.Spec.ServiceQuotaChecks = &infrav1.ServiceQuotaChecks{}
+
+			machinePoolScope := &scope.MachinePoolScope{
+				MachinePool: &expclusterv1.MachinePool{
+					Spec: expclusterv1.MachinePoolSpec{
+						Replicas: tt.replicas,
+					},
+				},
+				AWSMachinePool: &expinfrav1.AWSMachinePool{
+					Spec: expinfrav1.AWSMachinePoolSpec{
+						MinSize: 1,
+						AWSLaunchTemplate: expinfrav1.AWSLaunchTemplate{
+							InstanceType: "m5.large",
+						},
+						MixedInstancesPolicy: tt.mixedInstancesPolicy,
+					},
+				},
+			}
+			reconciler := AWSMachinePoolReconciler{
+				serviceQuotaServiceFactory: func(scope.ServiceQuotaScope) services.ServiceQuotaInterface {
+					return quotaSvc
+				},
+			}
+
+			g.Expect(reconciler.reconcileServiceQuotas(machinePoolScope, cs, tt.currentCapacity)).To(Succeed())
+		})
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const (
+	// scalingActivityPollInterval is how often the scaling activities of the ASG are polled.
+	scalingActivityPollInterval = time.Minute
+	// maxScalingActivityEvents is the maximum number of scaling activities reported per poll,
+	// so that a burst of activities doesn't flood the machine pool with events.
+	maxScalingActivityEvents = 10
+
+	scalingActivityLaunchPrefix    = "Launching"
+	scalingActivityTerminatePrefix = "Terminating"
+)
+
+// reconcileScalingActivities emits events for the scaling activities of the ASG completed since the last poll,
+// and reports the result of the most recent one on the ScalingActivitySucceeded condition.
+// The first poll only records the time of the most recent activity, as the earlier ones aren't new.
+func (r *AWSMachinePoolReconciler) reconcileScalingActivities(machinePoolScope *scope.MachinePoolScope, asgsvc services.ASGInterface, asgName string) error {
+	awsMachinePool := machinePoolScope.AWSMachinePool
+
+	activities, err := asgsvc.DescribeScalingActivities(asgName, awsMachinePool.Status.LastScalingActivityTime, maxScalingActivityEvents)
+	if err != nil {
+		return err
+	}
+
+	if awsMachinePool.Status.LastScalingActivityTime == nil {
+		lastScalingActivityTime := metav1.Now()
+		if len(activities) > 0 {
+			lastScalingActivityTime = metav1.NewTime(*activities[len(activities)-1].EndTime)
+		}
+		awsMachinePool.Status.LastScalingActivityTime = &lastScalingActivityTime
+		return nil
+	}
+
+	if len(activities) == 0 {
+		return nil
+	}
+
+	for _, activity := range activities {
+		eventType, reason := scalingActivityEvent(activity)
+		r.Recorder.Eventf(awsMachinePool, eventType, reason, "%s: %s", aws.StringValue(activity.Description), scalingActivityMessage(activity))
+	}
+
+	latest := activities[len(activities)-1]
+	if aws.StringValue(latest.StatusCode) == autoscaling.ScalingActivityStatusCodeSuccessful {
+		conditions.MarkTrue(awsMachinePool, expinfrav1.ScalingActivitySucceededCondition)
+	} else {
+		conditions.MarkFalse(awsMachinePool, expinfrav1.ScalingActivitySucceededCondition, expinfrav1.ScalingActivityFailedReason, clusterv1.ConditionSeverityWarning, "%s", scalingActivityMessage(latest))
+	}
+
+	lastScalingActivityTime := metav1.NewTime(*latest.EndTime)
+	awsMachinePool.Status.LastScalingActivityTime = &lastScalingActivityTime
+
+	return nil
+}
+
+// scalingActivityEvent returns the event type and reason reporting a completed scaling activity.
+func scalingActivityEvent(activity *autoscaling.Activity) (string, string) {
+	description := aws.StringValue(activity.Description)
+	succeeded := aws.StringValue(activity.StatusCode) == autoscaling.ScalingActivityStatusCodeSuccessful
+
+	switch {
+	case strings.HasPrefix(description, scalingActivityLaunchPrefix) && succeeded:
+		return corev1.EventTypeNormal, "ScaledOut"
+	case strings.HasPrefix(description, scalingActivityLaunchPrefix):
+		return corev1.EventTypeWarning, "FailedLaunch"
+	case strings.HasPrefix(description, scalingActivityTerminatePrefix) && succeeded:
+		return corev1.EventTypeNormal, "ScaledIn"
+	case strings.HasPrefix(description, scalingActivityTerminatePrefix):
+		return corev1.EventTypeWarning, "FailedTerminate"
+	case succeeded:
+		return corev1.EventTypeNormal, "ScalingActivity"
+	default:
+		return corev1.EventTypeWarning, "FailedScalingActivity"
+	}
+}
+
+// scalingActivityMessage returns a human readable message for the result of a scaling activity.
+func scalingActivityMessage(activity *autoscaling.Activity) string {
+	if message := aws.StringValue(activity.StatusMessage); message != "" {
+		return message
+	}
+	return aws.StringValue(activity.Cause)
+}
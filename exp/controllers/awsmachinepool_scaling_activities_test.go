@@ -0,0 +1,208 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/mock_services"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestScalingActivityEvent(t *testing.T) {
+	tests := []struct {
+		name          string
+		description   string
+		statusCode    string
+		wantEventType string
+		wantReason    string
+	}{
+		{
+			name:          "successful launch is a scale out",
+			description:   "Launching a new EC2 instance: i-0123456789abcdef0",
+			statusCode:    autoscaling.ScalingActivityStatusCodeSuccessful,
+			wantEventType: corev1.EventTypeNormal,
+			wantReason:    "ScaledOut",
+		},
+		{
+			name:          "failed launch",
+			description:   "Launching a new EC2 instance.  Status Reason: Insufficient capacity.",
+			statusCode:    autoscaling.ScalingActivityStatusCodeFailed,
+			wantEventType: corev1.EventTypeWarning,
+			wantReason:    "FailedLaunch",
+		},
+		{
+			name:          "cancelled launch",
+			description:   "Launching a new EC2 instance",
+			statusCode:    autoscaling.ScalingActivityStatusCodeCancelled,
+			wantEventType: corev1.EventTypeWarning,
+			wantReason:    "FailedLaunch",
+		},
+		{
+			name:          "successful termination is a scale in",
+			description:   "Terminating EC2 instance: i-0123456789abcdef0",
+			statusCode:    autoscaling.ScalingActivityStatusCodeSuccessful,
+			wantEventType: corev1.EventTypeNormal,
+			wantReason:    "ScaledIn",
+		},
+		{
+			name:          "failed termination",
+			description:   "Terminating EC2 instance: i-0123456789abcdef0",
+			statusCode:    autoscaling.ScalingActivityStatusCodeFailed,
+			wantEventType: corev1.EventTypeWarning,
+			wantReason:    "FailedTerminate",
+		},
+		{
+			name:          "other failed activity",
+			description:   "Updating load balancers",
+			statusCode:    autoscaling.ScalingActivityStatusCodeFailed,
+			wantEventType: corev1.EventTypeWarning,
+			wantReason:    "FailedScalingActivity",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			eventType, reason := scalingActivityEvent(&autoscaling.Activity{
+				Description: aws.String(tt.description),
+				StatusCode:  aws.String(tt.statusCode),
+			})
+			g.Expect(eventType).To(Equal(tt.wantEventType))
+			g.Expect(reason).To(Equal(tt.wantReason))
+		})
+	}
+}
+
+func TestReconcileScalingActivities(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+
+	t.Run("should emit events and record the most recent activity", func(t *testing.T) {
+		g := NewWithT(t)
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		asgSvc := mock_services.NewMockASGInterface(mockCtrl)
+		recorder := record.NewFakeRecorder(10)
+		reconciler := &AWSMachinePoolReconciler{Recorder: recorder}
+		last := metav1.NewTime(now.Add(-time.Hour))
+		machinePoolScope := &scope.MachinePoolScope{AWSMachinePool: &expinfrav1.AWSMachinePool{
+			Status: expinfrav1.AWSMachinePoolStatus{LastScalingActivityTime: &last},
+		}}
+
+		asgSvc.EXPECT().DescribeScalingActivities("asg", &last, int64(maxScalingActivityEvents)).Return([]*autoscaling.Activity{
+			{
+				Description: aws.String("Launching a new EC2 instance: i-1"),
+				StatusCode:  aws.String(autoscaling.ScalingActivityStatusCodeSuccessful),
+				EndTime:     aws.Time(now.Add(-time.Minute)),
+			},
+			{
+				Description:   aws.String("Launching a new EC2 instance"),
+				StatusCode:    aws.String(autoscaling.ScalingActivityStatusCodeFailed),
+				StatusMessage: aws.String("Insufficient capacity"),
+				EndTime:       aws.Time(now),
+			},
+		}, nil)
+
+		g.Expect(reconciler.reconcileScalingActivities(machinePoolScope, asgSvc, "asg")).To(Succeed())
+		g.Expect(recorder.Events).To(HaveLen(2))
+		g.Expect(<-recorder.Events).To(HavePrefix("Normal ScaledOut"))
+		g.Expect(<-recorder.Events).To(HavePrefix("Warning FailedLaunch"))
+		g.Expect(conditions.IsFalse(machinePoolScope.AWSMachinePool, expinfrav1.ScalingActivitySucceededCondition)).To(BeTrue())
+		g.Expect(conditions.GetReason(machinePoolScope.AWSMachinePool, expinfrav1.ScalingActivitySucceededCondition)).To(Equal(expinfrav1.ScalingActivityFailedReason))
+		g.Expect(machinePoolScope.AWSMachinePool.Status.LastScalingActivityTime.Time).To(BeTemporally("==", now))
+	})
+
+	t.Run("should only record the most recent activity on the first poll", func(t *testing.T) {
+		g := NewWithT(t)
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		asgSvc := mock_services.NewMockASGInterface(mockCtrl)
+		recorder := record.NewFakeRecorder(10)
+		reconciler := &AWSMachinePoolReconciler{Recorder: recorder}
+		machinePoolScope := &scope.MachinePoolScope{AWSMachinePool: &expinfrav1.AWSMachinePool{}}
+
+		asgSvc.EXPECT().DescribeScalingActivities("asg", nil, int64(maxScalingActivityEvents)).Return([]*autoscaling.Activity{
+			{
+				Description: aws.String("Launching a new EC2 instance: i-1"),
+				StatusCode:  aws.String(autoscaling.ScalingActivityStatusCodeSuccessful),
+				EndTime:     aws.Time(now.Add(-time.Minute)),
+			},
+			{
+				Description:   aws.String("Launching a new EC2 instance"),
+				StatusCode:    aws.String(autoscaling.ScalingActivityStatusCodeFailed),
+				StatusMessage: aws.String("Insufficient capacity"),
+				EndTime:       aws.Time(now),
+			},
+		}, nil)
+
+		g.Expect(reconciler.reconcileScalingActivities(machinePoolScope, asgSvc, "asg")).To(Succeed())
+		g.Expect(recorder.Events).To(BeEmpty())
+		g.Expect(conditions.Has(machinePoolScope.AWSMachinePool, expinfrav1.ScalingActivitySucceededCondition)).To(BeFalse())
+		g.Expect(machinePoolScope.AWSMachinePool.Status.LastScalingActivityTime.Time).To(BeTemporally("==", now))
+	})
+
+	t.Run("should record the current time on the first poll without activities", func(t *testing.T) {
+		g := NewWithT(t)
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		asgSvc := mock_services.NewMockASGInterface(mockCtrl)
+		recorder := record.NewFakeRecorder(10)
+		reconciler := &AWSMachinePoolReconciler{Recorder: recorder}
+		machinePoolScope := &scope.MachinePoolScope{AWSMachinePool: &expinfrav1.AWSMachinePool{}}
+
+		asgSvc.EXPECT().DescribeScalingActivities("asg", nil, int64(maxScalingActivityEvents)).Return(nil, nil)
+
+		g.Expect(reconciler.reconcileScalingActivities(machinePoolScope, asgSvc, "asg")).To(Succeed())
+		g.Expect(recorder.Events).To(BeEmpty())
+		g.Expect(machinePoolScope.AWSMachinePool.Status.LastScalingActivityTime).NotTo(BeNil())
+		g.Expect(machinePoolScope.AWSMachinePool.Status.LastScalingActivityTime.Time).To(BeTemporally(">=", now))
+	})
+
+	t.Run("should not emit events when there are no new activities", func(t *testing.T) {
+		g := NewWithT(t)
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		asgSvc := mock_services.NewMockASGInterface(mockCtrl)
+		recorder := record.NewFakeRecorder(10)
+		reconciler := &AWSMachinePoolReconciler{Recorder: recorder}
+		last := metav1.NewTime(now)
+		machinePoolScope := &scope.MachinePoolScope{AWSMachinePool: &expinfrav1.AWSMachinePool{
+			Status: expinfrav1.AWSMachinePoolStatus{LastScalingActivityTime: &last},
+		}}
+
+		asgSvc.EXPECT().DescribeScalingActivities("asg", &last, int64(maxScalingActivityEvents)).Return(nil, nil)
+
+		g.Expect(reconciler.reconcileScalingActivities(machinePoolScope, asgSvc, "asg")).To(Succeed())
+		g.Expect(recorder.Events).To(BeEmpty())
+		g.Expect(machinePoolScope.AWSMachinePool.Status.LastScalingActivityTime).To(Equal(&last))
+	})
+}
@@ -39,6 +39,7 @@ import (
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/eks"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/servicequotas"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	expclusterv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
@@ -189,6 +190,10 @@ func (r *AWSManagedMachinePoolReconciler) reconcileNormal(
 	ekssvc := eks.NewNodegroupService(machinePoolScope)
 
 	if err := ekssvc.ReconcilePool(); err != nil {
+		if servicequotas.IsInsufficientQuota(err) {
+			machinePoolScope.Info("Insufficient service quota headroom, not creating EKS nodegroup", "reason", err.Error())
+			return ctrl.Result{RequeueAfter: servicequotas.InsufficientQuotaRequeueAfter}, nil
+		}
 		return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile machine pool for AWSManagedMachinePool %s/%s", machinePoolScope.ManagedMachinePool.Namespace, machinePoolScope.ManagedMachinePool.Name)
 	}
 
@@ -40,6 +40,7 @@ const (
 	LaunchTemplateNameNotFound = "InvalidLaunchTemplateName.NotFoundException"
 	LoadBalancerNotFound       = "LoadBalancerNotFound"
 	NATGatewayNotFound         = "InvalidNatGatewayID.NotFound"
+	NetworkInterfaceNotFound   = "InvalidNetworkInterfaceID.NotFound"
 	// nolint:gosec
 	NoCredentialProviders                   = "NoCredentialProviders"
 	NoSuchKey                               = "NoSuchKey"
@@ -37,6 +37,10 @@ var (
 	ErrUnknownCapacityType = errors.New("unknown capacity type")
 )
 
+// capacityTypesCapacityBlock is the EKS capacity type for nodegroups launched into a capacity block.
+// It is not yet defined by the vendored AWS SDK.
+const capacityTypesCapacityBlock = "CAPACITY_BLOCK"
+
 // AddonSDKToAddonState is used to convert an AWS SDK Addon to a control plane AddonState.
 func AddonSDKToAddonState(eksAddon *eks.Addon) *ekscontrolplanev1.AddonState {
 	addonState := &ekscontrolplanev1.AddonState{
@@ -171,6 +175,8 @@ func CapacityTypeToSDK(capacityType expinfrav1.ManagedMachinePoolCapacityType) (
 		return eks.CapacityTypesOnDemand, nil
 	case expinfrav1.ManagedMachinePoolCapacityTypeSpot:
 		return eks.CapacityTypesSpot, nil
+	case expinfrav1.ManagedMachinePoolCapacityTypeCapacityBlock:
+		return capacityTypesCapacityBlock, nil
 	default:
 		return "", ErrUnknownCapacityType
 	}
@@ -124,6 +124,17 @@ func MapToSSMTags(src infrav1.Tags) []*ssm.Tag {
 	return tags
 }
 
+// SSMTagsToMap converts a []*ssm.Tag into a infrav1.Tags.
+func SSMTagsToMap(src []*ssm.Tag) infrav1.Tags {
+	tags := make(infrav1.Tags, len(src))
+
+	for _, t := range src {
+		tags[*t.Key] = *t.Value
+	}
+
+	return tags
+}
+
 // ASGTagsToMap converts a []*autoscaling.TagDescription into a infrav1.Tags.
 func ASGTagsToMap(src []*autoscaling.TagDescription) infrav1.Tags {
 	tags := make(infrav1.Tags, len(src))
@@ -120,6 +120,12 @@ type ClusterScoper interface {
 	APIServerPort() int32
 	// AdditionalTags returns any tags that you would like to attach to AWS resources. The returned value will never be nil.
 	AdditionalTags() infrav1.Tags
+	// CostAllocationTags returns the tags used for cost allocation, which are part of AdditionalTags and take precedence
+	// over any other tag of the resources of the cluster. The returned value will never be nil.
+	CostAllocationTags() infrav1.Tags
+	// RemovedCostAllocationTagKeys returns the keys of the cost allocation tags last applied to AWS resources
+	// which have since been removed.
+	RemovedCostAllocationTagKeys() []string
 	// SetFailureDomain sets the infrastructure provider failure domain key to the spec given as input.
 	SetFailureDomain(id string, spec clusterv1.FailureDomainSpec)
 
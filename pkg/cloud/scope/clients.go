@@ -22,6 +22,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/aws/aws-sdk-go/service/backup/backupiface"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/eks"
@@ -38,6 +40,10 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/aws/aws-sdk-go/service/servicediscovery/servicediscoveryiface"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/aws/aws-sdk-go/service/servicequotas/servicequotasiface"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -194,6 +200,36 @@ func NewS3Client(scopeUser cloud.ScopeUsage, session cloud.Session, logger cloud
 	return s3Client
 }
 
+// NewServiceDiscoveryClient creates a new AWS Cloud Map API client for a given session.
+func NewServiceDiscoveryClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger cloud.Logger, target runtime.Object) servicediscoveryiface.ServiceDiscoveryAPI {
+	serviceDiscoveryClient := servicediscovery.New(session.Session(), aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger)).WithLogger(awslogs.NewWrapLogr(logger)))
+	serviceDiscoveryClient.Handlers.Build.PushFrontNamed(getUserAgentHandler())
+	serviceDiscoveryClient.Handlers.CompleteAttempt.PushFront(awsmetrics.CaptureRequestMetrics(scopeUser.ControllerName()))
+	serviceDiscoveryClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(target))
+
+	return serviceDiscoveryClient
+}
+
+// NewServiceQuotasClient creates a new Service Quotas API client for a given session.
+func NewServiceQuotasClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger cloud.Logger, target runtime.Object) servicequotasiface.ServiceQuotasAPI {
+	serviceQuotasClient := servicequotas.New(session.Session(), aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger)).WithLogger(awslogs.NewWrapLogr(logger)))
+	serviceQuotasClient.Handlers.Build.PushFrontNamed(getUserAgentHandler())
+	serviceQuotasClient.Handlers.CompleteAttempt.PushFront(awsmetrics.CaptureRequestMetrics(scopeUser.ControllerName()))
+	serviceQuotasClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(target))
+
+	return serviceQuotasClient
+}
+
+// NewBackupClient creates a new AWS Backup API client for a given session.
+func NewBackupClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger cloud.Logger, target runtime.Object) backupiface.BackupAPI {
+	backupClient := backup.New(session.Session(), aws.NewConfig().WithLogLevel(awslogs.GetAWSLogLevel(logger)).WithLogger(awslogs.NewWrapLogr(logger)))
+	backupClient.Handlers.Build.PushFrontNamed(getUserAgentHandler())
+	backupClient.Handlers.CompleteAttempt.PushFront(awsmetrics.CaptureRequestMetrics(scopeUser.ControllerName()))
+	backupClient.Handlers.Complete.PushBack(recordAWSPermissionsIssue(target))
+
+	return backupClient
+}
+
 func recordAWSPermissionsIssue(target runtime.Object) func(r *request.Request) {
 	return func(r *request.Request) {
 		if awsErr, ok := r.Error.(awserr.Error); ok {
@@ -188,6 +188,9 @@ func (s *ClusterScope) ControlPlaneLoadBalancerScheme() infrav1.ClassicELBScheme
 
 func (s *ClusterScope) ControlPlaneLoadBalancerName() *string {
 	if s.AWSCluster.Spec.ControlPlaneLoadBalancer != nil {
+		if name := s.AWSCluster.Spec.ControlPlaneLoadBalancer.Existing.LoadBalancerName(); name != "" {
+			return &name
+		}
 		return s.AWSCluster.Spec.ControlPlaneLoadBalancer.Name
 	}
 	return nil
@@ -201,6 +204,41 @@ func (s *ClusterScope) Bucket() *infrav1.S3Bucket {
 	return s.AWSCluster.Spec.S3Bucket
 }
 
+// ServiceDiscovery returns the AWS Cloud Map namespace configuration.
+func (s *ClusterScope) ServiceDiscovery() *infrav1.ServiceDiscovery {
+	return s.AWSCluster.Spec.ServiceDiscovery
+}
+
+// ServiceDiscoveryStatus returns the observed AWS Cloud Map namespace.
+func (s *ClusterScope) ServiceDiscoveryStatus() *infrav1.ServiceDiscoveryStatus {
+	return s.AWSCluster.Status.ServiceDiscovery
+}
+
+// SetServiceDiscoveryStatus sets the observed AWS Cloud Map namespace.
+func (s *ClusterScope) SetServiceDiscoveryStatus(status *infrav1.ServiceDiscoveryStatus) {
+	s.AWSCluster.Status.ServiceDiscovery = status
+}
+
+// ServiceQuotaChecks returns the AWS Service Quotas checked before creating resources.
+func (s *ClusterScope) ServiceQuotaChecks() *infrav1.ServiceQuotaChecks {
+	return s.AWSCluster.Spec.ServiceQuotaChecks
+}
+
+// Backup returns the AWS Backup plan configuration.
+func (s *ClusterScope) Backup() *infrav1.Backup {
+	return s.AWSCluster.Spec.Backup
+}
+
+// BackupStatus returns the observed AWS Backup plan.
+func (s *ClusterScope) BackupStatus() *infrav1.BackupStatus {
+	return s.AWSCluster.Status.Backup
+}
+
+// SetBackupStatus sets the observed AWS Backup plan.
+func (s *ClusterScope) SetBackupStatus(status *infrav1.BackupStatus) {
+	s.AWSCluster.Status.Backup = status
+}
+
 // ControlPlaneConfigMapName returns the name of the ConfigMap used to
 // coordinate the bootstrapping of control plane nodes.
 func (s *ClusterScope) ControlPlaneConfigMapName() string {
@@ -249,6 +287,7 @@ func (s *ClusterScope) PatchObject() error {
 			clusterv1.ReadyCondition,
 			infrav1.VpcReadyCondition,
 			infrav1.SubnetsReadyCondition,
+			infrav1.SubnetsFreeIPsSufficientCondition,
 			infrav1.InternetGatewayReadyCondition,
 			infrav1.NatGatewaysReadyCondition,
 			infrav1.RouteTablesReadyCondition,
@@ -256,6 +295,9 @@ func (s *ClusterScope) PatchObject() error {
 			infrav1.BastionHostReadyCondition,
 			infrav1.LoadBalancerReadyCondition,
 			infrav1.PrincipalUsageAllowedCondition,
+			infrav1.ServiceDiscoveryNamespaceReadyCondition,
+			infrav1.BackupPlanReadyCondition,
+			infrav1.ControlPlaneCertificatesRotatedCondition,
 		}})
 }
 
@@ -264,13 +306,35 @@ func (s *ClusterScope) Close() error {
 	return s.PatchObject()
 }
 
-// AdditionalTags returns AdditionalTags from the scope's AWSCluster. The returned value will never be nil.
+// AdditionalTags merges AdditionalTags and CostAllocationTags from the scope's AWSCluster. The returned value will never be nil.
 func (s *ClusterScope) AdditionalTags() infrav1.Tags {
 	if s.AWSCluster.Spec.AdditionalTags == nil {
 		s.AWSCluster.Spec.AdditionalTags = infrav1.Tags{}
 	}
 
-	return s.AWSCluster.Spec.AdditionalTags.DeepCopy()
+	tags := s.AWSCluster.Spec.AdditionalTags.DeepCopy()
+	tags.Merge(s.AWSCluster.Spec.CostAllocationTags)
+
+	return tags
+}
+
+// CostAllocationTags returns CostAllocationTags from the scope's AWSCluster. The returned value will never be nil.
+func (s *ClusterScope) CostAllocationTags() infrav1.Tags {
+	tags := infrav1.Tags{}
+	tags.Merge(s.AWSCluster.Spec.CostAllocationTags)
+
+	return tags
+}
+
+// RemovedCostAllocationTagKeys returns the keys of the cost allocation tags last applied by the scope's AWSCluster
+// which have since been removed from it.
+func (s *ClusterScope) RemovedCostAllocationTagKeys() []string {
+	return removedCostAllocationTagKeys(s.AWSCluster, s.AdditionalTags())
+}
+
+// SetCostAllocationTagsLastApplied records the cost allocation tags applied by the scope's AWSCluster.
+func (s *ClusterScope) SetCostAllocationTagsLastApplied() error {
+	return setCostAllocationTagsLastApplied(s.AWSCluster, s.AWSCluster.Spec.CostAllocationTags)
 }
 
 // APIServerPort returns the APIServerPort to use when creating the load balancer.
@@ -54,4 +54,7 @@ type EC2Scope interface {
 
 	// ImageLookupBaseOS returns the base operating system name to use when looking up AMIs
 	ImageLookupBaseOS() string
+
+	// ServiceQuotaChecks returns the AWS Service Quotas checked before creating resources.
+	ServiceQuotaChecks() *infrav1.ServiceQuotaChecks
 }
@@ -132,14 +132,18 @@ func (s *FargateProfileScope) EnableIAM() bool {
 	return s.enableIAM
 }
 
-// AdditionalTags returns AdditionalTags from the scope's FargateProfile
+// AdditionalTags returns AdditionalTags from the scope's FargateProfile merged with
+// the cost allocation tags of the control plane, which take precedence.
 // The returned value will never be nil.
 func (s *FargateProfileScope) AdditionalTags() infrav1.Tags {
 	if s.FargateProfile.Spec.AdditionalTags == nil {
 		s.FargateProfile.Spec.AdditionalTags = infrav1.Tags{}
 	}
 
-	return s.FargateProfile.Spec.AdditionalTags.DeepCopy()
+	tags := s.FargateProfile.Spec.AdditionalTags.DeepCopy()
+	tags.Merge(s.ControlPlane.Spec.CostAllocationTags)
+
+	return tags
 }
 
 // RoleName returns the node group role name.
@@ -321,14 +321,17 @@ func (m *MachineScope) Close() error {
 }
 
 // AdditionalTags merges AdditionalTags from the scope's AWSCluster and AWSMachine. If the same key is present in both,
-// the value from AWSMachine takes precedence. The returned Tags will never be nil.
+// the value from AWSMachine takes precedence, unless it is a cost allocation tag of the cluster. The returned Tags will
+// never be nil.
 func (m *MachineScope) AdditionalTags() infrav1.Tags {
 	tags := make(infrav1.Tags)
 
 	// Start with the cluster-wide tags...
 	tags.Merge(m.InfraCluster.AdditionalTags())
-	// ... and merge in the Machine's
+	// ... merge in the Machine's...
 	tags.Merge(m.AWSMachine.Spec.AdditionalTags)
+	// ... and enforce the cluster's cost allocation tags.
+	tags.Merge(m.InfraCluster.CostAllocationTags())
 
 	return tags
 }
@@ -20,6 +20,7 @@ import (
 	"encoding/base64"
 	"testing"
 
+	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -28,6 +29,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1beta1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 )
 
@@ -342,3 +345,74 @@ func TestSetProviderID(t *testing.T) {
 		t.Fatalf("Expected providerID %s, got %s", expectedProviderID, providerID)
 	}
 }
+
+func TestAdditionalTagsIncludeCostAllocationTags(t *testing.T) {
+	costAllocationTags := infrav1.Tags{"cost-center": "1234", "team": "platform"}
+	resourceTags := infrav1.Tags{"environment": "production", "team": "overridden"}
+	expectedTags := infrav1.Tags{"cost-center": "1234", "team": "platform", "environment": "production"}
+
+	awsCluster := newAWSCluster("my-cluster")
+	awsCluster.Spec.AdditionalTags = infrav1.Tags{"environment": "production"}
+	awsCluster.Spec.CostAllocationTags = costAllocationTags
+	clusterScope := &ClusterScope{AWSCluster: awsCluster}
+
+	controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{
+		Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+			AdditionalTags:     infrav1.Tags{"environment": "production"},
+			CostAllocationTags: costAllocationTags,
+		},
+	}
+
+	tests := []struct {
+		name  string
+		scope interface{ AdditionalTags() infrav1.Tags }
+	}{
+		{
+			name:  "cluster resources",
+			scope: clusterScope,
+		},
+		{
+			name: "machine resources",
+			scope: &MachineScope{
+				InfraCluster: clusterScope,
+				AWSMachine:   &infrav1.AWSMachine{Spec: infrav1.AWSMachineSpec{AdditionalTags: resourceTags}},
+			},
+		},
+		{
+			name: "machine pool resources",
+			scope: &MachinePoolScope{
+				InfraCluster:   clusterScope,
+				AWSMachinePool: &expinfrav1.AWSMachinePool{Spec: expinfrav1.AWSMachinePoolSpec{AdditionalTags: resourceTags}},
+			},
+		},
+		{
+			name:  "managed control plane resources",
+			scope: &ManagedControlPlaneScope{ControlPlane: controlPlane},
+		},
+		{
+			name: "managed node group resources",
+			scope: &ManagedMachinePoolScope{
+				ControlPlane:       controlPlane,
+				ManagedMachinePool: &expinfrav1.AWSManagedMachinePool{Spec: expinfrav1.AWSManagedMachinePoolSpec{AdditionalTags: resourceTags}},
+			},
+		},
+		{
+			name: "fargate profile resources",
+			scope: &FargateProfileScope{
+				ControlPlane:   controlPlane,
+				FargateProfile: &expinfrav1.AWSFargateProfile{Spec: expinfrav1.FargateProfileSpec{AdditionalTags: resourceTags}},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(tc.scope.AdditionalTags()).To(Equal(expectedTags))
+		})
+	}
+
+	g := NewWithT(t)
+	g.Expect(clusterScope.CostAllocationTags()).To(Equal(costAllocationTags))
+	g.Expect(awsCluster.Spec.AdditionalTags).To(Equal(infrav1.Tags{"environment": "production"}))
+}
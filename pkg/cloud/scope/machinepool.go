@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
@@ -154,14 +155,17 @@ func (m *MachinePoolScope) getBootstrapData() ([]byte, string, error) {
 }
 
 // AdditionalTags merges AdditionalTags from the scope's AWSCluster and AWSMachinePool. If the same key is present in both,
-// the value from AWSMachinePool takes precedence. The returned Tags will never be nil.
+// the value from AWSMachinePool takes precedence, unless it is a cost allocation tag of the cluster. The returned Tags
+// will never be nil.
 func (m *MachinePoolScope) AdditionalTags() infrav1.Tags {
 	tags := make(infrav1.Tags)
 
 	// Start with the cluster-wide tags...
 	tags.Merge(m.InfraCluster.AdditionalTags())
-	// ... and merge in the Machine's
+	// ... merge in the Machine's...
 	tags.Merge(m.AWSMachinePool.Spec.AdditionalTags)
+	// ... and enforce the cluster's cost allocation tags.
+	tags.Merge(m.InfraCluster.CostAllocationTags())
 
 	return tags
 }
@@ -174,6 +178,7 @@ func (m *MachinePoolScope) PatchObject() error {
 		patch.WithOwnedConditions{Conditions: []clusterv1.ConditionType{
 			expinfrav1.ASGReadyCondition,
 			expinfrav1.LaunchTemplateReadyCondition,
+			expinfrav1.ScalingActivitySucceededCondition,
 		}})
 }
 
@@ -225,6 +230,16 @@ func (m *MachinePoolScope) SetLaunchTemplateIDStatus(id string) {
 	m.AWSMachinePool.Status.LaunchTemplateID = id
 }
 
+// StaggeredScaleUpInProgress returns whether a staggered scale up of the ASG hasn't reached the
+// number of replicas yet, and the interval to wait before the next batch.
+func (m *MachinePoolScope) StaggeredScaleUpInProgress() (bool, time.Duration) {
+	config := m.AWSMachinePool.Spec.StaggeredScaleUp
+	if config == nil || m.MachinePool.Spec.Replicas == nil || m.AWSMachinePool.Status.Replicas >= *m.MachinePool.Spec.Replicas {
+		return false, 0
+	}
+	return true, config.GetInterval()
+}
+
 // IsEKSManaged checks if the AWSMachinePool is EKS managed.
 func (m *MachinePoolScope) IsEKSManaged() bool {
 	return m.InfraCluster.InfraCluster().GetObjectKind().GroupVersionKind().Kind == "AWSManagedControlPlane"
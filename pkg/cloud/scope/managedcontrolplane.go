@@ -27,6 +27,8 @@ import (
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/klog/v2/klogr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -48,6 +50,8 @@ func init() {
 	_ = amazoncni.AddToScheme(scheme)
 	_ = appsv1.AddToScheme(scheme)
 	_ = corev1.AddToScheme(scheme)
+	_ = policyv1.AddToScheme(scheme)
+	_ = rbacv1.AddToScheme(scheme)
 }
 
 // ManagedControlPlaneScopeParams defines the input parameters used to create a new Scope.
@@ -231,6 +235,7 @@ func (s *ManagedControlPlaneScope) PatchObject() error {
 		patch.WithOwnedConditions{Conditions: []clusterv1.ConditionType{
 			infrav1.VpcReadyCondition,
 			infrav1.SubnetsReadyCondition,
+			infrav1.SubnetsFreeIPsSufficientCondition,
 			infrav1.ClusterSecurityGroupsReadyCondition,
 			infrav1.InternetGatewayReadyCondition,
 			infrav1.NatGatewaysReadyCondition,
@@ -240,6 +245,13 @@ func (s *ManagedControlPlaneScope) PatchObject() error {
 			ekscontrolplanev1.EKSControlPlaneReadyCondition,
 			ekscontrolplanev1.EKSControlPlaneUpdatingCondition,
 			ekscontrolplanev1.IAMControlPlaneRolesReadyCondition,
+			ekscontrolplanev1.ControlPlaneReachableCondition,
+			ekscontrolplanev1.AddonsHealthyCondition,
+			ekscontrolplanev1.NodeGroupsHealthyCondition,
+			ekscontrolplanev1.AWSNodeRolloutCompleteCondition,
+			ekscontrolplanev1.NodeProblemDetectorReadyCondition,
+			ekscontrolplanev1.CNIMetricsHelperReadyCondition,
+			ekscontrolplanev1.AWSNodePodDisruptionBudgetReadyCondition,
 		}})
 }
 
@@ -248,13 +260,35 @@ func (s *ManagedControlPlaneScope) Close() error {
 	return s.PatchObject()
 }
 
-// AdditionalTags returns AdditionalTags from the scope's EksControlPlane. The returned value will never be nil.
+// AdditionalTags merges AdditionalTags and CostAllocationTags from the scope's EksControlPlane. The returned value will never be nil.
 func (s *ManagedControlPlaneScope) AdditionalTags() infrav1.Tags {
 	if s.ControlPlane.Spec.AdditionalTags == nil {
 		s.ControlPlane.Spec.AdditionalTags = infrav1.Tags{}
 	}
 
-	return s.ControlPlane.Spec.AdditionalTags.DeepCopy()
+	tags := s.ControlPlane.Spec.AdditionalTags.DeepCopy()
+	tags.Merge(s.ControlPlane.Spec.CostAllocationTags)
+
+	return tags
+}
+
+// CostAllocationTags returns CostAllocationTags from the scope's EksControlPlane. The returned value will never be nil.
+func (s *ManagedControlPlaneScope) CostAllocationTags() infrav1.Tags {
+	tags := infrav1.Tags{}
+	tags.Merge(s.ControlPlane.Spec.CostAllocationTags)
+
+	return tags
+}
+
+// RemovedCostAllocationTagKeys returns the keys of the cost allocation tags last applied by the scope's EksControlPlane
+// which have since been removed from it.
+func (s *ManagedControlPlaneScope) RemovedCostAllocationTagKeys() []string {
+	return removedCostAllocationTagKeys(s.ControlPlane, s.AdditionalTags())
+}
+
+// SetCostAllocationTagsLastApplied records the cost allocation tags applied by the scope's EksControlPlane.
+func (s *ManagedControlPlaneScope) SetCostAllocationTagsLastApplied() error {
+	return setCostAllocationTagsLastApplied(s.ControlPlane, s.ControlPlane.Spec.CostAllocationTags)
 }
 
 // APIServerPort returns the port to use when communicating with the API server.
@@ -285,11 +319,22 @@ func (s *ManagedControlPlaneScope) Session() awsclient.ConfigProvider {
 	return s.session
 }
 
+// ServiceQuotaChecks returns the AWS Service Quotas checked before creating resources.
+func (s *ManagedControlPlaneScope) ServiceQuotaChecks() *infrav1.ServiceQuotaChecks {
+	return s.ControlPlane.Spec.ServiceQuotaChecks
+}
+
 // Bastion returns the bastion details.
 func (s *ManagedControlPlaneScope) Bastion() *infrav1.Bastion {
 	return &s.ControlPlane.Spec.Bastion
 }
 
+// ControlPlaneLoadBalancer returns nil, the API server of EKS clusters isn't behind a load balancer
+// managed by the provider.
+func (s *ManagedControlPlaneScope) ControlPlaneLoadBalancer() *infrav1.AWSLoadBalancerSpec {
+	return nil
+}
+
 // SetBastionInstance sets the bastion instance in the status of the cluster.
 func (s *ManagedControlPlaneScope) SetBastionInstance(instance *infrav1.Instance) {
 	s.ControlPlane.Status.Bastion = instance
@@ -367,6 +412,16 @@ func (s *ManagedControlPlaneScope) DisableKubeProxy() bool {
 	return s.ControlPlane.Spec.KubeProxy.Disable
 }
 
+// NodeProblemDetector returns the node-problem-detector configuration of the cluster.
+func (s *ManagedControlPlaneScope) NodeProblemDetector() *ekscontrolplanev1.NodeProblemDetector {
+	return s.ControlPlane.Spec.NodeProblemDetector
+}
+
+// GPUTimeSlicing returns the GPU time-slicing configuration of the cluster.
+func (s *ManagedControlPlaneScope) GPUTimeSlicing() *ekscontrolplanev1.GPUTimeSlicing {
+	return s.ControlPlane.Spec.GPUTimeSlicing
+}
+
 // DisableVPCCNI returns whether the AWS VPC CNI should be disabled.
 func (s *ManagedControlPlaneScope) DisableVPCCNI() bool {
 	return s.ControlPlane.Spec.DisableVPCCNI
@@ -127,6 +127,11 @@ func (s *ManagedMachinePoolScope) ManagedPoolName() string {
 	return s.ManagedMachinePool.Name
 }
 
+// ServiceQuotaChecks returns the AWS Service Quotas checked before creating resources.
+func (s *ManagedMachinePoolScope) ServiceQuotaChecks() *infrav1.ServiceQuotaChecks {
+	return s.ControlPlane.Spec.ServiceQuotaChecks
+}
+
 // ServiceLimiter returns the AWS SDK session. Used for creating clients.
 func (s *ManagedMachinePoolScope) ServiceLimiter(service string) *throttle.ServiceLimiter {
 	if sl, ok := s.serviceLimiters[service]; ok {
@@ -155,14 +160,18 @@ func (s *ManagedMachinePoolScope) IdentityRef() *infrav1.AWSIdentityReference {
 	return s.ControlPlane.Spec.IdentityRef
 }
 
-// AdditionalTags returns AdditionalTags from the scope's ManagedMachinePool
+// AdditionalTags returns AdditionalTags from the scope's ManagedMachinePool merged with
+// the cost allocation tags of the control plane, which take precedence.
 // The returned value will never be nil.
 func (s *ManagedMachinePoolScope) AdditionalTags() infrav1.Tags {
 	if s.ManagedMachinePool.Spec.AdditionalTags == nil {
 		s.ManagedMachinePool.Spec.AdditionalTags = infrav1.Tags{}
 	}
 
-	return s.ManagedMachinePool.Spec.AdditionalTags.DeepCopy()
+	tags := s.ManagedMachinePool.Spec.AdditionalTags.DeepCopy()
+	tags.Merge(s.ControlPlane.Spec.CostAllocationTags)
+
+	return tags
 }
 
 // RoleName returns the node group role name.
@@ -187,11 +196,20 @@ func (s *ManagedMachinePoolScope) SubnetIDs() ([]string, error) {
 		return []string{}, fmt.Errorf("getting subnet placement strategy: %w", err)
 	}
 
+	zoneSubnets := make([]availabilityZoneSubnet, 0, len(s.ManagedMachinePool.Spec.AvailabilityZoneSubnets))
+	for _, zoneSubnet := range s.ManagedMachinePool.Spec.AvailabilityZoneSubnets {
+		zoneSubnets = append(zoneSubnets, availabilityZoneSubnet{
+			AvailabilityZone: zoneSubnet.AvailabilityZone,
+			SubnetID:         zoneSubnet.SubnetID,
+		})
+	}
+
 	return strategy.Place(&placementInput{
-		SpecSubnetIDs:           s.ManagedMachinePool.Spec.SubnetIDs,
-		SpecAvailabilityZones:   s.ManagedMachinePool.Spec.AvailabilityZones,
-		ParentAvailabilityZones: s.MachinePool.Spec.FailureDomains,
-		ControlplaneSubnets:     s.ControlPlaneSubnets(),
+		SpecAvailabilityZoneSubnets: zoneSubnets,
+		SpecSubnetIDs:               s.ManagedMachinePool.Spec.SubnetIDs,
+		SpecAvailabilityZones:       s.ManagedMachinePool.Spec.AvailabilityZones,
+		ParentAvailabilityZones:     s.MachinePool.Spec.FailureDomains,
+		ControlplaneSubnets:         s.ControlPlaneSubnets(),
 	})
 }
 
@@ -243,6 +261,7 @@ func (s *ManagedMachinePoolScope) PatchObject() error {
 		patch.WithOwnedConditions{Conditions: []clusterv1.ConditionType{
 			expinfrav1.EKSNodegroupReadyCondition,
 			expinfrav1.IAMNodegroupRolesReadyCondition,
+			expinfrav1.CrossAccountECRPolicyReadyCondition,
 		}})
 }
 
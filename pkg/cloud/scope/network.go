@@ -42,4 +42,7 @@ type NetworkScope interface {
 
 	// Bastion returns the bastion details for the cluster.
 	Bastion() *infrav1.Bastion
+
+	// ServiceQuotaChecks returns the AWS Service Quotas checked before creating resources.
+	ServiceQuotaChecks() *infrav1.ServiceQuotaChecks
 }
@@ -0,0 +1,34 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud"
+)
+
+// ServiceQuotaScope is the interface for the scope to be used with the service quotas service.
+type ServiceQuotaScope interface {
+	cloud.Logger
+	cloud.Session
+	cloud.ScopeUsage
+
+	// InfraCluster returns the AWS infrastructure cluster object.
+	InfraCluster() cloud.ClusterObject
+	// ServiceQuotaChecks returns the AWS Service Quotas checked before creating resources.
+	ServiceQuotaChecks() *infrav1.ServiceQuotaChecks
+}
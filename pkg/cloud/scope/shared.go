@@ -33,13 +33,24 @@ var (
 	ErrLoggerRequired = errors.New("logger is required")
 	// ErrNotPlaced is an error if there is no placement determined.
 	ErrNotPlaced = errors.New("placement not determined")
+	// ErrAZNotMapped is an error if an availability zone of the parent resource isn't mapped to a subnet.
+	ErrAZNotMapped = errors.New("availability zone not mapped to a subnet")
+	// ErrSubnetAZMismatch is an error if a subnet is mapped to another availability zone than its own.
+	ErrSubnetAZMismatch = errors.New("subnet not in the mapped availability zone")
 )
 
+// availabilityZoneSubnet is a subnet explicitly mapped to an availability zone.
+type availabilityZoneSubnet struct {
+	AvailabilityZone string
+	SubnetID         string
+}
+
 type placementInput struct {
-	SpecSubnetIDs           []string
-	SpecAvailabilityZones   []string
-	ParentAvailabilityZones []string
-	ControlplaneSubnets     infrav1.Subnets
+	SpecAvailabilityZoneSubnets []availabilityZoneSubnet
+	SpecSubnetIDs               []string
+	SpecAvailabilityZones       []string
+	ParentAvailabilityZones     []string
+	ControlplaneSubnets         infrav1.Subnets
 }
 
 type subnetsPlacementStratgey interface {
@@ -62,12 +73,18 @@ type defaultSubnetPlacementStrategy struct {
 }
 
 // Place works out the subnet placement based on the following precedence:
-// 1. Explicit definition of subnet IDs in the spec
-// 2. If the spec has Availability Zones then get the subnets for these AZs
-// 3. If the parent resource has Availability Zones then get the subnets for these AZs
-// 4. All the private subnets from the control plane are used
+// 1. Explicit mapping of Availability Zones to subnets in the spec
+// 2. Explicit definition of subnet IDs in the spec
+// 3. If the spec has Availability Zones then get the subnets for these AZs
+// 4. If the parent resource has Availability Zones then get the subnets for these AZs
+// 5. All the private subnets from the control plane are used
 // In Cluster API Availability Zone can also be referred to by the name `Failure Domain`.
 func (p *defaultSubnetPlacementStrategy) Place(input *placementInput) ([]string, error) {
+	if len(input.SpecAvailabilityZoneSubnets) > 0 {
+		p.logger.V(2).Info("using subnets from the spec availability zone mapping")
+		return p.getMappedSubnets(input.SpecAvailabilityZoneSubnets, input.ParentAvailabilityZones, input.ControlplaneSubnets)
+	}
+
 	if len(input.SpecSubnetIDs) > 0 {
 		p.logger.V(2).Info("using subnets from the spec")
 		return input.SpecSubnetIDs, nil
@@ -102,6 +119,29 @@ func (p *defaultSubnetPlacementStrategy) Place(input *placementInput) ([]string,
 	return nil, ErrNotPlaced
 }
 
+// getMappedSubnets returns the mapped subnets once checked that they cover the availability zones
+// of the parent resource and, for the subnets known to the control plane, that they are in their
+// mapped availability zone.
+func (p *defaultSubnetPlacementStrategy) getMappedSubnets(zoneSubnets []availabilityZoneSubnet, parentAZs []string, controlPlaneSubnets infrav1.Subnets) ([]string, error) {
+	mappedAZs := map[string]bool{}
+	subnetIDs := make([]string, 0, len(zoneSubnets))
+	for _, zoneSubnet := range zoneSubnets {
+		if subnet := controlPlaneSubnets.FindByID(zoneSubnet.SubnetID); subnet != nil && subnet.AvailabilityZone != zoneSubnet.AvailabilityZone {
+			return nil, fmt.Errorf("subnet %s is in availability zone %s, mapped to %s: %w", zoneSubnet.SubnetID, subnet.AvailabilityZone, zoneSubnet.AvailabilityZone, ErrSubnetAZMismatch)
+		}
+		mappedAZs[zoneSubnet.AvailabilityZone] = true
+		subnetIDs = append(subnetIDs, zoneSubnet.SubnetID)
+	}
+
+	for _, zone := range parentAZs {
+		if !mappedAZs[zone] {
+			return nil, fmt.Errorf("getting subnet for availability zone %s: %w", zone, ErrAZNotMapped)
+		}
+	}
+
+	return subnetIDs, nil
+}
+
 func (p *defaultSubnetPlacementStrategy) getSubnetsForAZs(azs []string, controlPlaneSubnets infrav1.Subnets) ([]string, error) {
 	subnetIDs := []string{}
 
@@ -29,6 +29,7 @@ import (
 func TestSubnetPlacement(t *testing.T) {
 	testCases := []struct {
 		name                string
+		specZoneSubnets     []availabilityZoneSubnet
 		specSubnetIDs       []string
 		specAZs             []string
 		parentAZs           []string
@@ -37,6 +38,69 @@ func TestSubnetPlacement(t *testing.T) {
 		expectedSubnetIDs   []string
 		expectError         bool
 	}{
+		{
+			name: "spec availability zone subnets expected",
+			specZoneSubnets: []availabilityZoneSubnet{
+				{AvailabilityZone: "eu-west-1a", SubnetID: "az1"},
+				{AvailabilityZone: "eu-west-1c", SubnetID: "az3-extra"},
+			},
+			specSubnetIDs: []string{"az2"},
+			parentAZs:     []string{"eu-west-1a", "eu-west-1c"},
+			controlPlaneSubnets: infrav1.Subnets{
+				infrav1.SubnetSpec{
+					ID:               "az1",
+					AvailabilityZone: "eu-west-1a",
+				},
+				infrav1.SubnetSpec{
+					ID:               "az2",
+					AvailabilityZone: "eu-west-1b",
+				},
+				infrav1.SubnetSpec{
+					ID:               "az3",
+					AvailabilityZone: "eu-west-1c",
+				},
+			},
+			logger:            klogr.New(),
+			expectedSubnetIDs: []string{"az1", "az3-extra"},
+			expectError:       false,
+		},
+		{
+			name: "spec availability zone subnets not covering the parent azs",
+			specZoneSubnets: []availabilityZoneSubnet{
+				{AvailabilityZone: "eu-west-1a", SubnetID: "az1"},
+			},
+			parentAZs: []string{"eu-west-1a", "eu-west-1b"},
+			controlPlaneSubnets: infrav1.Subnets{
+				infrav1.SubnetSpec{
+					ID:               "az1",
+					AvailabilityZone: "eu-west-1a",
+				},
+				infrav1.SubnetSpec{
+					ID:               "az2",
+					AvailabilityZone: "eu-west-1b",
+				},
+			},
+			logger:      klogr.New(),
+			expectError: true,
+		},
+		{
+			name: "spec availability zone subnet mapped to another az",
+			specZoneSubnets: []availabilityZoneSubnet{
+				{AvailabilityZone: "eu-west-1a", SubnetID: "az2"},
+			},
+			controlPlaneSubnets: infrav1.Subnets{
+				infrav1.SubnetSpec{
+					ID:               "az1",
+					AvailabilityZone: "eu-west-1a",
+				},
+				infrav1.SubnetSpec{
+					ID:               "az2",
+					AvailabilityZone: "eu-west-1b",
+				},
+			},
+			logger:      klogr.New(),
+			expectError: true,
+		},
 		{
 			name:          "spec subnets expected",
 			specSubnetIDs: []string{"az1"},
@@ -152,10 +216,11 @@ func TestSubnetPlacement(t *testing.T) {
 			g.Expect(err).NotTo(HaveOccurred())
 
 			actualSubnetIDs, err := strategy.Place(&placementInput{
-				SpecSubnetIDs:           tc.specSubnetIDs,
-				SpecAvailabilityZones:   tc.specAZs,
-				ParentAvailabilityZones: tc.parentAZs,
-				ControlplaneSubnets:     tc.controlPlaneSubnets,
+				SpecAvailabilityZoneSubnets: tc.specZoneSubnets,
+				SpecSubnetIDs:               tc.specSubnetIDs,
+				SpecAvailabilityZones:       tc.specAZs,
+				ParentAvailabilityZones:     tc.parentAZs,
+				ControlplaneSubnets:         tc.controlPlaneSubnets,
 			})
 
 			if tc.expectError {
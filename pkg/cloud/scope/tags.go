@@ -0,0 +1,80 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"encoding/json"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+)
+
+// removedCostAllocationTagKeys returns the sorted keys of the cost allocation tags last applied
+// to the resources of the cluster object which aren't part of its additional tags anymore.
+func removedCostAllocationTagKeys(obj metav1.Object, additional infrav1.Tags) []string {
+	annotation, ok := obj.GetAnnotations()[infrav1.CostAllocationTagsLastAppliedAnnotation]
+	if !ok {
+		return nil
+	}
+
+	lastApplied := []string{}
+	if err := json.Unmarshal([]byte(annotation), &lastApplied); err != nil {
+		return nil
+	}
+
+	removed := []string{}
+	for _, key := range lastApplied {
+		if _, ok := additional[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	sort.Strings(removed)
+
+	return removed
+}
+
+// setCostAllocationTagsLastApplied records the keys of the cost allocation tags applied
+// to the resources of the cluster object in its annotations.
+func setCostAllocationTagsLastApplied(obj metav1.Object, costAllocation infrav1.Tags) error {
+	annotations := obj.GetAnnotations()
+	if len(costAllocation) == 0 {
+		delete(annotations, infrav1.CostAllocationTagsLastAppliedAnnotation)
+		obj.SetAnnotations(annotations)
+		return nil
+	}
+
+	keys := make([]string, 0, len(costAllocation))
+	for key := range costAllocation {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	b, err := json.Marshal(keys)
+	if err != nil {
+		return err
+	}
+
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[infrav1.CostAllocationTagsLastAppliedAnnotation] = string(b)
+	obj.SetAnnotations(annotations)
+
+	return nil
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+)
+
+func TestCostAllocationTagsLastApplied(t *testing.T) {
+	g := NewWithT(t)
+
+	awsCluster := &infrav1.AWSCluster{
+		Spec: infrav1.AWSClusterSpec{
+			AdditionalTags:     infrav1.Tags{"owner": "me"},
+			CostAllocationTags: infrav1.Tags{"team": "a", "cost-center": "1234"},
+		},
+	}
+	s := &ClusterScope{AWSCluster: awsCluster}
+
+	g.Expect(s.RemovedCostAllocationTagKeys()).To(BeEmpty())
+	g.Expect(s.SetCostAllocationTagsLastApplied()).To(Succeed())
+	g.Expect(awsCluster.Annotations).To(HaveKeyWithValue(infrav1.CostAllocationTagsLastAppliedAnnotation, `["cost-center","team"]`))
+	g.Expect(s.RemovedCostAllocationTagKeys()).To(BeEmpty())
+
+	// Keys moved to the additional tags are still applied, so they aren't removed.
+	awsCluster.Spec.AdditionalTags["team"] = "a"
+	awsCluster.Spec.CostAllocationTags = infrav1.Tags{"project": "x"}
+	g.Expect(s.RemovedCostAllocationTagKeys()).To(Equal([]string{"cost-center"}))
+
+	g.Expect(s.SetCostAllocationTagsLastApplied()).To(Succeed())
+	g.Expect(awsCluster.Annotations).To(HaveKeyWithValue(infrav1.CostAllocationTagsLastAppliedAnnotation, `["project"]`))
+
+	awsCluster.Spec.CostAllocationTags = nil
+	g.Expect(s.RemovedCostAllocationTagKeys()).To(Equal([]string{"project"}))
+	g.Expect(s.SetCostAllocationTagsLastApplied()).To(Succeed())
+	g.Expect(awsCluster.Annotations).NotTo(HaveKey(infrav1.CostAllocationTagsLastAppliedAnnotation))
+}
@@ -18,12 +18,14 @@ package asg
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
@@ -160,8 +162,8 @@ func (s *Service) CreateASG(scope *scope.MachinePoolScope) (*expinfrav1.AutoScal
 		MixedInstancesPolicy: scope.AWSMachinePool.Spec.MixedInstancesPolicy,
 	}
 
-	if scope.MachinePool.Spec.Replicas != nil {
-		input.DesiredCapacity = scope.MachinePool.Spec.Replicas
+	if desired := desiredCapacity(scope); desired != nil {
+		input.DesiredCapacity = desired
 	}
 
 	if scope.AWSMachinePool.Status.LaunchTemplateID == "" {
@@ -281,8 +283,11 @@ func (s *Service) UpdateASG(scope *scope.MachinePoolScope) error {
 		CapacityRebalance:    aws.Bool(scope.AWSMachinePool.Spec.CapacityRebalance),
 	}
 
-	if scope.MachinePool.Spec.Replicas != nil {
-		input.DesiredCapacity = aws.Int64(int64(*scope.MachinePool.Spec.Replicas))
+	// A predictive scaling policy in the ForecastAndScale mode owns the desired capacity.
+	if !scope.AWSMachinePool.Spec.PredictiveScaling.ScalesCapacity() {
+		if desired := desiredCapacity(scope); desired != nil {
+			input.DesiredCapacity = aws.Int64(int64(*desired))
+		}
 	}
 
 	if scope.AWSMachinePool.Spec.MixedInstancesPolicy != nil {
@@ -327,7 +332,8 @@ func (s *Service) CanStartASGInstanceRefresh(scope *scope.MachinePoolScope) (boo
 // StartASGInstanceRefresh will start an ASG instance with refresh.
 func (s *Service) StartASGInstanceRefresh(scope *scope.MachinePoolScope) error {
 	strategy := pointer.StringPtr(autoscaling.RefreshStrategyRolling)
-	var minHealthyPercentage, instanceWarmup *int64
+	var minHealthyPercentage, instanceWarmup, checkpointDelay *int64
+	var checkpointPercentages []*int64
 	if scope.AWSMachinePool.Spec.RefreshPreferences != nil {
 		if scope.AWSMachinePool.Spec.RefreshPreferences.Strategy != nil {
 			strategy = scope.AWSMachinePool.Spec.RefreshPreferences.Strategy
@@ -338,14 +344,20 @@ func (s *Service) StartASGInstanceRefresh(scope *scope.MachinePoolScope) error {
 		if scope.AWSMachinePool.Spec.RefreshPreferences.MinHealthyPercentage != nil {
 			minHealthyPercentage = scope.AWSMachinePool.Spec.RefreshPreferences.MinHealthyPercentage
 		}
+		if len(scope.AWSMachinePool.Spec.RefreshPreferences.CheckpointPercentages) > 0 {
+			checkpointPercentages = aws.Int64Slice(scope.AWSMachinePool.Spec.RefreshPreferences.CheckpointPercentages)
+			checkpointDelay = scope.AWSMachinePool.Spec.RefreshPreferences.CheckpointDelay
+		}
 	}
 
 	input := &autoscaling.StartInstanceRefreshInput{
 		AutoScalingGroupName: aws.String(scope.Name()),
 		Strategy:             strategy,
 		Preferences: &autoscaling.RefreshPreferences{
-			InstanceWarmup:       instanceWarmup,
-			MinHealthyPercentage: minHealthyPercentage,
+			InstanceWarmup:        instanceWarmup,
+			MinHealthyPercentage:  minHealthyPercentage,
+			CheckpointPercentages: checkpointPercentages,
+			CheckpointDelay:       checkpointDelay,
 		},
 	}
 
@@ -495,3 +507,35 @@ func (s *Service) SubnetIDs(scope *scope.MachinePoolScope) ([]string, error) {
 
 	return scope.SubnetIDs(subnetIDs)
 }
+
+// DescribeScalingActivities returns the completed scaling activities of the ASG that ended after the given time,
+// ordered from the oldest to the most recent. At most maxRecords of the most recent activities are considered.
+func (s *Service) DescribeScalingActivities(name string, since *metav1.Time, maxRecords int64) ([]*autoscaling.Activity, error) {
+	input := &autoscaling.DescribeScalingActivitiesInput{
+		AutoScalingGroupName: aws.String(name),
+		MaxRecords:           aws.Int64(maxRecords),
+	}
+
+	out, err := s.ASGClient.DescribeScalingActivities(input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe scaling activities for ASG %q", name)
+	}
+
+	activities := make([]*autoscaling.Activity, 0, len(out.Activities))
+	for _, activity := range out.Activities {
+		// Activities without an end time are still in progress, they are reported once they complete.
+		if activity.EndTime == nil {
+			continue
+		}
+		if since != nil && !activity.EndTime.After(since.Time) {
+			continue
+		}
+		activities = append(activities, activity)
+	}
+
+	sort.SliceStable(activities, func(i, j int) bool {
+		return activities[i].EndTime.Before(*activities[j].EndTime)
+	})
+
+	return activities, nil
+}
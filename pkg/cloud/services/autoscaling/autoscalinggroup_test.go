@@ -19,6 +19,7 @@ package asg
 import (
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
@@ -558,6 +559,28 @@ func TestService_UpdateASG(t *testing.T) {
 				m.UpdateAutoScalingGroup(gomock.AssignableToTypeOf(&autoscaling.UpdateAutoScalingGroupInput{})).Return(&autoscaling.UpdateAutoScalingGroupOutput{}, nil)
 			},
 		},
+		{
+			name:            "should not update the desired capacity while predictive scaling scales the ASG",
+			machinePoolName: "update-asg-predictive-scaling",
+			wantErr:         false,
+			setupMachinePoolScope: func(mps *scope.MachinePoolScope) {
+				mps.MachinePool.Spec.Replicas = aws.Int32(3)
+				mps.AWSMachinePool.Spec.PredictiveScaling = &expinfrav1.PredictiveScalingPolicy{
+					Mode:        expinfrav1.PredictiveScalingModeForecastAndScale,
+					MetricPair:  expinfrav1.PredictiveScalingMetricPairCPUUtilization,
+					TargetValue: 50,
+				}
+			},
+			expect: func(e *mock_ec2iface.MockEC2APIMockRecorder, m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.UpdateAutoScalingGroup(gomock.AssignableToTypeOf(&autoscaling.UpdateAutoScalingGroupInput{})).
+					DoAndReturn(func(input *autoscaling.UpdateAutoScalingGroupInput) (*autoscaling.UpdateAutoScalingGroupOutput, error) {
+						if input.DesiredCapacity != nil {
+							t.Fatalf("unexpected desired capacity %d", aws.Int64Value(input.DesiredCapacity))
+						}
+						return &autoscaling.UpdateAutoScalingGroupOutput{}, nil
+					})
+			},
+		},
 		{
 			name:            "should return error if update ASG fails",
 			machinePoolName: "update-asg-fail",
@@ -586,6 +609,7 @@ func TestService_UpdateASG(t *testing.T) {
 			mps, err := getMachinePoolScope(fakeClient, clusterScope)
 			g.Expect(err).ToNot(HaveOccurred())
 			mps.AWSMachinePool.Name = tt.machinePoolName
+			tt.setupMachinePoolScope(mps)
 
 			err = s.UpdateASG(mps)
 			checkErr(tt.wantErr, err, g)
@@ -982,9 +1006,10 @@ func TestService_StartASGInstanceRefresh(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	tests := []struct {
-		name    string
-		wantErr bool
-		expect  func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+		name               string
+		wantErr            bool
+		refreshPreferences *expinfrav1.RefreshPreferences
+		expect             func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
 	}{
 		{
 			name:    "should return error if start instance refresh failed",
@@ -1016,6 +1041,28 @@ func TestService_StartASGInstanceRefresh(t *testing.T) {
 					Return(&autoscaling.StartInstanceRefreshOutput{}, nil)
 			},
 		},
+		{
+			name:    "should pass the checkpoints to the instance refresh",
+			wantErr: false,
+			refreshPreferences: &expinfrav1.RefreshPreferences{
+				Strategy:              aws.String("Rolling"),
+				MinHealthyPercentage:  aws.Int64(80),
+				CheckpointPercentages: []int64{20, 50, 100},
+				CheckpointDelay:       aws.Int64(600),
+			},
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.StartInstanceRefresh(gomock.Eq(&autoscaling.StartInstanceRefreshInput{
+					AutoScalingGroupName: aws.String("mpn"),
+					Strategy:             aws.String("Rolling"),
+					Preferences: &autoscaling.RefreshPreferences{
+						MinHealthyPercentage:  aws.Int64(80),
+						CheckpointPercentages: aws.Int64Slice([]int64{20, 50, 100}),
+						CheckpointDelay:       aws.Int64(600),
+					},
+				})).
+					Return(&autoscaling.StartInstanceRefreshOutput{}, nil)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -1033,6 +1080,9 @@ func TestService_StartASGInstanceRefresh(t *testing.T) {
 			mps, err := getMachinePoolScope(fakeClient, clusterScope)
 			g.Expect(err).ToNot(HaveOccurred())
 			mps.AWSMachinePool.Name = "mpn"
+			if tt.refreshPreferences != nil {
+				mps.AWSMachinePool.Spec.RefreshPreferences = tt.refreshPreferences
+			}
 
 			err = s.StartASGInstanceRefresh(mps)
 			checkErr(tt.wantErr, err, g)
@@ -1040,6 +1090,91 @@ func TestService_StartASGInstanceRefresh(t *testing.T) {
 	}
 }
 
+func TestService_DescribeScalingActivities(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	now := time.Now()
+	activity := func(id string, endTime *time.Time) *autoscaling.Activity {
+		return &autoscaling.Activity{
+			ActivityId: aws.String(id),
+			StatusCode: aws.String(autoscaling.ScalingActivityStatusCodeSuccessful),
+			EndTime:    endTime,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		since   *metav1.Time
+		wantErr bool
+		wantIDs []string
+		expect  func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+	}{
+		{
+			name:    "should return completed activities from the oldest to the most recent",
+			wantIDs: []string{"old", "new"},
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeScalingActivities(gomock.Eq(&autoscaling.DescribeScalingActivitiesInput{
+					AutoScalingGroupName: aws.String("asgName"),
+					MaxRecords:           aws.Int64(10),
+				})).
+					Return(&autoscaling.DescribeScalingActivitiesOutput{
+						Activities: []*autoscaling.Activity{
+							activity("in-progress", nil),
+							activity("new", aws.Time(now)),
+							activity("old", aws.Time(now.Add(-time.Hour))),
+						},
+					}, nil)
+			},
+		},
+		{
+			name:    "should skip activities that ended before the given time",
+			since:   &metav1.Time{Time: now.Add(-time.Minute)},
+			wantIDs: []string{"new"},
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeScalingActivities(gomock.Any()).
+					Return(&autoscaling.DescribeScalingActivitiesOutput{
+						Activities: []*autoscaling.Activity{
+							activity("new", aws.Time(now)),
+							activity("old", aws.Time(now.Add(-time.Hour))),
+						},
+					}, nil)
+			},
+		},
+		{
+			name:    "should return error if describe scaling activities fails",
+			wantErr: true,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeScalingActivities(gomock.Any()).
+					Return(nil, awserrors.NewFailedDependency("dependency failure"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			fakeClient := getFakeClient()
+
+			clusterScope, err := getClusterScope(fakeClient)
+			g.Expect(err).ToNot(HaveOccurred())
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+			tt.expect(asgMock.EXPECT())
+			s := NewService(clusterScope)
+			s.ASGClient = asgMock
+
+			activities, err := s.DescribeScalingActivities("asgName", tt.since, 10)
+			checkErr(tt.wantErr, err, g)
+			ids := []string{}
+			for _, a := range activities {
+				ids = append(ids, aws.StringValue(a.ActivityId))
+			}
+			if !tt.wantErr {
+				g.Expect(ids).To(Equal(tt.wantIDs))
+			}
+		})
+	}
+}
+
 func getFakeClient() client.Client {
 	scheme := runtime.NewScheme()
 	_ = infrav1.AddToScheme(scheme)
@@ -0,0 +1,173 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/pkg/errors"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const (
+	// predictiveScalingPolicyName is the name of the predictive scaling policy managed by CAPA,
+	// policy names only need to be unique within an ASG.
+	predictiveScalingPolicyName = "cluster-api-provider-aws-predictive-scaling"
+
+	policyTypePredictiveScaling = "PredictiveScaling"
+)
+
+// ReconcilePredictiveScalingPolicy creates, updates or deletes the predictive scaling policy of the ASG
+// so that it matches the AWSMachinePool spec. The PredictiveScalingPolicyReady condition records that
+// a policy was applied, so that the ASG policies are only looked up when there is one to remove.
+func (s *Service) ReconcilePredictiveScalingPolicy(scope *scope.MachinePoolScope) error {
+	policy := scope.AWSMachinePool.Spec.PredictiveScaling
+	if policy == nil && !conditions.Has(scope.AWSMachinePool, expinfrav1.PredictiveScalingPolicyReadyCondition) {
+		return nil
+	}
+
+	existing, err := s.getPredictiveScalingPolicy(scope.Name())
+	if err != nil {
+		conditions.MarkFalse(scope.AWSMachinePool, expinfrav1.PredictiveScalingPolicyReadyCondition, expinfrav1.PredictiveScalingPolicyFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+		return err
+	}
+
+	if policy == nil {
+		if existing != nil {
+			if _, err := s.ASGClient.DeletePolicy(&autoscaling.DeletePolicyInput{
+				AutoScalingGroupName: aws.String(scope.Name()),
+				PolicyName:           aws.String(predictiveScalingPolicyName),
+			}); err != nil {
+				conditions.MarkFalse(scope.AWSMachinePool, expinfrav1.PredictiveScalingPolicyReadyCondition, expinfrav1.PredictiveScalingPolicyFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+				return errors.Wrapf(err, "failed to delete predictive scaling policy for ASG %q", scope.Name())
+			}
+			record.Eventf(scope.AWSMachinePool, "SuccessfulDeletePredictiveScalingPolicy", "Deleted predictive scaling policy for ASG %s", scope.Name())
+		}
+		conditions.Delete(scope.AWSMachinePool, expinfrav1.PredictiveScalingPolicyReadyCondition)
+		return nil
+	}
+
+	desired := createSDKPredictiveScalingConfiguration(policy)
+	if existing == nil || predictiveScalingConfigurationNeedsUpdate(existing.PredictiveScalingConfiguration, desired) {
+		if _, err := s.ASGClient.PutScalingPolicy(&autoscaling.PutScalingPolicyInput{
+			AutoScalingGroupName:           aws.String(scope.Name()),
+			PolicyName:                     aws.String(predictiveScalingPolicyName),
+			PolicyType:                     aws.String(policyTypePredictiveScaling),
+			PredictiveScalingConfiguration: desired,
+		}); err != nil {
+			conditions.MarkFalse(scope.AWSMachinePool, expinfrav1.PredictiveScalingPolicyReadyCondition, expinfrav1.PredictiveScalingPolicyFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+			return errors.Wrapf(err, "failed to put predictive scaling policy for ASG %q", scope.Name())
+		}
+		record.Eventf(scope.AWSMachinePool, "SuccessfulPutPredictiveScalingPolicy", "Configured predictive scaling policy for ASG %s", scope.Name())
+	}
+	conditions.MarkTrue(scope.AWSMachinePool, expinfrav1.PredictiveScalingPolicyReadyCondition)
+
+	return nil
+}
+
+func (s *Service) getPredictiveScalingPolicy(asgName string) (*autoscaling.ScalingPolicy, error) {
+	out, err := s.ASGClient.DescribePolicies(&autoscaling.DescribePoliciesInput{
+		AutoScalingGroupName: aws.String(asgName),
+		PolicyNames:          aws.StringSlice([]string{predictiveScalingPolicyName}),
+		PolicyTypes:          aws.StringSlice([]string{policyTypePredictiveScaling}),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe scaling policies for ASG %q", asgName)
+	}
+
+	for _, policy := range out.ScalingPolicies {
+		if aws.StringValue(policy.PolicyName) == predictiveScalingPolicyName {
+			return policy, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func createSDKPredictiveScalingConfiguration(policy *expinfrav1.PredictiveScalingPolicy) *autoscaling.PredictiveScalingConfiguration {
+	mode := policy.Mode
+	if mode == "" {
+		mode = expinfrav1.PredictiveScalingModeForecastOnly
+	}
+	breachBehavior := policy.MaxCapacityBreachBehavior
+	if breachBehavior == "" {
+		breachBehavior = expinfrav1.PredictiveScalingMaxCapacityBreachBehaviorHonorMaxCapacity
+	}
+
+	metricPair := &autoscaling.PredictiveScalingPredefinedMetricPair{
+		PredefinedMetricType: aws.String(string(policy.MetricPair)),
+	}
+	if policy.ResourceLabel != "" {
+		metricPair.ResourceLabel = aws.String(policy.ResourceLabel)
+	}
+
+	return &autoscaling.PredictiveScalingConfiguration{
+		Mode:                      aws.String(string(mode)),
+		SchedulingBufferTime:      policy.SchedulingBufferTime,
+		MaxCapacityBreachBehavior: aws.String(string(breachBehavior)),
+		MaxCapacityBuffer:         policy.MaxCapacityBuffer,
+		MetricSpecifications: []*autoscaling.PredictiveScalingMetricSpecification{
+			{
+				TargetValue:                       aws.Float64(float64(policy.TargetValue)),
+				PredefinedMetricPairSpecification: metricPair,
+			},
+		},
+	}
+}
+
+// predictiveScalingConfigurationNeedsUpdate compares the fields managed by CAPA, treating the
+// values AWS omits for defaults as equal to the defaults.
+func predictiveScalingConfigurationNeedsUpdate(existing, desired *autoscaling.PredictiveScalingConfiguration) bool {
+	if existing == nil {
+		return true
+	}
+
+	if stringValueOrDefault(existing.Mode, string(expinfrav1.PredictiveScalingModeForecastOnly)) != aws.StringValue(desired.Mode) ||
+		stringValueOrDefault(existing.MaxCapacityBreachBehavior, string(expinfrav1.PredictiveScalingMaxCapacityBreachBehaviorHonorMaxCapacity)) != aws.StringValue(desired.MaxCapacityBreachBehavior) ||
+		aws.Int64Value(existing.SchedulingBufferTime) != aws.Int64Value(desired.SchedulingBufferTime) ||
+		aws.Int64Value(existing.MaxCapacityBuffer) != aws.Int64Value(desired.MaxCapacityBuffer) {
+		return true
+	}
+
+	if len(existing.MetricSpecifications) != len(desired.MetricSpecifications) {
+		return true
+	}
+	for i := range desired.MetricSpecifications {
+		e, d := existing.MetricSpecifications[i], desired.MetricSpecifications[i]
+		if aws.Float64Value(e.TargetValue) != aws.Float64Value(d.TargetValue) || e.PredefinedMetricPairSpecification == nil {
+			return true
+		}
+		if aws.StringValue(e.PredefinedMetricPairSpecification.PredefinedMetricType) != aws.StringValue(d.PredefinedMetricPairSpecification.PredefinedMetricType) ||
+			aws.StringValue(e.PredefinedMetricPairSpecification.ResourceLabel) != aws.StringValue(d.PredefinedMetricPairSpecification.ResourceLabel) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func stringValueOrDefault(v *string, def string) string {
+	if v == nil || *v == "" {
+		return def
+	}
+	return *v
+}
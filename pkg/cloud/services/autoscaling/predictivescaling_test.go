@@ -0,0 +1,237 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/autoscaling/mock_autoscalingiface"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestCreateSDKPredictiveScalingConfiguration(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy *expinfrav1.PredictiveScalingPolicy
+		want   *autoscaling.PredictiveScalingConfiguration
+	}{
+		{
+			name: "defaults mode and breach behavior",
+			policy: &expinfrav1.PredictiveScalingPolicy{
+				MetricPair:  expinfrav1.PredictiveScalingMetricPairCPUUtilization,
+				TargetValue: 50,
+			},
+			want: &autoscaling.PredictiveScalingConfiguration{
+				Mode:                      aws.String("ForecastOnly"),
+				MaxCapacityBreachBehavior: aws.String("HonorMaxCapacity"),
+				MetricSpecifications: []*autoscaling.PredictiveScalingMetricSpecification{
+					{
+						TargetValue: aws.Float64(50),
+						PredefinedMetricPairSpecification: &autoscaling.PredictiveScalingPredefinedMetricPair{
+							PredefinedMetricType: aws.String("ASGCPUUtilization"),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "maps all fields",
+			policy: &expinfrav1.PredictiveScalingPolicy{
+				Mode:                      expinfrav1.PredictiveScalingModeForecastAndScale,
+				MetricPair:                expinfrav1.PredictiveScalingMetricPairALBRequestCount,
+				ResourceLabel:             "app/my-alb/778d41231b141a0f/targetgroup/my-alb-target-group/943f017f100becff",
+				TargetValue:               1000,
+				SchedulingBufferTime:      aws.Int64(300),
+				MaxCapacityBreachBehavior: expinfrav1.PredictiveScalingMaxCapacityBreachBehaviorIncreaseMaxCapacity,
+				MaxCapacityBuffer:         aws.Int64(10),
+			},
+			want: &autoscaling.PredictiveScalingConfiguration{
+				Mode:                      aws.String("ForecastAndScale"),
+				SchedulingBufferTime:      aws.Int64(300),
+				MaxCapacityBreachBehavior: aws.String("IncreaseMaxCapacity"),
+				MaxCapacityBuffer:         aws.Int64(10),
+				MetricSpecifications: []*autoscaling.PredictiveScalingMetricSpecification{
+					{
+						TargetValue: aws.Float64(1000),
+						PredefinedMetricPairSpecification: &autoscaling.PredictiveScalingPredefinedMetricPair{
+							PredefinedMetricType: aws.String("ALBRequestCount"),
+							ResourceLabel:        aws.String("app/my-alb/778d41231b141a0f/targetgroup/my-alb-target-group/943f017f100becff"),
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(createSDKPredictiveScalingConfiguration(tt.policy)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestService_ReconcilePredictiveScalingPolicy(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	policy := &expinfrav1.PredictiveScalingPolicy{
+		MetricPair:  expinfrav1.PredictiveScalingMetricPairCPUUtilization,
+		TargetValue: 50,
+	}
+	describeInput := &autoscaling.DescribePoliciesInput{
+		AutoScalingGroupName: aws.String("asg"),
+		PolicyNames:          aws.StringSlice([]string{predictiveScalingPolicyName}),
+		PolicyTypes:          aws.StringSlice([]string{"PredictiveScaling"}),
+	}
+	// AWS omits the default mode and breach behavior.
+	existingPolicy := &autoscaling.ScalingPolicy{
+		PolicyName: aws.String(predictiveScalingPolicyName),
+		PredictiveScalingConfiguration: &autoscaling.PredictiveScalingConfiguration{
+			MetricSpecifications: []*autoscaling.PredictiveScalingMetricSpecification{
+				{
+					TargetValue: aws.Float64(50),
+					PredefinedMetricPairSpecification: &autoscaling.PredictiveScalingPredefinedMetricPair{
+						PredefinedMetricType: aws.String("ASGCPUUtilization"),
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		policy  *expinfrav1.PredictiveScalingPolicy
+		applied bool
+		wantErr bool
+		expect  func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+	}{
+		{
+			name:   "does not look up policies when no policy is configured or was applied",
+			policy: nil,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {},
+		},
+		{
+			name:   "creates the policy when it does not exist",
+			policy: policy,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribePolicies(describeInput).Return(&autoscaling.DescribePoliciesOutput{}, nil)
+				m.PutScalingPolicy(&autoscaling.PutScalingPolicyInput{
+					AutoScalingGroupName:           aws.String("asg"),
+					PolicyName:                     aws.String(predictiveScalingPolicyName),
+					PolicyType:                     aws.String("PredictiveScaling"),
+					PredictiveScalingConfiguration: createSDKPredictiveScalingConfiguration(policy),
+				}).Return(&autoscaling.PutScalingPolicyOutput{}, nil)
+			},
+		},
+		{
+			name:   "does not update a policy that matches the spec",
+			policy: policy,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribePolicies(describeInput).Return(&autoscaling.DescribePoliciesOutput{
+					ScalingPolicies: []*autoscaling.ScalingPolicy{existingPolicy},
+				}, nil)
+			},
+		},
+		{
+			name: "updates a policy that drifted from the spec",
+			policy: &expinfrav1.PredictiveScalingPolicy{
+				Mode:        expinfrav1.PredictiveScalingModeForecastAndScale,
+				MetricPair:  expinfrav1.PredictiveScalingMetricPairCPUUtilization,
+				TargetValue: 50,
+			},
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribePolicies(describeInput).Return(&autoscaling.DescribePoliciesOutput{
+					ScalingPolicies: []*autoscaling.ScalingPolicy{existingPolicy},
+				}, nil)
+				m.PutScalingPolicy(gomock.AssignableToTypeOf(&autoscaling.PutScalingPolicyInput{})).
+					DoAndReturn(func(input *autoscaling.PutScalingPolicyInput) (*autoscaling.PutScalingPolicyOutput, error) {
+						if aws.StringValue(input.PredictiveScalingConfiguration.Mode) != "ForecastAndScale" {
+							t.Fatalf("unexpected mode %q", aws.StringValue(input.PredictiveScalingConfiguration.Mode))
+						}
+						return &autoscaling.PutScalingPolicyOutput{}, nil
+					})
+			},
+		},
+		{
+			name:    "deletes the policy when it is no longer configured",
+			policy:  nil,
+			applied: true,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribePolicies(describeInput).Return(&autoscaling.DescribePoliciesOutput{
+					ScalingPolicies: []*autoscaling.ScalingPolicy{existingPolicy},
+				}, nil)
+				m.DeletePolicy(&autoscaling.DeletePolicyInput{
+					AutoScalingGroupName: aws.String("asg"),
+					PolicyName:           aws.String(predictiveScalingPolicyName),
+				}).Return(&autoscaling.DeletePolicyOutput{}, nil)
+			},
+		},
+		{
+			name:    "forgets an applied policy which was already removed",
+			policy:  nil,
+			applied: true,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribePolicies(describeInput).Return(&autoscaling.DescribePoliciesOutput{}, nil)
+			},
+		},
+		{
+			name:    "returns error when the policy can't be put",
+			policy:  policy,
+			wantErr: true,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribePolicies(describeInput).Return(&autoscaling.DescribePoliciesOutput{}, nil)
+				m.PutScalingPolicy(gomock.Any()).Return(nil, awserrors.NewFailedDependency("dependency failure"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			fakeClient := getFakeClient()
+
+			clusterScope, err := getClusterScope(fakeClient)
+			g.Expect(err).ToNot(HaveOccurred())
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+			tt.expect(asgMock.EXPECT())
+			s := NewService(clusterScope)
+			s.ASGClient = asgMock
+
+			mps, err := getMachinePoolScope(fakeClient, clusterScope)
+			g.Expect(err).ToNot(HaveOccurred())
+			mps.AWSMachinePool.Name = "asg"
+			mps.AWSMachinePool.Spec.PredictiveScaling = tt.policy
+			if tt.applied {
+				conditions.MarkTrue(mps.AWSMachinePool, expinfrav1.PredictiveScalingPolicyReadyCondition)
+			}
+
+			err = s.ReconcilePredictiveScalingPolicy(mps)
+			checkErr(tt.wantErr, err, g)
+			if tt.wantErr {
+				g.Expect(conditions.IsFalse(mps.AWSMachinePool, expinfrav1.PredictiveScalingPolicyReadyCondition)).To(BeTrue())
+			} else {
+				g.Expect(conditions.IsTrue(mps.AWSMachinePool, expinfrav1.PredictiveScalingPolicyReadyCondition)).To(Equal(tt.policy != nil))
+			}
+		})
+	}
+}
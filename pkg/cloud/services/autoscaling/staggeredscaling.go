@@ -0,0 +1,71 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+)
+
+// desiredCapacity returns the desired capacity to set on the ASG of the machine pool, nil to leave
+// it unchanged. When a staggered scale up is configured, the capacity is raised by at most one
+// batch above the current number of instances per interval.
+func desiredCapacity(scope *scope.MachinePoolScope) *int32 {
+	target := scope.MachinePool.Spec.Replicas
+	config := scope.AWSMachinePool.Spec.StaggeredScaleUp
+	if target == nil || config == nil {
+		return target
+	}
+
+	now := time.Now()
+	current := scope.AWSMachinePool.Status.Replicas
+	desired := staggeredDesiredCapacity(config, current, *target, scope.AWSMachinePool.Spec.MinSize, scope.AWSMachinePool.Status.LastStaggeredScaleUpTime, now)
+	if desired != nil && *desired > current {
+		scope.AWSMachinePool.Status.LastStaggeredScaleUpTime = &metav1.Time{Time: now}
+		if *desired < *target {
+			scope.Info("Staggering ASG scale up", "current", current, "desired", *desired, "target", *target)
+		}
+	}
+	return desired
+}
+
+// staggeredDesiredCapacity returns the desired capacity for the next batch of a scale up from current
+// to target instances. It returns nil while the interval since the last batch hasn't elapsed. Scaling
+// down, and scaling up by no more than one batch, aren't staggered. The desired capacity never goes
+// below the minimum size of the group, which the Auto Scaling API would reject.
+func staggeredDesiredCapacity(config *expinfrav1.StaggeredScaleUp, current, target, minSize int32, lastScaleUp *metav1.Time, now time.Time) *int32 {
+	if target <= current {
+		return &target
+	}
+
+	if lastScaleUp != nil && now.Sub(lastScaleUp.Time) < config.GetInterval() {
+		return nil
+	}
+
+	desired := target
+	if target-current > config.BatchSize {
+		desired = current + config.BatchSize
+	}
+	if desired < minSize {
+		desired = minSize
+	}
+	return &desired
+}
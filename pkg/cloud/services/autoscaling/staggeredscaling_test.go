@@ -0,0 +1,123 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1beta1"
+)
+
+func TestStaggeredDesiredCapacity(t *testing.T) {
+	now := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+	config := &expinfrav1.StaggeredScaleUp{
+		BatchSize: 10,
+		Interval:  &metav1.Duration{Duration: 2 * time.Minute},
+	}
+
+	tests := []struct {
+		name        string
+		config      *expinfrav1.StaggeredScaleUp
+		current     int32
+		target      int32
+		minSize     int32
+		lastScaleUp *metav1.Time
+		want        *int32
+	}{
+		{
+			name:    "scale down is not staggered",
+			config:  config,
+			current: 100,
+			target:  20,
+			want:    pointer.Int32(20),
+		},
+		{
+			name:        "scale down is not delayed by the interval",
+			config:      config,
+			current:     100,
+			target:      20,
+			lastScaleUp: &metav1.Time{Time: now.Add(-time.Second)},
+			want:        pointer.Int32(20),
+		},
+		{
+			name:    "scale up within one batch is not staggered",
+			config:  config,
+			current: 5,
+			target:  15,
+			want:    pointer.Int32(15),
+		},
+		{
+			name:    "first batch of a large scale up",
+			config:  config,
+			current: 0,
+			target:  200,
+			want:    pointer.Int32(10),
+		},
+		{
+			name:        "next batch once the interval elapsed",
+			config:      config,
+			current:     10,
+			target:      200,
+			lastScaleUp: &metav1.Time{Time: now.Add(-2 * time.Minute)},
+			want:        pointer.Int32(20),
+		},
+		{
+			name:        "waits for the interval before the next batch",
+			config:      config,
+			current:     10,
+			target:      200,
+			lastScaleUp: &metav1.Time{Time: now.Add(-time.Minute)},
+			want:        nil,
+		},
+		{
+			name:        "last batch reaches the target",
+			config:      config,
+			current:     195,
+			target:      200,
+			lastScaleUp: &metav1.Time{Time: now.Add(-5 * time.Minute)},
+			want:        pointer.Int32(200),
+		},
+		{
+			name:    "never below the minimum size",
+			config:  config,
+			current: 0,
+			target:  200,
+			minSize: 50,
+			want:    pointer.Int32(50),
+		},
+		{
+			name:        "default interval",
+			config:      &expinfrav1.StaggeredScaleUp{BatchSize: 10},
+			current:     10,
+			target:      200,
+			lastScaleUp: &metav1.Time{Time: now.Add(-30 * time.Second)},
+			want:        nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got := staggeredDesiredCapacity(tt.config, tt.current, tt.target, tt.minSize, tt.lastScaleUp, now)
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
@@ -19,26 +19,42 @@ package awsnode
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	amazoncni "github.com/aws/amazon-vpc-cni-k8s/pkg/apis/crd/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/internal/managed"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
 )
 
 const (
 	awsNodeName      = "aws-node"
 	awsNodeNamespace = "kube-system"
+
+	vpcCniConfigMapName   = "amazon-vpc-cni"
+	eniConfigLabelKey     = "eniConfig.label"
+	defaultENIConfigLabel = "failure-domain.beta.kubernetes.io/zone"
+
+	podMTUEnvName = "AWS_VPC_ENI_MTU"
+	// podMTUAnnotation marks an aws-node DaemonSet whose pod MTU is set by CAPA, so the environment
+	// variable can be removed when PodMTU is unset.
+	podMTUAnnotation = "aws.cluster.x-k8s.io/aws-node-pod-mtu"
 )
 
+// requiredCapabilities are the capabilities the aws-node container needs to manage routes and iptables rules.
+var requiredCapabilities = []corev1.Capability{"NET_ADMIN", "NET_RAW"}
+
 // ReconcileCNI will reconcile the CNI of a service.
 func (s *Service) ReconcileCNI(ctx context.Context) error {
 	s.scope.Info("Reconciling aws-node DaemonSet in cluster", "cluster-name", s.scope.Name(), "cluster-namespace", s.scope.Namespace())
@@ -63,8 +79,16 @@ func (s *Service) ReconcileCNI(ctx context.Context) error {
 		return ErrCNIMissing
 	}
 
+	if err := s.reconcilePodDisruptionBudget(ctx, remoteClient, &ds); err != nil {
+		return fmt.Errorf("reconciling aws-node PodDisruptionBudget: %w", err)
+	}
+
+	if err := s.reconcileMetricsHelper(ctx, remoteClient, &ds); err != nil {
+		return fmt.Errorf("reconciling cni-metrics-helper: %w", err)
+	}
+
 	var needsUpdate bool
-	if len(s.scope.VpcCni().Env) > 0 {
+	if len(s.vpcCniEnv()) > 0 {
 		s.scope.Info("updating aws-node daemonset environment variables", "cluster-name", s.scope.Name(), "cluster-namespace", s.scope.Namespace())
 
 		for i := range ds.Spec.Template.Spec.Containers {
@@ -76,13 +100,29 @@ func (s *Service) ReconcileCNI(ctx context.Context) error {
 		}
 	}
 
+	if s.applyPodMTU(&ds) {
+		needsUpdate = true
+	}
+
+	if s.applySecurityContext(&ds) {
+		needsUpdate = true
+	}
+
+	if s.applyPodSpec(&ds) {
+		needsUpdate = true
+	}
+
+	if s.applyUpdateStrategy(&ds) {
+		needsUpdate = true
+	}
+
 	if s.scope.SecondaryCidrBlock() == nil {
 		if needsUpdate {
 			if err = remoteClient.Update(ctx, &ds, &client.UpdateOptions{}); err != nil {
 				return err
 			}
 		}
-		return nil
+		return s.reconcileRollout(ctx, remoteClient, &ds)
 	}
 
 	sgs, err := s.getSecurityGroups()
@@ -90,10 +130,7 @@ func (s *Service) ReconcileCNI(ctx context.Context) error {
 		return err
 	}
 
-	metaLabels := map[string]string{
-		"app.kubernetes.io/managed-by": "cluster-api-provider-aws",
-		"app.kubernetes.io/part-of":    s.scope.Name(),
-	}
+	metaLabels := s.metaLabels()
 
 	s.scope.Info("for each subnet", "cluster-name", s.scope.Name(), "cluster-namespace", s.scope.Namespace())
 	for _, subnet := range s.secondarySubnets() {
@@ -158,8 +195,14 @@ func (s *Service) ReconcileCNI(ctx context.Context) error {
 		}
 	}
 
+	eniConfigLabel := s.eniConfigLabel()
+	if err := s.reconcileCNIConfigMap(ctx, remoteClient, eniConfigLabel); err != nil {
+		return fmt.Errorf("reconciling %s ConfigMap: %w", vpcCniConfigMapName, err)
+	}
+
 	s.scope.Info("updating containers", "cluster-name", s.scope.Name(), "cluster-namespace", s.scope.Namespace())
-	for _, container := range ds.Spec.Template.Spec.Containers {
+	for i := range ds.Spec.Template.Spec.Containers {
+		container := &ds.Spec.Template.Spec.Containers[i]
 		if container.Name == "aws-node" {
 			container.Env = append(s.filterEnv(container.Env),
 				corev1.EnvVar{
@@ -168,13 +211,150 @@ func (s *Service) ReconcileCNI(ctx context.Context) error {
 				},
 				corev1.EnvVar{
 					Name:  "ENI_CONFIG_LABEL_DEF",
-					Value: "failure-domain.beta.kubernetes.io/zone",
+					Value: eniConfigLabel,
 				},
 			)
 		}
 	}
 
-	return remoteClient.Update(ctx, &ds, &client.UpdateOptions{})
+	if err := remoteClient.Update(ctx, &ds, &client.UpdateOptions{}); err != nil {
+		return err
+	}
+
+	return s.reconcileRollout(ctx, remoteClient, &ds)
+}
+
+func (s *Service) metaLabels() map[string]string {
+	return managed.Labels(s.scope.Name())
+}
+
+func (s *Service) eniConfigLabel() string {
+	if label := s.scope.VpcCni().ENIConfigLabel; label != "" {
+		return label
+	}
+	return defaultENIConfigLabel
+}
+
+// reconcileCNIConfigMap ensures the amazon-vpc-cni ConfigMap selects the ENIConfig of
+// a node with the same label as the aws-node DaemonSet. Other keys are left untouched.
+func (s *Service) reconcileCNIConfigMap(ctx context.Context, remoteClient client.Client, eniConfigLabel string) error {
+	cm := &corev1.ConfigMap{}
+	if err := remoteClient.Get(ctx, types.NamespacedName{Namespace: awsNodeNamespace, Name: vpcCniConfigMapName}, cm); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		s.scope.Info("Creating amazon-vpc-cni ConfigMap", "cluster-name", s.scope.Name(), "cluster-namespace", s.scope.Namespace())
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: awsNodeNamespace,
+				Name:      vpcCniConfigMapName,
+				Labels:    s.metaLabels(),
+			},
+			Data: map[string]string{
+				eniConfigLabelKey: eniConfigLabel,
+			},
+		}
+		return remoteClient.Create(ctx, cm, &client.CreateOptions{})
+	}
+
+	if cm.Data[eniConfigLabelKey] == eniConfigLabel {
+		return nil
+	}
+
+	s.scope.Info("Updating amazon-vpc-cni ConfigMap", "cluster-name", s.scope.Name(), "cluster-namespace", s.scope.Namespace())
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data[eniConfigLabelKey] = eniConfigLabel
+	return remoteClient.Update(ctx, cm, &client.UpdateOptions{})
+}
+
+// applySecurityContext applies the user provided security context to the aws-node container
+// and returns whether the DaemonSet changed.
+func (s *Service) applySecurityContext(ds *appsv1.DaemonSet) bool {
+	spec := s.scope.VpcCni().SecurityContext
+	if spec == nil {
+		return false
+	}
+
+	var needsUpdate bool
+	for i := range ds.Spec.Template.Spec.Containers {
+		container := &ds.Spec.Template.Spec.Containers[i]
+		if container.Name != awsNodeName {
+			continue
+		}
+
+		desired := &corev1.SecurityContext{}
+		if container.SecurityContext != nil {
+			desired = container.SecurityContext.DeepCopy()
+		}
+		if desired.Capabilities == nil {
+			desired.Capabilities = &corev1.Capabilities{}
+		}
+		desired.Capabilities.Drop = spec.DropCapabilities
+		for _, capability := range requiredCapabilities {
+			if !hasCapability(desired.Capabilities.Add, capability) {
+				desired.Capabilities.Add = append(desired.Capabilities.Add, capability)
+			}
+		}
+		// Privilege escalation can't be disabled for a privileged container or one with
+		// CAP_SYS_ADMIN, the pod would be rejected.
+		if !pointer.BoolDeref(desired.Privileged, false) && !hasCapability(desired.Capabilities.Add, "SYS_ADMIN") &&
+			!hasCapability(desired.Capabilities.Add, "CAP_SYS_ADMIN") {
+			desired.AllowPrivilegeEscalation = pointer.Bool(false)
+		}
+		desired.ReadOnlyRootFilesystem = pointer.Bool(spec.ReadOnlyRootFilesystem)
+
+		if !equality.Semantic.DeepEqual(container.SecurityContext, desired) {
+			s.scope.Info("updating aws-node container security context", "cluster-name", s.scope.Name(), "cluster-namespace", s.scope.Namespace())
+			container.SecurityContext = desired
+			needsUpdate = true
+		}
+	}
+
+	return needsUpdate
+}
+
+// applyPodSpec applies the user provided priority class, affinity and annotations to the aws-node
+// pod template and returns whether the DaemonSet changed.
+func (s *Service) applyPodSpec(ds *appsv1.DaemonSet) bool {
+	cni := s.scope.VpcCni()
+	template := &ds.Spec.Template
+
+	var needsUpdate bool
+	if cni.PriorityClassName != "" && template.Spec.PriorityClassName != cni.PriorityClassName {
+		s.scope.Info("updating aws-node priority class", "cluster-name", s.scope.Name(), "cluster-namespace", s.scope.Namespace(), "priority-class", cni.PriorityClassName)
+		template.Spec.PriorityClassName = cni.PriorityClassName
+		needsUpdate = true
+	}
+
+	if cni.Affinity != nil && !equality.Semantic.DeepEqual(template.Spec.Affinity, cni.Affinity) {
+		s.scope.Info("updating aws-node affinity", "cluster-name", s.scope.Name(), "cluster-namespace", s.scope.Namespace())
+		template.Spec.Affinity = cni.Affinity.DeepCopy()
+		needsUpdate = true
+	}
+
+	for k, v := range cni.PodAnnotations {
+		if current, ok := template.Annotations[k]; ok && current == v {
+			continue
+		}
+		if template.Annotations == nil {
+			template.Annotations = map[string]string{}
+		}
+		template.Annotations[k] = v
+		needsUpdate = true
+	}
+
+	return needsUpdate
+}
+
+func hasCapability(capabilities []corev1.Capability, capability corev1.Capability) bool {
+	for _, c := range capabilities {
+		if c == capability {
+			return true
+		}
+	}
+	return false
 }
 
 func (s *Service) getSecurityGroups() ([]string, error) {
@@ -205,13 +385,67 @@ func (s *Service) filterEnv(env []corev1.EnvVar) []corev1.EnvVar {
 	return env[:i]
 }
 
+// vpcCniEnv returns the user provided environment variables of the aws-node container,
+// including the ones derived from the typed VpcCni fields.
+func (s *Service) vpcCniEnv() []corev1.EnvVar {
+	env := s.scope.VpcCni().Env
+	if mtu := s.scope.VpcCni().PodMTU; mtu != nil {
+		env = append(append([]corev1.EnvVar{}, env...), corev1.EnvVar{
+			Name:  podMTUEnvName,
+			Value: strconv.Itoa(*mtu),
+		})
+	}
+	return env
+}
+
+// applyPodMTU marks the DaemonSet when the pod MTU is set, and removes the environment variable set
+// from it once PodMTU is unset so the VPC CNI default applies again. It returns whether the DaemonSet changed.
+func (s *Service) applyPodMTU(ds *appsv1.DaemonSet) bool {
+	_, managed := ds.Annotations[podMTUAnnotation]
+
+	if s.scope.VpcCni().PodMTU != nil {
+		if managed {
+			return false
+		}
+		if ds.Annotations == nil {
+			ds.Annotations = map[string]string{}
+		}
+		ds.Annotations[podMTUAnnotation] = "true"
+		return true
+	}
+
+	if !managed {
+		return false
+	}
+	delete(ds.Annotations, podMTUAnnotation)
+	for _, e := range s.scope.VpcCni().Env {
+		if e.Name == podMTUEnvName {
+			return true
+		}
+	}
+	for i := range ds.Spec.Template.Spec.Containers {
+		container := &ds.Spec.Template.Spec.Containers[i]
+		if container.Name != awsNodeName {
+			continue
+		}
+		env := container.Env[:0]
+		for _, e := range container.Env {
+			if e.Name != podMTUEnvName {
+				env = append(env, e)
+			}
+		}
+		container.Env = env
+	}
+	return true
+}
+
 // applyUserProvidedEnvironmentProperties takes a container environment and applies user provided values to it.
 func (s *Service) applyUserProvidedEnvironmentProperties(containerEnv []corev1.EnvVar) ([]corev1.EnvVar, bool) {
 	var (
 		envVars     = make(map[string]corev1.EnvVar)
 		needsUpdate = false
 	)
-	for _, e := range s.scope.VpcCni().Env {
+	for _, e := range s.vpcCniEnv() {
 		envVars[e.Name] = e
 	}
 	// Handle the case where we overwrite an existing value if it's not already the desired value.
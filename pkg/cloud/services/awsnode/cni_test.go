@@ -9,9 +9,11 @@ import (
 	v1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
 )
 
@@ -164,6 +166,159 @@ func TestReconcileCniVpcCniValues(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "pod MTU is set as environment value",
+			cniValues: ekscontrolplanev1.VpcCni{
+				Env: []corev1.EnvVar{
+					{
+						Name:  "NAME1",
+						Value: "VALUE1",
+					},
+				},
+				PodMTU: pointer.Int(8961),
+			},
+			daemonSet: &v1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "aws-node",
+					Namespace: "kube-system",
+				},
+				Spec: v1.DaemonSetSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name: "aws-node",
+									Env: []corev1.EnvVar{
+										{
+											Name:  "AWS_VPC_ENI_MTU",
+											Value: "9001",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			consistsOf: []corev1.EnvVar{
+				{
+					Name:  "NAME1",
+					Value: "VALUE1",
+				},
+				{
+					Name:  "AWS_VPC_ENI_MTU",
+					Value: "8961",
+				},
+			},
+		},
+		{
+			name: "pod MTU is set without other environment values",
+			cniValues: ekscontrolplanev1.VpcCni{
+				PodMTU: pointer.Int(1500),
+			},
+			daemonSet: &v1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "aws-node",
+					Namespace: "kube-system",
+				},
+				Spec: v1.DaemonSetSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name: "aws-node",
+								},
+							},
+						},
+					},
+				},
+			},
+			consistsOf: []corev1.EnvVar{
+				{
+					Name:  "AWS_VPC_ENI_MTU",
+					Value: "1500",
+				},
+			},
+		},
+		{
+			name:      "pod MTU is removed when it is unset",
+			cniValues: ekscontrolplanev1.VpcCni{},
+			daemonSet: &v1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "aws-node",
+					Namespace:   "kube-system",
+					Annotations: map[string]string{"aws.cluster.x-k8s.io/aws-node-pod-mtu": "true"},
+				},
+				Spec: v1.DaemonSetSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name: "aws-node",
+									Env: []corev1.EnvVar{
+										{
+											Name:  "NAME1",
+											Value: "VALUE1",
+										},
+										{
+											Name:  "AWS_VPC_ENI_MTU",
+											Value: "1500",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			consistsOf: []corev1.EnvVar{
+				{
+					Name:  "NAME1",
+					Value: "VALUE1",
+				},
+			},
+		},
+		{
+			name: "pod MTU is kept when it is unset but set as environment value",
+			cniValues: ekscontrolplanev1.VpcCni{
+				Env: []corev1.EnvVar{
+					{
+						Name:  "AWS_VPC_ENI_MTU",
+						Value: "1500",
+					},
+				},
+			},
+			daemonSet: &v1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "aws-node",
+					Namespace:   "kube-system",
+					Annotations: map[string]string{"aws.cluster.x-k8s.io/aws-node-pod-mtu": "true"},
+				},
+				Spec: v1.DaemonSetSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name: "aws-node",
+									Env: []corev1.EnvVar{
+										{
+											Name:  "AWS_VPC_ENI_MTU",
+											Value: "1500",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			consistsOf: []corev1.EnvVar{
+				{
+					Name:  "AWS_VPC_ENI_MTU",
+					Value: "1500",
+				},
+			},
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -186,6 +341,292 @@ func TestReconcileCniVpcCniValues(t *testing.T) {
 			g.Expect(ok).To(BeTrue())
 			g.Expect(ds.Spec.Template.Spec.Containers).NotTo(BeEmpty())
 			g.Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ConsistOf(tc.consistsOf))
+			if tc.cniValues.PodMTU != nil {
+				g.Expect(ds.Annotations).To(HaveKey("aws.cluster.x-k8s.io/aws-node-pod-mtu"))
+			} else {
+				g.Expect(ds.Annotations).NotTo(HaveKey("aws.cluster.x-k8s.io/aws-node-pod-mtu"))
+			}
+		})
+	}
+}
+
+func TestReconcileCniSecurityContext(t *testing.T) {
+	privileged := &corev1.SecurityContext{Privileged: pointer.Bool(true)}
+	hardened := &corev1.SecurityContext{
+		Capabilities: &corev1.Capabilities{
+			Add:  []corev1.Capability{"NET_ADMIN", "NET_RAW"},
+			Drop: []corev1.Capability{"SYS_ADMIN", "SYS_PTRACE"},
+		},
+		AllowPrivilegeEscalation: pointer.Bool(false),
+		ReadOnlyRootFilesystem:   pointer.Bool(true),
+	}
+	daemonSet := func(securityContext *corev1.SecurityContext) *v1.DaemonSet {
+		return &v1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "aws-node",
+				Namespace: "kube-system",
+			},
+			Spec: v1.DaemonSetSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						InitContainers: []corev1.Container{
+							{
+								Name:            "aws-vpc-cni-init",
+								SecurityContext: privileged,
+							},
+						},
+						Containers: []corev1.Container{
+							{
+								Name:            "aws-node",
+								SecurityContext: securityContext,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name                    string
+		cniValues               ekscontrolplanev1.VpcCni
+		daemonSet               *v1.DaemonSet
+		expectUpdate            bool
+		expectedSecurityContext *corev1.SecurityContext
+	}{
+		{
+			name: "drops capabilities and keeps the ones required by the cni",
+			cniValues: ekscontrolplanev1.VpcCni{
+				SecurityContext: &ekscontrolplanev1.VpcCniSecurityContext{
+					DropCapabilities:       []corev1.Capability{"SYS_ADMIN", "SYS_PTRACE"},
+					ReadOnlyRootFilesystem: true,
+				},
+			},
+			daemonSet: daemonSet(&corev1.SecurityContext{
+				Capabilities: &corev1.Capabilities{Add: []corev1.Capability{"NET_ADMIN"}},
+			}),
+			expectUpdate:            true,
+			expectedSecurityContext: hardened,
+		},
+		{
+			name: "sets security context on a container without one",
+			cniValues: ekscontrolplanev1.VpcCni{
+				SecurityContext: &ekscontrolplanev1.VpcCniSecurityContext{},
+			},
+			daemonSet:    daemonSet(nil),
+			expectUpdate: true,
+			expectedSecurityContext: &corev1.SecurityContext{
+				Capabilities: &corev1.Capabilities{
+					Add: []corev1.Capability{"NET_ADMIN", "NET_RAW"},
+				},
+				AllowPrivilegeEscalation: pointer.Bool(false),
+				ReadOnlyRootFilesystem:   pointer.Bool(false),
+			},
+		},
+		{
+			name: "does not disable privilege escalation on a privileged container",
+			cniValues: ekscontrolplanev1.VpcCni{
+				SecurityContext: &ekscontrolplanev1.VpcCniSecurityContext{},
+			},
+			daemonSet:    daemonSet(privileged.DeepCopy()),
+			expectUpdate: true,
+			expectedSecurityContext: &corev1.SecurityContext{
+				Privileged: pointer.Bool(true),
+				Capabilities: &corev1.Capabilities{
+					Add: []corev1.Capability{"NET_ADMIN", "NET_RAW"},
+				},
+				ReadOnlyRootFilesystem: pointer.Bool(false),
+			},
+		},
+		{
+			name: "does not disable privilege escalation on a container with CAP_SYS_ADMIN",
+			cniValues: ekscontrolplanev1.VpcCni{
+				SecurityContext: &ekscontrolplanev1.VpcCniSecurityContext{},
+			},
+			daemonSet: daemonSet(&corev1.SecurityContext{
+				Capabilities: &corev1.Capabilities{Add: []corev1.Capability{"SYS_ADMIN"}},
+			}),
+			expectUpdate: true,
+			expectedSecurityContext: &corev1.SecurityContext{
+				Capabilities: &corev1.Capabilities{
+					Add: []corev1.Capability{"SYS_ADMIN", "NET_ADMIN", "NET_RAW"},
+				},
+				ReadOnlyRootFilesystem: pointer.Bool(false),
+			},
+		},
+		{
+			name: "does not update when the security context is already applied",
+			cniValues: ekscontrolplanev1.VpcCni{
+				SecurityContext: &ekscontrolplanev1.VpcCniSecurityContext{
+					DropCapabilities:       []corev1.Capability{"SYS_ADMIN", "SYS_PTRACE"},
+					ReadOnlyRootFilesystem: true,
+				},
+			},
+			daemonSet:    daemonSet(hardened.DeepCopy()),
+			expectUpdate: false,
+		},
+		{
+			name:         "leaves the security context untouched when not configured",
+			cniValues:    ekscontrolplanev1.VpcCni{},
+			daemonSet:    daemonSet(nil),
+			expectUpdate: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockClient := &cachingClient{
+				getValue: tc.daemonSet,
+			}
+			m := &mockScope{
+				client: mockClient,
+				cni:    tc.cniValues,
+			}
+			s := NewService(m)
+
+			err := s.ReconcileCNI(context.Background())
+			g.Expect(err).NotTo(HaveOccurred())
+			if !tc.expectUpdate {
+				g.Expect(mockClient.updateChain).To(BeEmpty())
+				return
+			}
+			g.Expect(mockClient.updateChain).To(HaveLen(1))
+			ds, ok := mockClient.updateChain[0].(*v1.DaemonSet)
+			g.Expect(ok).To(BeTrue())
+			g.Expect(ds.Spec.Template.Spec.Containers[0].SecurityContext).To(Equal(tc.expectedSecurityContext))
+			g.Expect(ds.Spec.Template.Spec.InitContainers[0].SecurityContext).To(Equal(privileged))
+		})
+	}
+}
+
+func TestReconcileCniPodSpec(t *testing.T) {
+	affinity := &corev1.Affinity{
+		NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{
+					{
+						MatchExpressions: []corev1.NodeSelectorRequirement{
+							{
+								Key:      "eks.amazonaws.com/compute-type",
+								Operator: corev1.NodeSelectorOpNotIn,
+								Values:   []string{"fargate"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	daemonSet := func(template corev1.PodTemplateSpec) *v1.DaemonSet {
+		template.Spec.Containers = []corev1.Container{{Name: "aws-node"}}
+		return &v1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "aws-node",
+				Namespace: "kube-system",
+			},
+			Spec: v1.DaemonSetSpec{
+				Template: template,
+			},
+		}
+	}
+
+	tests := []struct {
+		name             string
+		cniValues        ekscontrolplanev1.VpcCni
+		daemonSet        *v1.DaemonSet
+		expectUpdate     bool
+		expectedTemplate corev1.PodTemplateSpec
+	}{
+		{
+			name: "sets the priority class",
+			cniValues: ekscontrolplanev1.VpcCni{
+				PriorityClassName: "system-node-critical",
+			},
+			daemonSet:    daemonSet(corev1.PodTemplateSpec{}),
+			expectUpdate: true,
+			expectedTemplate: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					PriorityClassName: "system-node-critical",
+					Containers:        []corev1.Container{{Name: "aws-node"}},
+				},
+			},
+		},
+		{
+			name: "replaces the affinity and adds annotations",
+			cniValues: ekscontrolplanev1.VpcCni{
+				Affinity:       affinity,
+				PodAnnotations: map[string]string{"example.com/owner": "platform"},
+			},
+			daemonSet: daemonSet(corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"existing": "value"},
+				},
+				Spec: corev1.PodSpec{
+					Affinity: &corev1.Affinity{},
+				},
+			}),
+			expectUpdate: true,
+			expectedTemplate: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"existing": "value", "example.com/owner": "platform"},
+				},
+				Spec: corev1.PodSpec{
+					Affinity:   affinity,
+					Containers: []corev1.Container{{Name: "aws-node"}},
+				},
+			},
+		},
+		{
+			name: "does not update when the pod spec is already applied",
+			cniValues: ekscontrolplanev1.VpcCni{
+				PriorityClassName: "system-node-critical",
+				Affinity:          affinity,
+				PodAnnotations:    map[string]string{"example.com/owner": "platform"},
+			},
+			daemonSet: daemonSet(corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"example.com/owner": "platform"},
+				},
+				Spec: corev1.PodSpec{
+					PriorityClassName: "system-node-critical",
+					Affinity:          affinity.DeepCopy(),
+				},
+			}),
+			expectUpdate: false,
+		},
+		{
+			name:      "leaves the pod spec untouched when not configured",
+			cniValues: ekscontrolplanev1.VpcCni{},
+			daemonSet: daemonSet(corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					PriorityClassName: "custom",
+				},
+			}),
+			expectUpdate: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockClient := &cachingClient{
+				getValue: tc.daemonSet,
+			}
+			m := &mockScope{
+				client: mockClient,
+				cni:    tc.cniValues,
+			}
+			s := NewService(m)
+
+			err := s.ReconcileCNI(context.Background())
+			g.Expect(err).NotTo(HaveOccurred())
+			if !tc.expectUpdate {
+				g.Expect(mockClient.updateChain).To(BeEmpty())
+				return
+			}
+			g.Expect(mockClient.updateChain).To(HaveLen(1))
+			ds, ok := mockClient.updateChain[0].(*v1.DaemonSet)
+			g.Expect(ok).To(BeTrue())
+			g.Expect(ds.Spec.Template).To(Equal(tc.expectedTemplate))
 		})
 	}
 }
@@ -211,8 +652,16 @@ func (c *cachingClient) Update(ctx context.Context, obj client.Object, opts ...c
 
 type mockScope struct {
 	scope.AWSNodeScope
-	client client.Client
-	cni    ekscontrolplanev1.VpcCni
+	client       client.Client
+	cni          ekscontrolplanev1.VpcCni
+	controlPlane *ekscontrolplanev1.AWSManagedControlPlane
+}
+
+func (s *mockScope) InfraCluster() cloud.ClusterObject {
+	if s.controlPlane == nil {
+		s.controlPlane = &ekscontrolplanev1.AWSManagedControlPlane{}
+	}
+	return s.controlPlane
 }
 
 func (s *mockScope) RemoteClient() (client.Client, error) {
@@ -231,6 +680,10 @@ func (s *mockScope) Name() string {
 	return "mock-name"
 }
 
+func (s *mockScope) KubernetesClusterName() string {
+	return "mock-eks-name"
+}
+
 func (s *mockScope) Namespace() string {
 	return "mock-namespace"
 }
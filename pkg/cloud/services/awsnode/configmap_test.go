@@ -0,0 +1,172 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsnode
+
+import (
+	"context"
+	"testing"
+
+	amazoncni "github.com/aws/amazon-vpc-cni-k8s/pkg/apis/crd/v1alpha1"
+	"github.com/aws/aws-sdk-go/aws"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
+)
+
+func TestReconcileCniConfigMapAndEnv(t *testing.T) {
+	tests := []struct {
+		name        string
+		cniValues   ekscontrolplanev1.VpcCni
+		existing    *corev1.ConfigMap
+		expectLabel string
+		expectData  map[string]string
+	}{
+		{
+			name:        "creates ConfigMap with the default label",
+			cniValues:   ekscontrolplanev1.VpcCni{},
+			expectLabel: "failure-domain.beta.kubernetes.io/zone",
+			expectData: map[string]string{
+				"eniConfig.label": "failure-domain.beta.kubernetes.io/zone",
+			},
+		},
+		{
+			name:        "creates ConfigMap with the configured label",
+			cniValues:   ekscontrolplanev1.VpcCni{ENIConfigLabel: "topology.kubernetes.io/zone"},
+			expectLabel: "topology.kubernetes.io/zone",
+			expectData: map[string]string{
+				"eniConfig.label": "topology.kubernetes.io/zone",
+			},
+		},
+		{
+			name:      "updates the label of an existing ConfigMap and keeps other keys",
+			cniValues: ekscontrolplanev1.VpcCni{ENIConfigLabel: "topology.kubernetes.io/zone"},
+			existing: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "amazon-vpc-cni", Namespace: "kube-system"},
+				Data: map[string]string{
+					"eniConfig.label":                  "failure-domain.beta.kubernetes.io/zone",
+					"enable-windows-ipam":              "false",
+					"enable-network-policy-controller": "false",
+				},
+			},
+			expectLabel: "topology.kubernetes.io/zone",
+			expectData: map[string]string{
+				"eniConfig.label":                  "topology.kubernetes.io/zone",
+				"enable-windows-ipam":              "false",
+				"enable-network-policy-controller": "false",
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			g.Expect(v1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(policyv1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(rbacv1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(amazoncni.AddToScheme(scheme)).To(Succeed())
+
+			objs := []client.Object{
+				&v1.DaemonSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "aws-node", Namespace: "kube-system"},
+					Spec: v1.DaemonSetSpec{
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{
+									{
+										Name: "aws-node",
+										Env: []corev1.EnvVar{
+											{Name: "ENI_CONFIG_LABEL_DEF", Value: "stale"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			if tc.existing != nil {
+				objs = append(objs, tc.existing)
+			}
+			remoteClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+
+			s := NewService(&customNetworkScope{
+				mockScope: mockScope{
+					client: remoteClient,
+					cni:    tc.cniValues,
+				},
+			})
+
+			// Reconcile twice to ensure the reconcile is idempotent.
+			for i := 0; i < 2; i++ {
+				g.Expect(s.ReconcileCNI(context.Background())).To(Succeed())
+			}
+
+			cm := &corev1.ConfigMap{}
+			g.Expect(remoteClient.Get(context.Background(), types.NamespacedName{Namespace: "kube-system", Name: "amazon-vpc-cni"}, cm)).To(Succeed())
+			g.Expect(cm.Data).To(Equal(tc.expectData))
+
+			ds := &v1.DaemonSet{}
+			g.Expect(remoteClient.Get(context.Background(), types.NamespacedName{Namespace: "kube-system", Name: "aws-node"}, ds)).To(Succeed())
+			g.Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ConsistOf(
+				corev1.EnvVar{Name: "AWS_VPC_K8S_CNI_CUSTOM_NETWORK_CFG", Value: "true"},
+				corev1.EnvVar{Name: "ENI_CONFIG_LABEL_DEF", Value: tc.expectLabel},
+			))
+
+			eniConfig := &amazoncni.ENIConfig{}
+			g.Expect(remoteClient.Get(context.Background(), types.NamespacedName{Namespace: "kube-system", Name: "us-east-1a"}, eniConfig)).To(Succeed())
+			g.Expect(eniConfig.Spec.Subnet).To(Equal("subnet-secondary"))
+		})
+	}
+}
+
+type customNetworkScope struct {
+	mockScope
+}
+
+func (s *customNetworkScope) SecondaryCidrBlock() *string {
+	return aws.String("100.64.0.0/16")
+}
+
+func (s *customNetworkScope) Subnets() infrav1.Subnets {
+	return infrav1.Subnets{
+		{
+			ID:               "subnet-secondary",
+			AvailabilityZone: "us-east-1a",
+			Tags: infrav1.Tags{
+				infrav1.NameAWSSubnetAssociation: infrav1.SecondarySubnetTagValue,
+			},
+		},
+	}
+}
+
+func (s *customNetworkScope) SecurityGroups() map[infrav1.SecurityGroupRole]infrav1.SecurityGroup {
+	return map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+		infrav1.SecurityGroupNode: {ID: "sg-node"},
+	}
+}
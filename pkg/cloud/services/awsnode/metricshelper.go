@@ -0,0 +1,269 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsnode
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/internal/managed"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const (
+	metricsHelperName = "cni-metrics-helper"
+
+	// irsaRoleAnnotation is the service account annotation of the IAM role assumed by the pods
+	// through IAM roles for service accounts.
+	irsaRoleAnnotation = "eks.amazonaws.com/role-arn"
+
+	awsNodeImageRepository       = "amazon-k8s-cni"
+	metricsHelperImageRepository = "cni-metrics-helper"
+)
+
+// reconcileMetricsHelper ensures the cni-metrics-helper Deployment, its service account and RBAC
+// match the VPC CNI configuration. The objects previously created by CAPA are removed when the
+// configuration no longer asks for the metrics helper. Whether the metrics helper was installed is
+// tracked by the CNIMetricsHelperReady condition.
+func (s *Service) reconcileMetricsHelper(ctx context.Context, remoteClient client.Client, ds *appsv1.DaemonSet) error {
+	spec := s.scope.VpcCni().MetricsHelper
+	if spec == nil {
+		if !conditions.Has(s.scope.InfraCluster(), ekscontrolplanev1.CNIMetricsHelperReadyCondition) {
+			return nil
+		}
+		if err := s.deleteMetricsHelper(ctx, remoteClient); err != nil {
+			return err
+		}
+		conditions.Delete(s.scope.InfraCluster(), ekscontrolplanev1.CNIMetricsHelperReadyCondition)
+		return nil
+	}
+
+	if err := s.installMetricsHelper(ctx, remoteClient, spec, ds); err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), ekscontrolplanev1.CNIMetricsHelperReadyCondition, ekscontrolplanev1.CNIMetricsHelperReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return err
+	}
+	conditions.MarkTrue(s.scope.InfraCluster(), ekscontrolplanev1.CNIMetricsHelperReadyCondition)
+
+	return nil
+}
+
+func (s *Service) installMetricsHelper(ctx context.Context, remoteClient client.Client, spec *ekscontrolplanev1.VpcCniMetricsHelper, ds *appsv1.DaemonSet) error {
+	image := spec.Image
+	if image == "" {
+		var err error
+		if image, err = metricsHelperImage(ds); err != nil {
+			return err
+		}
+	}
+
+	for _, desired := range s.metricsHelperObjects(spec.RoleARN, image) {
+		if err := s.applyMetricsHelperObject(ctx, remoteClient, desired); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) applyMetricsHelperObject(ctx context.Context, remoteClient client.Client, desired client.Object) error {
+	kind := kindOf(desired)
+
+	existing := desired.DeepCopyObject().(client.Object)
+	err := remoteClient.Get(ctx, client.ObjectKeyFromObject(desired), existing)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("getting cni-metrics-helper %s: %w", kind, err)
+	}
+
+	if apierrors.IsNotFound(err) {
+		s.scope.Info("Creating cni-metrics-helper "+kind, "cluster-name", s.scope.Name(), "cluster-namespace", s.scope.Namespace())
+		if err := remoteClient.Create(ctx, desired, &client.CreateOptions{}); err != nil {
+			return fmt.Errorf("creating cni-metrics-helper %s: %w", kind, err)
+		}
+		return nil
+	}
+
+	// Objects that weren't created by CAPA, e.g. a metrics helper installed by the user, are left untouched.
+	if !managed.HasLabels(existing, s.metaLabels()) {
+		s.scope.Info("Skipping cni-metrics-helper "+kind+" not managed by CAPA", "cluster-name", s.scope.Name(), "cluster-namespace", s.scope.Namespace())
+		return nil
+	}
+
+	// The fields left unset in the desired object, e.g. the ones defaulted by the API server, are ignored.
+	if equality.Semantic.DeepDerivative(desired, existing) {
+		return nil
+	}
+
+	s.scope.Info("Updating cni-metrics-helper "+kind, "cluster-name", s.scope.Name(), "cluster-namespace", s.scope.Namespace())
+	desired.SetResourceVersion(existing.GetResourceVersion())
+	if err := remoteClient.Update(ctx, desired, &client.UpdateOptions{}); err != nil {
+		return fmt.Errorf("updating cni-metrics-helper %s: %w", kind, err)
+	}
+	return nil
+}
+
+func (s *Service) deleteMetricsHelper(ctx context.Context, remoteClient client.Client) error {
+	namespacedMeta := metav1.ObjectMeta{Namespace: awsNodeNamespace, Name: metricsHelperName}
+	clusterMeta := metav1.ObjectMeta{Name: metricsHelperName}
+
+	for _, obj := range []client.Object{
+		&appsv1.Deployment{ObjectMeta: namespacedMeta},
+		&rbacv1.ClusterRoleBinding{ObjectMeta: clusterMeta},
+		&rbacv1.ClusterRole{ObjectMeta: clusterMeta},
+		&corev1.ServiceAccount{ObjectMeta: namespacedMeta},
+	} {
+		kind := kindOf(obj)
+		if err := remoteClient.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("getting cni-metrics-helper %s: %w", kind, err)
+		}
+		if !managed.HasLabels(obj, s.metaLabels()) {
+			continue
+		}
+
+		s.scope.Info("Deleting cni-metrics-helper "+kind, "cluster-name", s.scope.Name(), "cluster-namespace", s.scope.Namespace())
+		if err := remoteClient.Delete(ctx, obj, &client.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("deleting cni-metrics-helper %s: %w", kind, err)
+		}
+	}
+
+	return nil
+}
+
+func kindOf(obj client.Object) string {
+	return reflect.TypeOf(obj).Elem().Name()
+}
+
+// metricsHelperObjects returns the objects of the cni-metrics-helper, as in the manifests of the
+// amazon-vpc-cni-k8s releases. The pods assume the given IAM role to push the metrics to CloudWatch.
+func (s *Service) metricsHelperObjects(roleARN, image string) []client.Object {
+	podLabels := map[string]string{"k8s-app": metricsHelperName}
+	requests := corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("10m"),
+		corev1.ResourceMemory: resource.MustParse("64Mi"),
+	}
+
+	return []client.Object{
+		&corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   awsNodeNamespace,
+				Name:        metricsHelperName,
+				Labels:      s.metaLabels(),
+				Annotations: map[string]string{irsaRoleAnnotation: roleARN},
+			},
+		},
+		&rbacv1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   metricsHelperName,
+				Labels: s.metaLabels(),
+			},
+			Rules: []rbacv1.PolicyRule{
+				{
+					APIGroups: []string{""},
+					Resources: []string{"pods", "pods/proxy"},
+					Verbs:     []string{"get", "watch", "list"},
+				},
+			},
+		},
+		&rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   metricsHelperName,
+				Labels: s.metaLabels(),
+			},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: rbacv1.GroupName,
+				Kind:     "ClusterRole",
+				Name:     metricsHelperName,
+			},
+			Subjects: []rbacv1.Subject{
+				{
+					Kind:      rbacv1.ServiceAccountKind,
+					Namespace: awsNodeNamespace,
+					Name:      metricsHelperName,
+				},
+			},
+		},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: awsNodeNamespace,
+				Name:      metricsHelperName,
+				Labels:    s.metaLabels(),
+			},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: pointer.Int32(1),
+				Selector: &metav1.LabelSelector{MatchLabels: podLabels},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: podLabels},
+					Spec: corev1.PodSpec{
+						ServiceAccountName: metricsHelperName,
+						Containers: []corev1.Container{
+							{
+								Name:  metricsHelperName,
+								Image: image,
+								Env: []corev1.EnvVar{
+									{Name: "USE_CLOUDWATCH", Value: "true"},
+									// The CloudWatch metrics are dimensioned by the name of the EKS cluster.
+									{Name: "AWS_CLUSTER_ID", Value: s.scope.KubernetesClusterName()},
+								},
+								Resources: corev1.ResourceRequirements{
+									Requests: requests,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// metricsHelperImage returns the cni-metrics-helper image of the VPC CNI version of the aws-node
+// DaemonSet, which is published to the same registry. The metrics helper has no EKS builds.
+func metricsHelperImage(ds *appsv1.DaemonSet) (string, error) {
+	for _, container := range ds.Spec.Template.Spec.Containers {
+		if container.Name != awsNodeName {
+			continue
+		}
+
+		separator := strings.LastIndex(container.Image, "/"+awsNodeImageRepository+":")
+		if separator < 0 {
+			break
+		}
+		registry, tag := container.Image[:separator], container.Image[separator+len(awsNodeImageRepository)+2:]
+		if i := strings.Index(tag, "-eksbuild."); i >= 0 {
+			tag = tag[:i]
+		}
+		return fmt.Sprintf("%s/%s:%s", registry, metricsHelperImageRepository, tag), nil
+	}
+
+	return "", fmt.Errorf("cannot derive the cni-metrics-helper image from the aws-node DaemonSet, the image must be set")
+}
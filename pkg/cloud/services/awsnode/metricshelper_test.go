@@ -0,0 +1,275 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsnode
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestReconcileCniMetricsHelper(t *testing.T) {
+	managedLabels := map[string]string{
+		"app.kubernetes.io/managed-by": "cluster-api-provider-aws",
+		"app.kubernetes.io/part-of":    "mock-name",
+	}
+	roleARN := "arn:aws:iam::123456789012:role/cni-metrics-helper"
+
+	tests := []struct {
+		name         string
+		cniValues    ekscontrolplanev1.VpcCni
+		awsNodeImage string
+		existing     []client.Object
+		installed    bool
+		expectExists bool
+		expectImage  string
+		expectErr    bool
+	}{
+		{
+			name:         "no metrics helper configured",
+			cniValues:    ekscontrolplanev1.VpcCni{},
+			awsNodeImage: "602401143452.dkr.ecr.us-west-2.amazonaws.com/amazon-k8s-cni:v1.11.0-eksbuild.1",
+			expectExists: false,
+		},
+		{
+			name: "creates metrics helper with the image of the aws-node version",
+			cniValues: ekscontrolplanev1.VpcCni{
+				MetricsHelper: &ekscontrolplanev1.VpcCniMetricsHelper{RoleARN: roleARN},
+			},
+			awsNodeImage: "602401143452.dkr.ecr.us-west-2.amazonaws.com/amazon-k8s-cni:v1.11.0-eksbuild.1",
+			expectExists: true,
+			expectImage:  "602401143452.dkr.ecr.us-west-2.amazonaws.com/cni-metrics-helper:v1.11.0",
+		},
+		{
+			name: "creates metrics helper with configured image",
+			cniValues: ekscontrolplanev1.VpcCni{
+				MetricsHelper: &ekscontrolplanev1.VpcCniMetricsHelper{
+					RoleARN: roleARN,
+					Image:   "registry.example.com/cni-metrics-helper:v1.11.2",
+				},
+			},
+			awsNodeImage: "registry.example.com/custom-cni:v1",
+			expectExists: true,
+			expectImage:  "registry.example.com/cni-metrics-helper:v1.11.2",
+		},
+		{
+			name: "fails when the image cannot be derived from aws-node",
+			cniValues: ekscontrolplanev1.VpcCni{
+				MetricsHelper: &ekscontrolplanev1.VpcCniMetricsHelper{RoleARN: roleARN},
+			},
+			awsNodeImage: "registry.example.com/custom-cni:v1",
+			expectErr:    true,
+		},
+		{
+			name: "updates existing metrics helper",
+			cniValues: ekscontrolplanev1.VpcCni{
+				MetricsHelper: &ekscontrolplanev1.VpcCniMetricsHelper{RoleARN: roleARN},
+			},
+			awsNodeImage: "602401143452.dkr.ecr.us-west-2.amazonaws.com/amazon-k8s-cni:v1.11.2",
+			existing: []client.Object{
+				&corev1.ServiceAccount{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "cni-metrics-helper",
+						Namespace:   "kube-system",
+						Labels:      managedLabels,
+						Annotations: map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::123456789012:role/old"},
+					},
+				},
+				&v1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Name: "cni-metrics-helper", Namespace: "kube-system", Labels: managedLabels},
+					Spec: v1.DeploymentSpec{
+						Replicas: pointer.Int32(1),
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{
+									{Name: "cni-metrics-helper", Image: "602401143452.dkr.ecr.us-west-2.amazonaws.com/cni-metrics-helper:v1.11.0"},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectExists: true,
+			expectImage:  "602401143452.dkr.ecr.us-west-2.amazonaws.com/cni-metrics-helper:v1.11.2",
+		},
+		{
+			name:         "deletes managed metrics helper when no longer configured",
+			cniValues:    ekscontrolplanev1.VpcCni{},
+			awsNodeImage: "602401143452.dkr.ecr.us-west-2.amazonaws.com/amazon-k8s-cni:v1.11.0",
+			existing: []client.Object{
+				&corev1.ServiceAccount{
+					ObjectMeta: metav1.ObjectMeta{Name: "cni-metrics-helper", Namespace: "kube-system", Labels: managedLabels},
+				},
+				&rbacv1.ClusterRole{
+					ObjectMeta: metav1.ObjectMeta{Name: "cni-metrics-helper", Labels: managedLabels},
+				},
+				&rbacv1.ClusterRoleBinding{
+					ObjectMeta: metav1.ObjectMeta{Name: "cni-metrics-helper", Labels: managedLabels},
+				},
+				&v1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Name: "cni-metrics-helper", Namespace: "kube-system", Labels: managedLabels},
+				},
+			},
+			installed:    true,
+			expectExists: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			g.Expect(v1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(policyv1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(rbacv1.AddToScheme(scheme)).To(Succeed())
+
+			ds := &v1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "aws-node", Namespace: "kube-system"},
+				Spec: v1.DaemonSetSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Name: "aws-node", Image: tc.awsNodeImage}},
+						},
+					},
+				},
+			}
+			remoteClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(append(tc.existing, ds)...).Build()
+
+			controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{}
+			if tc.installed {
+				conditions.MarkTrue(controlPlane, ekscontrolplanev1.CNIMetricsHelperReadyCondition)
+			}
+			s := NewService(&mockScope{
+				client:       remoteClient,
+				cni:          tc.cniValues,
+				controlPlane: controlPlane,
+			})
+
+			if tc.expectErr {
+				g.Expect(s.ReconcileCNI(context.Background())).NotTo(Succeed())
+				g.Expect(conditions.IsFalse(controlPlane, ekscontrolplanev1.CNIMetricsHelperReadyCondition)).To(BeTrue())
+				return
+			}
+
+			// Reconcile twice to ensure the reconcile is idempotent.
+			for i := 0; i < 2; i++ {
+				g.Expect(s.ReconcileCNI(context.Background())).To(Succeed())
+			}
+
+			key := types.NamespacedName{Namespace: "kube-system", Name: "cni-metrics-helper"}
+			sa := &corev1.ServiceAccount{}
+			saErr := remoteClient.Get(context.Background(), key, sa)
+			role := &rbacv1.ClusterRole{}
+			roleErr := remoteClient.Get(context.Background(), types.NamespacedName{Name: "cni-metrics-helper"}, role)
+			binding := &rbacv1.ClusterRoleBinding{}
+			bindingErr := remoteClient.Get(context.Background(), types.NamespacedName{Name: "cni-metrics-helper"}, binding)
+			deployment := &v1.Deployment{}
+			deploymentErr := remoteClient.Get(context.Background(), key, deployment)
+
+			if !tc.expectExists {
+				for _, err := range []error{saErr, roleErr, bindingErr, deploymentErr} {
+					g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+				}
+				g.Expect(conditions.Has(controlPlane, ekscontrolplanev1.CNIMetricsHelperReadyCondition)).To(BeFalse())
+				return
+			}
+
+			for _, err := range []error{saErr, roleErr, bindingErr, deploymentErr} {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(sa.Annotations).To(HaveKeyWithValue("eks.amazonaws.com/role-arn", roleARN))
+			g.Expect(binding.Subjects).To(ConsistOf(rbacv1.Subject{Kind: "ServiceAccount", Namespace: "kube-system", Name: "cni-metrics-helper"}))
+			g.Expect(deployment.Spec.Template.Spec.ServiceAccountName).To(Equal("cni-metrics-helper"))
+			g.Expect(deployment.Spec.Template.Spec.Containers).To(HaveLen(1))
+			g.Expect(deployment.Spec.Template.Spec.Containers[0].Image).To(Equal(tc.expectImage))
+			g.Expect(deployment.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "USE_CLOUDWATCH", Value: "true"}))
+			g.Expect(deployment.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "AWS_CLUSTER_ID", Value: "mock-eks-name"}))
+			g.Expect(deployment.Spec.Template.Spec.Containers[0].Resources.Requests).NotTo(BeEmpty())
+			g.Expect(conditions.IsTrue(controlPlane, ekscontrolplanev1.CNIMetricsHelperReadyCondition)).To(BeTrue())
+		})
+	}
+}
+
+func TestReconcileCniMetricsHelperUnmanagedObjects(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(v1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(policyv1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(rbacv1.AddToScheme(scheme)).To(Succeed())
+
+	ds := &v1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "aws-node", Namespace: "kube-system"},
+		Spec: v1.DaemonSetSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "aws-node", Image: "602401143452.dkr.ecr.us-west-2.amazonaws.com/amazon-k8s-cni:v1.11.0"}},
+				},
+			},
+		},
+	}
+	// A metrics helper installed by the user.
+	deployment := &v1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "cni-metrics-helper", Namespace: "kube-system"},
+		Spec: v1.DeploymentSpec{
+			Replicas: pointer.Int32(2),
+		},
+	}
+	remoteClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ds, deployment).Build()
+
+	controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{}
+	s := NewService(&mockScope{
+		client: remoteClient,
+		cni: ekscontrolplanev1.VpcCni{
+			MetricsHelper: &ekscontrolplanev1.VpcCniMetricsHelper{RoleARN: "arn:aws:iam::123456789012:role/cni-metrics-helper"},
+		},
+		controlPlane: controlPlane,
+	})
+	g.Expect(s.ReconcileCNI(context.Background())).To(Succeed())
+
+	key := types.NamespacedName{Namespace: "kube-system", Name: "cni-metrics-helper"}
+	current := &v1.Deployment{}
+	g.Expect(remoteClient.Get(context.Background(), key, current)).To(Succeed())
+	g.Expect(current.Spec.Replicas).To(Equal(pointer.Int32(2)))
+	g.Expect(current.Spec.Template.Spec.Containers).To(BeEmpty())
+
+	// The user's metrics helper isn't removed when the metrics helper was never installed by CAPA.
+	s = NewService(&mockScope{
+		client:       remoteClient,
+		cni:          ekscontrolplanev1.VpcCni{},
+		controlPlane: &ekscontrolplanev1.AWSManagedControlPlane{},
+	})
+	g.Expect(s.ReconcileCNI(context.Background())).To(Succeed())
+	g.Expect(remoteClient.Get(context.Background(), key, current)).To(Succeed())
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsnode
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/internal/managed"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// reconcilePodDisruptionBudget ensures the PodDisruptionBudget for the aws-node DaemonSet
+// matches the VPC CNI configuration. A PodDisruptionBudget previously created by CAPA is
+// removed when the configuration no longer asks for one. Whether the PodDisruptionBudget was
+// reconciled is tracked by the AWSNodePodDisruptionBudgetReady condition.
+func (s *Service) reconcilePodDisruptionBudget(ctx context.Context, remoteClient client.Client, ds *appsv1.DaemonSet) error {
+	pdbSpec := s.scope.VpcCni().PodDisruptionBudget
+	if pdbSpec == nil {
+		if !conditions.Has(s.scope.InfraCluster(), ekscontrolplanev1.AWSNodePodDisruptionBudgetReadyCondition) {
+			return nil
+		}
+		if err := s.deletePodDisruptionBudget(ctx, remoteClient); err != nil {
+			return err
+		}
+		conditions.Delete(s.scope.InfraCluster(), ekscontrolplanev1.AWSNodePodDisruptionBudgetReadyCondition)
+		return nil
+	}
+
+	pdb, err := getPodDisruptionBudget(ctx, remoteClient)
+	if meta.IsNoMatchError(err) {
+		// The policy/v1 API is only served from Kubernetes 1.21.
+		s.scope.Info("Skipping aws-node PodDisruptionBudget, the policy/v1 API isn't served", "cluster-name", s.scope.Name(), "cluster-namespace", s.scope.Namespace())
+		conditions.MarkFalse(s.scope.InfraCluster(), ekscontrolplanev1.AWSNodePodDisruptionBudgetReadyCondition, ekscontrolplanev1.AWSNodePodDisruptionBudgetUnsupportedReason, clusterv1.ConditionSeverityWarning, "the policy/v1 API isn't served by the cluster")
+		return nil
+	}
+	if err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), ekscontrolplanev1.AWSNodePodDisruptionBudgetReadyCondition, ekscontrolplanev1.AWSNodePodDisruptionBudgetReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return err
+	}
+
+	// A PodDisruptionBudget that wasn't created by CAPA, e.g. one created by the user, is left untouched.
+	if pdb != nil && !managed.HasLabels(pdb, s.metaLabels()) {
+		s.scope.Info("Skipping aws-node PodDisruptionBudget not managed by CAPA", "cluster-name", s.scope.Name(), "cluster-namespace", s.scope.Namespace())
+		conditions.MarkFalse(s.scope.InfraCluster(), ekscontrolplanev1.AWSNodePodDisruptionBudgetReadyCondition, ekscontrolplanev1.AWSNodePodDisruptionBudgetUnmanagedReason, clusterv1.ConditionSeverityWarning, "an aws-node PodDisruptionBudget not managed by CAPA exists")
+		return nil
+	}
+
+	if err := s.applyPodDisruptionBudget(ctx, remoteClient, pdb, pdbSpec, ds); err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), ekscontrolplanev1.AWSNodePodDisruptionBudgetReadyCondition, ekscontrolplanev1.AWSNodePodDisruptionBudgetReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return err
+	}
+	conditions.MarkTrue(s.scope.InfraCluster(), ekscontrolplanev1.AWSNodePodDisruptionBudgetReadyCondition)
+
+	return nil
+}
+
+// getPodDisruptionBudget returns the aws-node PodDisruptionBudget, or nil if it doesn't exist.
+// The error is returned unwrapped so that a missing policy/v1 API can be detected.
+func getPodDisruptionBudget(ctx context.Context, remoteClient client.Client) (*policyv1.PodDisruptionBudget, error) {
+	pdb := &policyv1.PodDisruptionBudget{}
+	err := remoteClient.Get(ctx, types.NamespacedName{Namespace: awsNodeNamespace, Name: awsNodeName}, pdb)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if meta.IsNoMatchError(err) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting aws-node PodDisruptionBudget: %w", err)
+	}
+	return pdb, nil
+}
+
+func (s *Service) applyPodDisruptionBudget(ctx context.Context, remoteClient client.Client, pdb *policyv1.PodDisruptionBudget, pdbSpec *ekscontrolplanev1.VpcCniPodDisruptionBudget, ds *appsv1.DaemonSet) error {
+	minAvailable := intstr.FromInt(1)
+	if pdbSpec.MinAvailable != nil {
+		minAvailable = *pdbSpec.MinAvailable
+	}
+
+	if pdb == nil {
+		s.scope.Info("Creating aws-node PodDisruptionBudget", "cluster-name", s.scope.Name(), "cluster-namespace", s.scope.Namespace(), "min-available", minAvailable.String())
+		pdb = &policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: awsNodeNamespace,
+				Name:      awsNodeName,
+				Labels:    s.metaLabels(),
+			},
+			Spec: policyv1.PodDisruptionBudgetSpec{
+				MinAvailable: &minAvailable,
+				Selector:     awsNodeSelector(ds),
+			},
+		}
+		if err := remoteClient.Create(ctx, pdb, &client.CreateOptions{}); err != nil {
+			return fmt.Errorf("creating aws-node PodDisruptionBudget: %w", err)
+		}
+		return nil
+	}
+
+	if pdb.Spec.MaxUnavailable == nil && pdb.Spec.MinAvailable != nil && *pdb.Spec.MinAvailable == minAvailable {
+		return nil
+	}
+
+	s.scope.Info("Updating aws-node PodDisruptionBudget", "cluster-name", s.scope.Name(), "cluster-namespace", s.scope.Namespace(), "min-available", minAvailable.String())
+	pdb.Spec.MinAvailable = &minAvailable
+	pdb.Spec.MaxUnavailable = nil
+	if err := remoteClient.Update(ctx, pdb, &client.UpdateOptions{}); err != nil {
+		return fmt.Errorf("updating aws-node PodDisruptionBudget: %w", err)
+	}
+
+	return nil
+}
+
+// deletePodDisruptionBudget deletes the aws-node PodDisruptionBudget if it was created by CAPA.
+func (s *Service) deletePodDisruptionBudget(ctx context.Context, remoteClient client.Client) error {
+	pdb, err := getPodDisruptionBudget(ctx, remoteClient)
+	if meta.IsNoMatchError(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if pdb == nil || !managed.HasLabels(pdb, s.metaLabels()) {
+		return nil
+	}
+
+	s.scope.Info("Deleting aws-node PodDisruptionBudget", "cluster-name", s.scope.Name(), "cluster-namespace", s.scope.Namespace())
+	if err := remoteClient.Delete(ctx, pdb, &client.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("deleting aws-node PodDisruptionBudget: %w", err)
+	}
+	return nil
+}
+
+// awsNodeSelector returns the label selector of the aws-node pods, falling back to the
+// label used by the upstream manifests when the DaemonSet doesn't specify one.
+func awsNodeSelector(ds *appsv1.DaemonSet) *metav1.LabelSelector {
+	if ds.Spec.Selector != nil {
+		return ds.Spec.Selector.DeepCopy()
+	}
+	return &metav1.LabelSelector{
+		MatchLabels: map[string]string{"k8s-app": awsNodeName},
+	}
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsnode
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestReconcileCniPodDisruptionBudget(t *testing.T) {
+	minAvailable := intstr.FromString("50%")
+	managedLabels := map[string]string{
+		"app.kubernetes.io/managed-by": "cluster-api-provider-aws",
+		"app.kubernetes.io/part-of":    "mock-name",
+	}
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"k8s-app": "aws-node"}}
+
+	tests := []struct {
+		name            string
+		cniValues       ekscontrolplanev1.VpcCni
+		existing        *policyv1.PodDisruptionBudget
+		installed       bool
+		noMatch         bool
+		expectExists    bool
+		expectMin       intstr.IntOrString
+		expectCondition *clusterv1.Condition
+	}{
+		{
+			name:         "no PodDisruptionBudget configured",
+			cniValues:    ekscontrolplanev1.VpcCni{},
+			expectExists: false,
+		},
+		{
+			name:      "doesn't look up the PodDisruptionBudget when never configured",
+			cniValues: ekscontrolplanev1.VpcCni{},
+			// The policy/v1 API isn't served before Kubernetes 1.21.
+			noMatch:      true,
+			expectExists: false,
+		},
+		{
+			name: "tolerates a cluster not serving the policy/v1 API",
+			cniValues: ekscontrolplanev1.VpcCni{
+				PodDisruptionBudget: &ekscontrolplanev1.VpcCniPodDisruptionBudget{},
+			},
+			noMatch:         true,
+			expectExists:    false,
+			expectCondition: conditions.FalseCondition(ekscontrolplanev1.AWSNodePodDisruptionBudgetReadyCondition, ekscontrolplanev1.AWSNodePodDisruptionBudgetUnsupportedReason, clusterv1.ConditionSeverityWarning, ""),
+		},
+		{
+			name: "creates PodDisruptionBudget with default minAvailable",
+			cniValues: ekscontrolplanev1.VpcCni{
+				PodDisruptionBudget: &ekscontrolplanev1.VpcCniPodDisruptionBudget{},
+			},
+			expectExists:    true,
+			expectMin:       intstr.FromInt(1),
+			expectCondition: conditions.TrueCondition(ekscontrolplanev1.AWSNodePodDisruptionBudgetReadyCondition),
+		},
+		{
+			name: "creates PodDisruptionBudget with configured minAvailable",
+			cniValues: ekscontrolplanev1.VpcCni{
+				PodDisruptionBudget: &ekscontrolplanev1.VpcCniPodDisruptionBudget{MinAvailable: &minAvailable},
+			},
+			expectExists:    true,
+			expectMin:       minAvailable,
+			expectCondition: conditions.TrueCondition(ekscontrolplanev1.AWSNodePodDisruptionBudgetReadyCondition),
+		},
+		{
+			name: "updates existing PodDisruptionBudget",
+			cniValues: ekscontrolplanev1.VpcCni{
+				PodDisruptionBudget: &ekscontrolplanev1.VpcCniPodDisruptionBudget{MinAvailable: &minAvailable},
+			},
+			existing: &policyv1.PodDisruptionBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "aws-node", Namespace: "kube-system", Labels: managedLabels},
+				Spec:       policyv1.PodDisruptionBudgetSpec{MinAvailable: intstrPtr(intstr.FromInt(1)), Selector: selector},
+			},
+			expectExists:    true,
+			expectMin:       minAvailable,
+			expectCondition: conditions.TrueCondition(ekscontrolplanev1.AWSNodePodDisruptionBudgetReadyCondition),
+		},
+		{
+			name:      "deletes managed PodDisruptionBudget when no longer configured",
+			cniValues: ekscontrolplanev1.VpcCni{},
+			existing: &policyv1.PodDisruptionBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "aws-node", Namespace: "kube-system", Labels: managedLabels},
+				Spec:       policyv1.PodDisruptionBudgetSpec{MinAvailable: intstrPtr(intstr.FromInt(1)), Selector: selector},
+			},
+			installed:    true,
+			expectExists: false,
+		},
+		{
+			name: "leaves unmanaged PodDisruptionBudget alone when configured",
+			cniValues: ekscontrolplanev1.VpcCni{
+				PodDisruptionBudget: &ekscontrolplanev1.VpcCniPodDisruptionBudget{MinAvailable: &minAvailable},
+			},
+			existing: &policyv1.PodDisruptionBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "aws-node", Namespace: "kube-system"},
+				Spec:       policyv1.PodDisruptionBudgetSpec{MinAvailable: intstrPtr(intstr.FromInt(2)), Selector: selector},
+			},
+			expectExists:    true,
+			expectMin:       intstr.FromInt(2),
+			expectCondition: conditions.FalseCondition(ekscontrolplanev1.AWSNodePodDisruptionBudgetReadyCondition, ekscontrolplanev1.AWSNodePodDisruptionBudgetUnmanagedReason, clusterv1.ConditionSeverityWarning, ""),
+		},
+		{
+			name:      "leaves unmanaged PodDisruptionBudget alone",
+			cniValues: ekscontrolplanev1.VpcCni{},
+			existing: &policyv1.PodDisruptionBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "aws-node", Namespace: "kube-system"},
+				Spec:       policyv1.PodDisruptionBudgetSpec{MinAvailable: intstrPtr(intstr.FromInt(2)), Selector: selector},
+			},
+			installed:    true,
+			expectExists: true,
+			expectMin:    intstr.FromInt(2),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			g.Expect(v1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(policyv1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(rbacv1.AddToScheme(scheme)).To(Succeed())
+
+			ds := &v1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "aws-node", Namespace: "kube-system"},
+				Spec: v1.DaemonSetSpec{
+					Selector: selector,
+				},
+			}
+			objs := []client.Object{ds}
+			if tc.existing != nil {
+				objs = append(objs, tc.existing)
+			}
+			remoteClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+			var serviceClient client.Client = remoteClient
+			if tc.noMatch {
+				serviceClient = &noPolicyV1Client{Client: remoteClient}
+			}
+
+			controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{}
+			if tc.installed {
+				conditions.MarkTrue(controlPlane, ekscontrolplanev1.AWSNodePodDisruptionBudgetReadyCondition)
+			}
+			s := NewService(&mockScope{
+				client:       serviceClient,
+				cni:          tc.cniValues,
+				controlPlane: controlPlane,
+			})
+
+			// Reconcile twice to ensure the reconcile is idempotent.
+			for i := 0; i < 2; i++ {
+				g.Expect(s.ReconcileCNI(context.Background())).To(Succeed())
+			}
+
+			if tc.expectCondition != nil {
+				condition := conditions.Get(controlPlane, tc.expectCondition.Type)
+				g.Expect(condition).NotTo(BeNil())
+				g.Expect(condition.Status).To(Equal(tc.expectCondition.Status))
+				g.Expect(condition.Reason).To(Equal(tc.expectCondition.Reason))
+			} else {
+				g.Expect(conditions.Has(controlPlane, ekscontrolplanev1.AWSNodePodDisruptionBudgetReadyCondition)).To(BeFalse())
+			}
+
+			pdb := &policyv1.PodDisruptionBudget{}
+			err := remoteClient.Get(context.Background(), types.NamespacedName{Namespace: "kube-system", Name: "aws-node"}, pdb)
+			if !tc.expectExists {
+				g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(pdb.Spec.MinAvailable).NotTo(BeNil())
+			g.Expect(*pdb.Spec.MinAvailable).To(Equal(tc.expectMin))
+			g.Expect(pdb.Spec.Selector).To(Equal(ds.Spec.Selector))
+		})
+	}
+}
+
+// noPolicyV1Client simulates a cluster not serving the policy/v1 API.
+type noPolicyV1Client struct {
+	client.Client
+}
+
+func (c *noPolicyV1Client) Get(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+	if _, ok := obj.(*policyv1.PodDisruptionBudget); ok {
+		return &meta.NoKindMatchError{GroupKind: policyv1.SchemeGroupVersion.WithKind("PodDisruptionBudget").GroupKind(), SearchedVersions: []string{"v1"}}
+	}
+	return c.Client.Get(ctx, key, obj)
+}
+
+func intstrPtr(v intstr.IntOrString) *intstr.IntOrString {
+	return &v
+}
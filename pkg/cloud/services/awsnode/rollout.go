@@ -0,0 +1,146 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsnode
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const (
+	// rolloutAnnotation marks an aws-node DaemonSet switched to the OnDelete update strategy by CAPA,
+	// so the RollingUpdate strategy can be restored when the rollout is no longer configured.
+	rolloutAnnotation = "aws.cluster.x-k8s.io/aws-node-rollout"
+
+	// templateGenerationAnnotation and podTemplateGenerationLabel are set by Kubernetes on a DaemonSet
+	// and its pods, the pods running an outdated template have a lower generation.
+	templateGenerationAnnotation = "deprecated.daemonset.template.generation"
+	podTemplateGenerationLabel   = "pod-template-generation"
+)
+
+// applyUpdateStrategy switches the DaemonSet to the OnDelete update strategy when a gradual rollout
+// is configured, and back to RollingUpdate when it no longer is. It returns whether the DaemonSet changed.
+func (s *Service) applyUpdateStrategy(ds *appsv1.DaemonSet) bool {
+	_, managed := ds.Annotations[rolloutAnnotation]
+
+	if s.scope.VpcCni().Rollout == nil {
+		if !managed {
+			return false
+		}
+		delete(ds.Annotations, rolloutAnnotation)
+		ds.Spec.UpdateStrategy = appsv1.DaemonSetUpdateStrategy{Type: appsv1.RollingUpdateDaemonSetStrategyType}
+		return true
+	}
+
+	if managed && ds.Spec.UpdateStrategy.Type == appsv1.OnDeleteDaemonSetStrategyType {
+		return false
+	}
+	if ds.Annotations == nil {
+		ds.Annotations = map[string]string{}
+	}
+	ds.Annotations[rolloutAnnotation] = "true"
+	ds.Spec.UpdateStrategy = appsv1.DaemonSetUpdateStrategy{Type: appsv1.OnDeleteDaemonSetStrategyType}
+	return true
+}
+
+// reconcileRollout restarts the next batch of aws-node pods running an outdated template, once the
+// pods restarted before are ready, and reports the progress in the AWSNodeRolloutComplete condition.
+func (s *Service) reconcileRollout(ctx context.Context, remoteClient client.Client, ds *appsv1.DaemonSet) error {
+	rollout := s.scope.VpcCni().Rollout
+	if rollout == nil {
+		conditions.Delete(s.scope.InfraCluster(), ekscontrolplanev1.AWSNodeRolloutCompleteCondition)
+		return nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(awsNodeSelector(ds))
+	if err != nil {
+		return fmt.Errorf("parsing aws-node selector: %w", err)
+	}
+	pods := &corev1.PodList{}
+	if err := remoteClient.List(ctx, pods, client.InNamespace(awsNodeNamespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return fmt.Errorf("listing aws-node pods: %w", err)
+	}
+
+	generation := ds.Annotations[templateGenerationAnnotation]
+	outdated, settling := []corev1.Pod{}, 0
+	for i := range pods.Items {
+		pod := pods.Items[i]
+		switch {
+		case pod.DeletionTimestamp != nil:
+			settling++
+		case pod.Labels[podTemplateGenerationLabel] != generation:
+			outdated = append(outdated, pod)
+		case !isPodReady(&pod):
+			settling++
+		}
+	}
+	// Replacements of deleted pods don't exist yet until the DaemonSet controller creates them.
+	if missing := int(ds.Status.DesiredNumberScheduled) - len(pods.Items); missing > 0 {
+		settling += missing
+	}
+
+	if len(outdated) == 0 && settling == 0 {
+		conditions.MarkTrue(s.scope.InfraCluster(), ekscontrolplanev1.AWSNodeRolloutCompleteCondition)
+		return nil
+	}
+
+	total := len(pods.Items)
+	if int(ds.Status.DesiredNumberScheduled) > total {
+		total = int(ds.Status.DesiredNumberScheduled)
+	}
+	if settling == 0 {
+		sort.Slice(outdated, func(i, j int) bool { return outdated[i].Name < outdated[j].Name })
+		batch := int(rollout.BatchSize)
+		if batch < 1 {
+			batch = 1
+		}
+		if batch > len(outdated) {
+			batch = len(outdated)
+		}
+		for i := range outdated[:batch] {
+			pod := &outdated[i]
+			s.scope.Info("Restarting outdated aws-node pod", "cluster-name", s.scope.Name(), "cluster-namespace", s.scope.Namespace(), "pod", pod.Name, "node", pod.Spec.NodeName)
+			if err := remoteClient.Delete(ctx, pod, &client.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("deleting aws-node pod %s: %w", pod.Name, err)
+			}
+		}
+	}
+
+	conditions.MarkFalse(s.scope.InfraCluster(), ekscontrolplanev1.AWSNodeRolloutCompleteCondition, ekscontrolplanev1.AWSNodeRolloutInProgressReason, clusterv1.ConditionSeverityInfo,
+		"%d of %d aws-node pods updated", total-len(outdated), total)
+	return nil
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsnode
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestApplyUpdateStrategy(t *testing.T) {
+	tests := []struct {
+		name         string
+		rollout      *ekscontrolplanev1.VpcCniRollout
+		daemonSet    *v1.DaemonSet
+		expectUpdate bool
+		expectType   v1.DaemonSetUpdateStrategyType
+	}{
+		{
+			name:         "switches to OnDelete when a rollout is configured",
+			rollout:      &ekscontrolplanev1.VpcCniRollout{BatchSize: 1},
+			daemonSet:    &v1.DaemonSet{Spec: v1.DaemonSetSpec{UpdateStrategy: v1.DaemonSetUpdateStrategy{Type: v1.RollingUpdateDaemonSetStrategyType}}},
+			expectUpdate: true,
+			expectType:   v1.OnDeleteDaemonSetStrategyType,
+		},
+		{
+			name:    "keeps OnDelete set by CAPA",
+			rollout: &ekscontrolplanev1.VpcCniRollout{BatchSize: 1},
+			daemonSet: &v1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{rolloutAnnotation: "true"}},
+				Spec:       v1.DaemonSetSpec{UpdateStrategy: v1.DaemonSetUpdateStrategy{Type: v1.OnDeleteDaemonSetStrategyType}},
+			},
+			expectUpdate: false,
+			expectType:   v1.OnDeleteDaemonSetStrategyType,
+		},
+		{
+			name: "restores RollingUpdate when the rollout is no longer configured",
+			daemonSet: &v1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{rolloutAnnotation: "true"}},
+				Spec:       v1.DaemonSetSpec{UpdateStrategy: v1.DaemonSetUpdateStrategy{Type: v1.OnDeleteDaemonSetStrategyType}},
+			},
+			expectUpdate: true,
+			expectType:   v1.RollingUpdateDaemonSetStrategyType,
+		},
+		{
+			name:         "leaves a strategy not set by CAPA untouched",
+			daemonSet:    &v1.DaemonSet{Spec: v1.DaemonSetSpec{UpdateStrategy: v1.DaemonSetUpdateStrategy{Type: v1.OnDeleteDaemonSetStrategyType}}},
+			expectUpdate: false,
+			expectType:   v1.OnDeleteDaemonSetStrategyType,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			s := NewService(&mockScope{cni: ekscontrolplanev1.VpcCni{Rollout: tc.rollout}})
+			g.Expect(s.applyUpdateStrategy(tc.daemonSet)).To(Equal(tc.expectUpdate))
+			g.Expect(tc.daemonSet.Spec.UpdateStrategy.Type).To(Equal(tc.expectType))
+		})
+	}
+}
+
+func TestReconcileRollout(t *testing.T) {
+	selector := map[string]string{"k8s-app": "aws-node"}
+	daemonSet := &v1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "aws-node",
+			Namespace:   "kube-system",
+			Annotations: map[string]string{templateGenerationAnnotation: "2"},
+		},
+		Spec: v1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: selector},
+		},
+		Status: v1.DaemonSetStatus{DesiredNumberScheduled: 5},
+	}
+	pod := func(name, generation string, ready bool) *corev1.Pod {
+		status := corev1.ConditionFalse
+		if ready {
+			status = corev1.ConditionTrue
+		}
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "kube-system",
+				Labels:    map[string]string{"k8s-app": "aws-node", podTemplateGenerationLabel: generation},
+			},
+			Status: corev1.PodStatus{
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		pods            []client.Object
+		expectRemaining []string
+		expectComplete  bool
+		expectMessage   string
+	}{
+		{
+			name: "restarts the first batch of outdated pods",
+			pods: []client.Object{
+				pod("aws-node-a", "1", true),
+				pod("aws-node-b", "1", true),
+				pod("aws-node-c", "1", true),
+				pod("aws-node-d", "1", true),
+				pod("aws-node-e", "1", true),
+			},
+			expectRemaining: []string{"aws-node-c", "aws-node-d", "aws-node-e"},
+			expectMessage:   "0 of 5 aws-node pods updated",
+		},
+		{
+			name: "waits for the replacements of the previous batch to be created",
+			pods: []client.Object{
+				pod("aws-node-c", "1", true),
+				pod("aws-node-d", "1", true),
+				pod("aws-node-e", "1", true),
+			},
+			expectRemaining: []string{"aws-node-c", "aws-node-d", "aws-node-e"},
+			expectMessage:   "2 of 5 aws-node pods updated",
+		},
+		{
+			name: "waits for the previous batch to be ready",
+			pods: []client.Object{
+				pod("aws-node-c", "1", true),
+				pod("aws-node-d", "1", true),
+				pod("aws-node-e", "1", true),
+				pod("aws-node-f", "2", true),
+				pod("aws-node-g", "2", false),
+			},
+			expectRemaining: []string{"aws-node-c", "aws-node-d", "aws-node-e", "aws-node-f", "aws-node-g"},
+			expectMessage:   "2 of 5 aws-node pods updated",
+		},
+		{
+			name: "restarts the next batch once the previous one is ready",
+			pods: []client.Object{
+				pod("aws-node-c", "1", true),
+				pod("aws-node-d", "1", true),
+				pod("aws-node-e", "1", true),
+				pod("aws-node-f", "2", true),
+				pod("aws-node-g", "2", true),
+			},
+			expectRemaining: []string{"aws-node-e", "aws-node-f", "aws-node-g"},
+			expectMessage:   "2 of 5 aws-node pods updated",
+		},
+		{
+			name: "completes when all pods run the current template",
+			pods: []client.Object{
+				pod("aws-node-f", "2", true),
+				pod("aws-node-g", "2", true),
+				pod("aws-node-h", "2", true),
+				pod("aws-node-i", "2", true),
+				pod("aws-node-j", "2", true),
+			},
+			expectRemaining: []string{"aws-node-f", "aws-node-g", "aws-node-h", "aws-node-i", "aws-node-j"},
+			expectComplete:  true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+			remoteClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tc.pods...).Build()
+
+			m := &mockScope{
+				client: remoteClient,
+				cni:    ekscontrolplanev1.VpcCni{Rollout: &ekscontrolplanev1.VpcCniRollout{BatchSize: 2}},
+			}
+			s := NewService(m)
+
+			g.Expect(s.reconcileRollout(context.Background(), remoteClient, daemonSet)).To(Succeed())
+
+			pods := &corev1.PodList{}
+			g.Expect(remoteClient.List(context.Background(), pods)).To(Succeed())
+			names := []string{}
+			for _, p := range pods.Items {
+				names = append(names, p.Name)
+			}
+			g.Expect(names).To(ConsistOf(tc.expectRemaining))
+
+			controlPlane := m.InfraCluster()
+			if tc.expectComplete {
+				g.Expect(conditions.IsTrue(controlPlane, ekscontrolplanev1.AWSNodeRolloutCompleteCondition)).To(BeTrue())
+				return
+			}
+			g.Expect(conditions.IsFalse(controlPlane, ekscontrolplanev1.AWSNodeRolloutCompleteCondition)).To(BeTrue())
+			g.Expect(conditions.GetReason(controlPlane, ekscontrolplanev1.AWSNodeRolloutCompleteCondition)).To(Equal(ekscontrolplanev1.AWSNodeRolloutInProgressReason))
+			g.Expect(conditions.GetMessage(controlPlane, ekscontrolplanev1.AWSNodeRolloutCompleteCondition)).To(Equal(tc.expectMessage))
+		})
+	}
+}
+
+func TestReconcileRolloutNotConfigured(t *testing.T) {
+	g := NewWithT(t)
+
+	m := &mockScope{}
+	conditions.MarkTrue(m.InfraCluster(), ekscontrolplanev1.AWSNodeRolloutCompleteCondition)
+	s := NewService(m)
+
+	g.Expect(s.reconcileRollout(context.Background(), nil, &v1.DaemonSet{})).To(Succeed())
+	g.Expect(conditions.Has(m.InfraCluster(), ekscontrolplanev1.AWSNodeRolloutCompleteCondition)).To(BeFalse())
+}
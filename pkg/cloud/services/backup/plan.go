@@ -0,0 +1,451 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/hash"
+)
+
+const (
+	// maxNameLength is the maximum length of backup plan and selection names.
+	maxNameLength = 50
+
+	// ruleName is the name of the single rule of the backup plan.
+	ruleName = "ebs-snapshots"
+)
+
+// ReconcileBackupPlan ensures the AWS Backup plan and its resource selection exist for the
+// cluster and match the spec, and records them in the cluster status.
+func (s *Service) ReconcileBackupPlan() error {
+	spec := s.scope.Backup()
+	if spec == nil {
+		return nil
+	}
+
+	s.scope.V(2).Info("Reconciling backup plan", "name", s.planName())
+
+	plan, err := s.findBackupPlan()
+	if err != nil {
+		return err
+	}
+
+	status := &infrav1.BackupStatus{}
+	if plan == nil {
+		out, err := s.createBackupPlan(spec)
+		if err != nil {
+			return err
+		}
+		status.PlanID = aws.StringValue(out.BackupPlanId)
+		status.PlanARN = aws.StringValue(out.BackupPlanArn)
+	} else {
+		status.PlanID = aws.StringValue(plan.BackupPlanId)
+		status.PlanARN = aws.StringValue(plan.BackupPlanArn)
+		if backupPlanNeedsUpdate(plan.BackupPlan, spec) {
+			if err := s.updateBackupPlan(status.PlanID, spec); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Record the plan before reconciling the selection so that it's cleaned up on delete
+	// even if the selection can't be created.
+	s.scope.SetBackupStatus(status)
+
+	selectionID, err := s.reconcileBackupSelection(status.PlanID, spec)
+	if err != nil {
+		return err
+	}
+	status.SelectionID = selectionID
+	s.scope.SetBackupStatus(status)
+
+	return nil
+}
+
+// DeleteBackupPlan deletes the AWS Backup plan owned by the cluster along with its resource
+// selections. Existing recovery points are kept until their retention expires.
+func (s *Service) DeleteBackupPlan() error {
+	if s.scope.Backup() == nil && (s.scope.BackupStatus() == nil || s.scope.BackupStatus().PlanID == "") {
+		return nil
+	}
+
+	plan, err := s.findBackupPlan()
+	if err != nil {
+		return err
+	}
+
+	if plan == nil {
+		s.scope.SetBackupStatus(nil)
+		return nil
+	}
+
+	planID := aws.StringValue(plan.BackupPlanId)
+	s.scope.Info("Deleting backup plan", "id", planID)
+
+	selections, err := s.listBackupSelections(planID)
+	if err != nil {
+		return err
+	}
+	for _, selection := range selections {
+		if _, err := s.BackupClient.DeleteBackupSelection(&backup.DeleteBackupSelectionInput{
+			BackupPlanId: aws.String(planID),
+			SelectionId:  selection.SelectionId,
+		}); err != nil && !isNotFound(err) {
+			return errors.Wrapf(err, "failed to delete selection %q of backup plan %q", aws.StringValue(selection.SelectionId), planID)
+		}
+	}
+
+	if _, err := s.BackupClient.DeleteBackupPlan(&backup.DeleteBackupPlanInput{
+		BackupPlanId: aws.String(planID),
+	}); err != nil && !isNotFound(err) {
+		return errors.Wrapf(err, "failed to delete backup plan %q", planID)
+	}
+
+	s.scope.SetBackupStatus(nil)
+
+	return nil
+}
+
+// findBackupPlan returns the backup plan owned by the cluster, first by the ID recorded in
+// status and then by looking up backup plans with the expected name.
+func (s *Service) findBackupPlan() (*backup.GetBackupPlanOutput, error) {
+	if status := s.scope.BackupStatus(); status != nil && status.PlanID != "" {
+		plan, err := s.getBackupPlan(status.PlanID)
+		if err != nil {
+			return nil, err
+		}
+		if plan != nil {
+			return plan, nil
+		}
+	}
+
+	name := s.planName()
+	input := &backup.ListBackupPlansInput{}
+	for {
+		out, err := s.BackupClient.ListBackupPlans(input)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list backup plans")
+		}
+
+		for _, summary := range out.BackupPlansList {
+			if aws.StringValue(summary.BackupPlanName) != name {
+				continue
+			}
+
+			owned, err := s.isOwned(summary.BackupPlanArn)
+			if err != nil {
+				return nil, err
+			}
+			if owned {
+				return s.getBackupPlan(aws.StringValue(summary.BackupPlanId))
+			}
+		}
+
+		if aws.StringValue(out.NextToken) == "" {
+			return nil, nil
+		}
+		input.NextToken = out.NextToken
+	}
+}
+
+func (s *Service) getBackupPlan(id string) (*backup.GetBackupPlanOutput, error) {
+	out, err := s.BackupClient.GetBackupPlan(&backup.GetBackupPlanInput{BackupPlanId: aws.String(id)})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to describe backup plan %q", id)
+	}
+
+	// Deleted plans stay visible for a while, treat them as missing.
+	if out.DeletionDate != nil {
+		return nil, nil
+	}
+
+	return out, nil
+}
+
+func (s *Service) isOwned(planARN *string) (bool, error) {
+	out, err := s.BackupClient.ListTags(&backup.ListTagsInput{
+		ResourceArn: planARN,
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to list tags for backup plan %q", aws.StringValue(planARN))
+	}
+
+	return infrav1.Tags(aws.StringValueMap(out.Tags)).HasOwned(s.scope.Name()), nil
+}
+
+func (s *Service) createBackupPlan(spec *infrav1.Backup) (*backup.CreateBackupPlanOutput, error) {
+	if err := s.checkBackupVault(spec.VaultName); err != nil {
+		return nil, err
+	}
+
+	tags := infrav1.Build(infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(s.planName()),
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	})
+
+	out, err := s.BackupClient.CreateBackupPlan(&backup.CreateBackupPlanInput{
+		BackupPlan:       s.planInput(spec),
+		BackupPlanTags:   aws.StringMap(tags),
+		CreatorRequestId: aws.String(s.planName()),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create backup plan %q", s.planName())
+	}
+
+	s.scope.Info("Created backup plan", "name", s.planName(), "id", aws.StringValue(out.BackupPlanId))
+
+	return out, nil
+}
+
+func (s *Service) updateBackupPlan(id string, spec *infrav1.Backup) error {
+	if err := s.checkBackupVault(spec.VaultName); err != nil {
+		return err
+	}
+
+	if _, err := s.BackupClient.UpdateBackupPlan(&backup.UpdateBackupPlanInput{
+		BackupPlanId: aws.String(id),
+		BackupPlan:   s.planInput(spec),
+	}); err != nil {
+		return errors.Wrapf(err, "failed to update backup plan %q", id)
+	}
+
+	s.scope.Info("Updated backup plan", "id", id)
+
+	return nil
+}
+
+// checkBackupVault returns an error if the target vault doesn't exist. The vault isn't
+// managed by the controller and must be created beforehand.
+func (s *Service) checkBackupVault(name string) error {
+	if _, err := s.BackupClient.DescribeBackupVault(&backup.DescribeBackupVaultInput{
+		BackupVaultName: aws.String(name),
+	}); err != nil {
+		if isNotFound(err) {
+			return errors.Errorf("backup vault %q does not exist", name)
+		}
+		return errors.Wrapf(err, "failed to describe backup vault %q", name)
+	}
+	return nil
+}
+
+func (s *Service) planInput(spec *infrav1.Backup) *backup.PlanInput {
+	return &backup.PlanInput{
+		BackupPlanName: aws.String(s.planName()),
+		Rules: []*backup.RuleInput{
+			{
+				RuleName:              aws.String(ruleName),
+				ScheduleExpression:    aws.String(spec.Schedule),
+				TargetBackupVaultName: aws.String(spec.VaultName),
+				Lifecycle: &backup.Lifecycle{
+					DeleteAfterDays: aws.Int64(spec.RetentionDays),
+				},
+			},
+		},
+	}
+}
+
+func backupPlanNeedsUpdate(plan *backup.Plan, spec *infrav1.Backup) bool {
+	if plan == nil || len(plan.Rules) != 1 {
+		return true
+	}
+
+	rule := plan.Rules[0]
+	var retentionDays int64
+	if rule.Lifecycle != nil {
+		retentionDays = aws.Int64Value(rule.Lifecycle.DeleteAfterDays)
+	}
+
+	return aws.StringValue(rule.RuleName) != ruleName ||
+		aws.StringValue(rule.ScheduleExpression) != spec.Schedule ||
+		aws.StringValue(rule.TargetBackupVaultName) != spec.VaultName ||
+		retentionDays != spec.RetentionDays
+}
+
+// reconcileBackupSelection ensures the plan has a selection of the volumes matching the spec and
+// returns its ID. Selections can't be updated, a stale selection is replaced instead.
+func (s *Service) reconcileBackupSelection(planID string, spec *infrav1.Backup) (string, error) {
+	desired, err := s.selection(spec)
+	if err != nil {
+		return "", err
+	}
+
+	selections, err := s.listBackupSelections(planID)
+	if err != nil {
+		return "", err
+	}
+
+	for _, summary := range selections {
+		if aws.StringValue(summary.SelectionName) != aws.StringValue(desired.SelectionName) {
+			continue
+		}
+
+		out, err := s.BackupClient.GetBackupSelection(&backup.GetBackupSelectionInput{
+			BackupPlanId: aws.String(planID),
+			SelectionId:  summary.SelectionId,
+		})
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to describe selection %q of backup plan %q", aws.StringValue(summary.SelectionId), planID)
+		}
+
+		if !backupSelectionNeedsUpdate(out.BackupSelection, desired) {
+			return aws.StringValue(summary.SelectionId), nil
+		}
+
+		s.scope.Info("Replacing outdated backup selection", "id", aws.StringValue(summary.SelectionId))
+		if _, err := s.BackupClient.DeleteBackupSelection(&backup.DeleteBackupSelectionInput{
+			BackupPlanId: aws.String(planID),
+			SelectionId:  summary.SelectionId,
+		}); err != nil && !isNotFound(err) {
+			return "", errors.Wrapf(err, "failed to delete selection %q of backup plan %q", aws.StringValue(summary.SelectionId), planID)
+		}
+	}
+
+	out, err := s.BackupClient.CreateBackupSelection(&backup.CreateBackupSelectionInput{
+		BackupPlanId:    aws.String(planID),
+		BackupSelection: desired,
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to create selection for backup plan %q", planID)
+	}
+
+	s.scope.Info("Created backup selection", "id", aws.StringValue(out.SelectionId))
+
+	return aws.StringValue(out.SelectionId), nil
+}
+
+func (s *Service) listBackupSelections(planID string) ([]*backup.SelectionsListMember, error) {
+	var selections []*backup.SelectionsListMember
+
+	input := &backup.ListBackupSelectionsInput{BackupPlanId: aws.String(planID)}
+	for {
+		out, err := s.BackupClient.ListBackupSelections(input)
+		if err != nil {
+			if isNotFound(err) {
+				return selections, nil
+			}
+			return nil, errors.Wrapf(err, "failed to list selections of backup plan %q", planID)
+		}
+
+		selections = append(selections, out.BackupSelectionsList...)
+
+		if aws.StringValue(out.NextToken) == "" {
+			return selections, nil
+		}
+		input.NextToken = out.NextToken
+	}
+}
+
+// selection returns the selection of the EBS volumes carrying the selection tags, which
+// default to the tag marking resources as owned by the cluster.
+func (s *Service) selection(spec *infrav1.Backup) (*backup.Selection, error) {
+	roleARN, err := arn.Parse(spec.IAMRoleARN)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse backup IAM role ARN %q", spec.IAMRoleARN)
+	}
+
+	tags := spec.SelectionTags
+	if len(tags) == 0 {
+		tags = infrav1.Tags{infrav1.ClusterTagKey(s.scope.Name()): string(infrav1.ResourceLifecycleOwned)}
+	}
+
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	conditions := make([]*backup.Condition, 0, len(keys))
+	for _, k := range keys {
+		conditions = append(conditions, &backup.Condition{
+			ConditionType:  aws.String(backup.ConditionTypeStringequals),
+			ConditionKey:   aws.String(k),
+			ConditionValue: aws.String(tags[k]),
+		})
+	}
+
+	return &backup.Selection{
+		SelectionName: aws.String(s.planName()),
+		IamRoleArn:    aws.String(spec.IAMRoleARN),
+		Resources:     aws.StringSlice([]string{fmt.Sprintf("arn:%s:ec2:*:*:volume/*", roleARN.Partition)}),
+		ListOfTags:    conditions,
+	}, nil
+}
+
+func backupSelectionNeedsUpdate(current, desired *backup.Selection) bool {
+	if current == nil {
+		return true
+	}
+
+	if aws.StringValue(current.IamRoleArn) != aws.StringValue(desired.IamRoleArn) {
+		return true
+	}
+
+	if !sets.NewString(aws.StringValueSlice(current.Resources)...).Equal(sets.NewString(aws.StringValueSlice(desired.Resources)...)) {
+		return true
+	}
+
+	currentTags := make(infrav1.Tags, len(current.ListOfTags))
+	for _, condition := range current.ListOfTags {
+		if aws.StringValue(condition.ConditionType) != backup.ConditionTypeStringequals {
+			return true
+		}
+		currentTags[aws.StringValue(condition.ConditionKey)] = aws.StringValue(condition.ConditionValue)
+	}
+	desiredTags := make(infrav1.Tags, len(desired.ListOfTags))
+	for _, condition := range desired.ListOfTags {
+		desiredTags[aws.StringValue(condition.ConditionKey)] = aws.StringValue(condition.ConditionValue)
+	}
+
+	return !currentTags.Equals(desiredTags)
+}
+
+// planName returns the name of the backup plan and its selection, which must not exceed 50 characters.
+func (s *Service) planName() string {
+	name := fmt.Sprintf("%s-ebs-backup", s.scope.Name())
+	if len(name) <= maxNameLength {
+		return name
+	}
+
+	hashed, err := hash.Base36TruncatedHash(name, maxNameLength)
+	if err != nil {
+		return name[:maxNameLength]
+	}
+	return hashed
+}
+
+func isNotFound(err error) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		return aerr.Code() == backup.ErrCodeResourceNotFoundException
+	}
+	return false
+}
@@ -0,0 +1,381 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/backup/mock_backupiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const (
+	testPlanID      = "plan-1234"
+	testPlanARN     = "arn:aws:backup:us-east-1:123456789012:backup-plan:plan-1234"
+	testSelectionID = "selection-1234"
+	testRoleARN     = "arn:aws:iam::123456789012:role/service-role/AWSBackupDefaultServiceRole"
+)
+
+func TestReconcileBackupPlan(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	spec := &infrav1.Backup{
+		Schedule:      "cron(0 5 ? * * *)",
+		RetentionDays: 7,
+		VaultName:     "Default",
+		IAMRoleARN:    testRoleARN,
+	}
+	planInput := &backup.PlanInput{
+		BackupPlanName: aws.String("test-cluster-ebs-backup"),
+		Rules: []*backup.RuleInput{
+			{
+				RuleName:              aws.String("ebs-snapshots"),
+				ScheduleExpression:    aws.String("cron(0 5 ? * * *)"),
+				TargetBackupVaultName: aws.String("Default"),
+				Lifecycle:             &backup.Lifecycle{DeleteAfterDays: aws.Int64(7)},
+			},
+		},
+	}
+	plan := func(schedule string, retentionDays int64) *backup.GetBackupPlanOutput {
+		return &backup.GetBackupPlanOutput{
+			BackupPlanId:  aws.String(testPlanID),
+			BackupPlanArn: aws.String(testPlanARN),
+			BackupPlan: &backup.Plan{
+				BackupPlanName: aws.String("test-cluster-ebs-backup"),
+				Rules: []*backup.Rule{
+					{
+						RuleName:              aws.String("ebs-snapshots"),
+						ScheduleExpression:    aws.String(schedule),
+						TargetBackupVaultName: aws.String("Default"),
+						Lifecycle:             &backup.Lifecycle{DeleteAfterDays: aws.Int64(retentionDays)},
+					},
+				},
+			},
+		}
+	}
+	selection := func(tags map[string]string) *backup.Selection {
+		selection := &backup.Selection{
+			SelectionName: aws.String("test-cluster-ebs-backup"),
+			IamRoleArn:    aws.String(testRoleARN),
+			Resources:     aws.StringSlice([]string{"arn:aws:ec2:*:*:volume/*"}),
+		}
+		for k, v := range tags {
+			selection.ListOfTags = append(selection.ListOfTags, &backup.Condition{
+				ConditionType:  aws.String("STRINGEQUALS"),
+				ConditionKey:   aws.String(k),
+				ConditionValue: aws.String(v),
+			})
+		}
+		return selection
+	}
+	ownedTags := map[string]string{"sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster": "owned"}
+	selectionSummary := []*backup.SelectionsListMember{
+		{SelectionId: aws.String(testSelectionID), SelectionName: aws.String("test-cluster-ebs-backup")},
+	}
+	expectedStatus := &infrav1.BackupStatus{PlanID: testPlanID, PlanARN: testPlanARN, SelectionID: testSelectionID}
+
+	testCases := []struct {
+		name           string
+		spec           *infrav1.Backup
+		status         *infrav1.BackupStatus
+		expect         func(m *mock_backupiface.MockBackupAPIMockRecorder)
+		expectErr      bool
+		expectedStatus *infrav1.BackupStatus
+	}{
+		{
+			name:   "does nothing when backup is not configured",
+			expect: func(m *mock_backupiface.MockBackupAPIMockRecorder) {},
+		},
+		{
+			name: "creates plan and selection when they do not exist",
+			spec: spec,
+			expect: func(m *mock_backupiface.MockBackupAPIMockRecorder) {
+				m.ListBackupPlans(gomock.Eq(&backup.ListBackupPlansInput{})).Return(&backup.ListBackupPlansOutput{}, nil)
+				m.DescribeBackupVault(gomock.Eq(&backup.DescribeBackupVaultInput{BackupVaultName: aws.String(spec.VaultName)})).
+					Return(&backup.DescribeBackupVaultOutput{}, nil)
+				m.CreateBackupPlan(gomock.AssignableToTypeOf(&backup.CreateBackupPlanInput{})).
+					DoAndReturn(func(input *backup.CreateBackupPlanInput) (*backup.CreateBackupPlanOutput, error) {
+						g := NewWithT(t)
+						g.Expect(input.BackupPlan).To(Equal(planInput))
+						g.Expect(input.CreatorRequestId).To(Equal(aws.String("test-cluster-ebs-backup")))
+						g.Expect(input.BackupPlanTags).To(HaveKeyWithValue("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster", aws.String("owned")))
+						return &backup.CreateBackupPlanOutput{BackupPlanId: aws.String(testPlanID), BackupPlanArn: aws.String(testPlanARN)}, nil
+					})
+				m.ListBackupSelections(gomock.Eq(&backup.ListBackupSelectionsInput{BackupPlanId: aws.String(testPlanID)})).
+					Return(&backup.ListBackupSelectionsOutput{}, nil)
+				m.CreateBackupSelection(gomock.Eq(&backup.CreateBackupSelectionInput{
+					BackupPlanId:    aws.String(testPlanID),
+					BackupSelection: selection(ownedTags),
+				})).Return(&backup.CreateBackupSelectionOutput{SelectionId: aws.String(testSelectionID)}, nil)
+			},
+			expectedStatus: expectedStatus,
+		},
+		{
+			name: "adopts existing plan owned by the cluster",
+			spec: spec,
+			expect: func(m *mock_backupiface.MockBackupAPIMockRecorder) {
+				m.ListBackupPlans(gomock.Any()).Return(&backup.ListBackupPlansOutput{
+					BackupPlansList: []*backup.PlansListMember{
+						{BackupPlanId: aws.String("plan-other"), BackupPlanArn: aws.String("arn-other"), BackupPlanName: aws.String("test-cluster-ebs-backup")},
+						{BackupPlanId: aws.String(testPlanID), BackupPlanArn: aws.String(testPlanARN), BackupPlanName: aws.String("test-cluster-ebs-backup")},
+					},
+				}, nil)
+				m.ListTags(gomock.Eq(&backup.ListTagsInput{ResourceArn: aws.String("arn-other")})).
+					Return(&backup.ListTagsOutput{}, nil)
+				m.ListTags(gomock.Eq(&backup.ListTagsInput{ResourceArn: aws.String(testPlanARN)})).
+					Return(&backup.ListTagsOutput{Tags: aws.StringMap(ownedTags)}, nil)
+				m.GetBackupPlan(gomock.Eq(&backup.GetBackupPlanInput{BackupPlanId: aws.String(testPlanID)})).
+					Return(plan("cron(0 5 ? * * *)", 7), nil)
+				m.ListBackupSelections(gomock.Any()).Return(&backup.ListBackupSelectionsOutput{BackupSelectionsList: selectionSummary}, nil)
+				m.GetBackupSelection(gomock.Eq(&backup.GetBackupSelectionInput{BackupPlanId: aws.String(testPlanID), SelectionId: aws.String(testSelectionID)})).
+					Return(&backup.GetBackupSelectionOutput{BackupSelection: selection(ownedTags)}, nil)
+			},
+			expectedStatus: expectedStatus,
+		},
+		{
+			name:   "updates plan when schedule or retention changed",
+			spec:   spec,
+			status: &infrav1.BackupStatus{PlanID: testPlanID},
+			expect: func(m *mock_backupiface.MockBackupAPIMockRecorder) {
+				m.GetBackupPlan(gomock.Eq(&backup.GetBackupPlanInput{BackupPlanId: aws.String(testPlanID)})).
+					Return(plan("cron(0 1 ? * * *)", 30), nil)
+				m.DescribeBackupVault(gomock.Any()).Return(&backup.DescribeBackupVaultOutput{}, nil)
+				m.UpdateBackupPlan(gomock.Eq(&backup.UpdateBackupPlanInput{BackupPlanId: aws.String(testPlanID), BackupPlan: planInput})).
+					Return(&backup.UpdateBackupPlanOutput{}, nil)
+				m.ListBackupSelections(gomock.Any()).Return(&backup.ListBackupSelectionsOutput{BackupSelectionsList: selectionSummary}, nil)
+				m.GetBackupSelection(gomock.Any()).Return(&backup.GetBackupSelectionOutput{BackupSelection: selection(ownedTags)}, nil)
+			},
+			expectedStatus: expectedStatus,
+		},
+		{
+			name: "replaces selection when selection tags changed",
+			spec: &infrav1.Backup{
+				Schedule:      "cron(0 5 ? * * *)",
+				RetentionDays: 7,
+				VaultName:     "Default",
+				IAMRoleARN:    testRoleARN,
+				SelectionTags: infrav1.Tags{"backup": "true"},
+			},
+			status: &infrav1.BackupStatus{PlanID: testPlanID, SelectionID: "selection-old"},
+			expect: func(m *mock_backupiface.MockBackupAPIMockRecorder) {
+				m.GetBackupPlan(gomock.Any()).Return(plan("cron(0 5 ? * * *)", 7), nil)
+				m.ListBackupSelections(gomock.Any()).Return(&backup.ListBackupSelectionsOutput{
+					BackupSelectionsList: []*backup.SelectionsListMember{
+						{SelectionId: aws.String("selection-old"), SelectionName: aws.String("test-cluster-ebs-backup")},
+					},
+				}, nil)
+				m.GetBackupSelection(gomock.Any()).Return(&backup.GetBackupSelectionOutput{BackupSelection: selection(ownedTags)}, nil)
+				m.DeleteBackupSelection(gomock.Eq(&backup.DeleteBackupSelectionInput{BackupPlanId: aws.String(testPlanID), SelectionId: aws.String("selection-old")})).
+					Return(&backup.DeleteBackupSelectionOutput{}, nil)
+				m.CreateBackupSelection(gomock.Eq(&backup.CreateBackupSelectionInput{
+					BackupPlanId:    aws.String(testPlanID),
+					BackupSelection: selection(map[string]string{"backup": "true"}),
+				})).Return(&backup.CreateBackupSelectionOutput{SelectionId: aws.String(testSelectionID)}, nil)
+			},
+			expectedStatus: expectedStatus,
+		},
+		{
+			name: "returns error when listing plans fails",
+			spec: spec,
+			expect: func(m *mock_backupiface.MockBackupAPIMockRecorder) {
+				m.ListBackupPlans(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectErr: true,
+		},
+		{
+			name: "returns error when vault does not exist",
+			spec: spec,
+			expect: func(m *mock_backupiface.MockBackupAPIMockRecorder) {
+				m.ListBackupPlans(gomock.Any()).Return(&backup.ListBackupPlansOutput{}, nil)
+				m.DescribeBackupVault(gomock.Any()).Return(nil, awserr.New(backup.ErrCodeResourceNotFoundException, "", nil))
+			},
+			expectErr: true,
+		},
+		{
+			name: "returns error when selection can't be created",
+			spec: spec,
+			expect: func(m *mock_backupiface.MockBackupAPIMockRecorder) {
+				m.ListBackupPlans(gomock.Any()).Return(&backup.ListBackupPlansOutput{}, nil)
+				m.DescribeBackupVault(gomock.Any()).Return(&backup.DescribeBackupVaultOutput{}, nil)
+				m.CreateBackupPlan(gomock.Any()).
+					Return(&backup.CreateBackupPlanOutput{BackupPlanId: aws.String(testPlanID), BackupPlanArn: aws.String(testPlanARN)}, nil)
+				m.ListBackupSelections(gomock.Any()).Return(&backup.ListBackupSelectionsOutput{}, nil)
+				m.CreateBackupSelection(gomock.Any()).Return(nil, awserr.New(backup.ErrCodeInvalidParameterValueException, "", nil))
+			},
+			expectErr:      true,
+			expectedStatus: &infrav1.BackupStatus{PlanID: testPlanID, PlanARN: testPlanARN},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			backupMock := mock_backupiface.NewMockBackupAPI(mockCtrl)
+			clusterScope, err := setupCluster("test-cluster", tc.spec, tc.status)
+			g.Expect(err).To(Not(HaveOccurred()))
+
+			tc.expect(backupMock.EXPECT())
+			s := NewService(clusterScope)
+			s.BackupClient = backupMock
+
+			err = s.ReconcileBackupPlan()
+			if tc.expectErr {
+				g.Expect(err).NotTo(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+			g.Expect(clusterScope.BackupStatus()).To(Equal(tc.expectedStatus))
+		})
+	}
+}
+
+func TestDeleteBackupPlan(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	spec := &infrav1.Backup{
+		Schedule:      "cron(0 5 ? * * *)",
+		RetentionDays: 7,
+		VaultName:     "Default",
+		IAMRoleARN:    testRoleARN,
+	}
+	plan := &backup.GetBackupPlanOutput{
+		BackupPlanId:  aws.String(testPlanID),
+		BackupPlanArn: aws.String(testPlanARN),
+	}
+
+	testCases := []struct {
+		name      string
+		spec      *infrav1.Backup
+		status    *infrav1.BackupStatus
+		expect    func(m *mock_backupiface.MockBackupAPIMockRecorder)
+		expectErr bool
+	}{
+		{
+			name:   "does nothing when backup is not configured",
+			expect: func(m *mock_backupiface.MockBackupAPIMockRecorder) {},
+		},
+		{
+			name:   "deletes selections and plan recorded in status",
+			spec:   spec,
+			status: &infrav1.BackupStatus{PlanID: testPlanID, SelectionID: testSelectionID},
+			expect: func(m *mock_backupiface.MockBackupAPIMockRecorder) {
+				m.GetBackupPlan(gomock.Eq(&backup.GetBackupPlanInput{BackupPlanId: aws.String(testPlanID)})).Return(plan, nil)
+				m.ListBackupSelections(gomock.Eq(&backup.ListBackupSelectionsInput{BackupPlanId: aws.String(testPlanID)})).
+					Return(&backup.ListBackupSelectionsOutput{
+						BackupSelectionsList: []*backup.SelectionsListMember{{SelectionId: aws.String(testSelectionID)}},
+					}, nil)
+				m.DeleteBackupSelection(gomock.Eq(&backup.DeleteBackupSelectionInput{BackupPlanId: aws.String(testPlanID), SelectionId: aws.String(testSelectionID)})).
+					Return(&backup.DeleteBackupSelectionOutput{}, nil)
+				m.DeleteBackupPlan(gomock.Eq(&backup.DeleteBackupPlanInput{BackupPlanId: aws.String(testPlanID)})).
+					Return(&backup.DeleteBackupPlanOutput{}, nil)
+			},
+		},
+		{
+			name:   "deletes plan recorded in status after it was removed from spec",
+			status: &infrav1.BackupStatus{PlanID: testPlanID},
+			expect: func(m *mock_backupiface.MockBackupAPIMockRecorder) {
+				m.GetBackupPlan(gomock.Any()).Return(plan, nil)
+				m.ListBackupSelections(gomock.Any()).Return(&backup.ListBackupSelectionsOutput{}, nil)
+				m.DeleteBackupPlan(gomock.Eq(&backup.DeleteBackupPlanInput{BackupPlanId: aws.String(testPlanID)})).
+					Return(&backup.DeleteBackupPlanOutput{}, nil)
+			},
+		},
+		{
+			name: "does not error when plan is already gone",
+			spec: spec,
+			expect: func(m *mock_backupiface.MockBackupAPIMockRecorder) {
+				m.ListBackupPlans(gomock.Any()).Return(&backup.ListBackupPlansOutput{}, nil)
+			},
+		},
+		{
+			name:   "does not error when plan disappears during delete",
+			status: &infrav1.BackupStatus{PlanID: testPlanID},
+			expect: func(m *mock_backupiface.MockBackupAPIMockRecorder) {
+				m.GetBackupPlan(gomock.Any()).Return(plan, nil)
+				m.ListBackupSelections(gomock.Any()).Return(nil, awserr.New(backup.ErrCodeResourceNotFoundException, "", nil))
+				m.DeleteBackupPlan(gomock.Any()).Return(nil, awserr.New(backup.ErrCodeResourceNotFoundException, "", nil))
+			},
+		},
+		{
+			name:   "returns error when plan can't be deleted",
+			status: &infrav1.BackupStatus{PlanID: testPlanID},
+			expect: func(m *mock_backupiface.MockBackupAPIMockRecorder) {
+				m.GetBackupPlan(gomock.Any()).Return(plan, nil)
+				m.ListBackupSelections(gomock.Any()).Return(&backup.ListBackupSelectionsOutput{}, nil)
+				m.DeleteBackupPlan(gomock.Any()).Return(nil, awserr.New(backup.ErrCodeInvalidRequestException, "", nil))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			backupMock := mock_backupiface.NewMockBackupAPI(mockCtrl)
+			clusterScope, err := setupCluster("test-cluster", tc.spec, tc.status)
+			g.Expect(err).To(Not(HaveOccurred()))
+
+			tc.expect(backupMock.EXPECT())
+			s := NewService(clusterScope)
+			s.BackupClient = backupMock
+
+			err = s.DeleteBackupPlan()
+			if tc.expectErr {
+				g.Expect(err).NotTo(BeNil())
+				return
+			}
+			g.Expect(err).To(BeNil())
+			g.Expect(clusterScope.BackupStatus()).To(BeNil())
+		})
+	}
+}
+
+func setupCluster(clusterName string, spec *infrav1.Backup, status *infrav1.BackupStatus) (*scope.ClusterScope, error) {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: infrav1.AWSClusterSpec{
+			Backup: spec,
+		},
+		Status: infrav1.AWSClusterStatus{
+			Backup: status,
+		},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(awsCluster).Build()
+	return scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: clusterName},
+		},
+		AWSCluster: awsCluster,
+		Client:     client,
+	})
+}
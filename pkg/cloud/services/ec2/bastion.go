@@ -28,7 +28,9 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/servicequotas"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/userdata"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/tags"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util/conditions"
@@ -81,6 +83,9 @@ func (s *Service) ReconcileBastion() error {
 			record.Warnf(s.scope.InfraCluster(), "FailedFetchingBastion", "Failed to fetch default bastion instance: %v", err)
 			return err
 		}
+		if err := s.reconcileBastionServiceQuotas(defaultBastion); err != nil {
+			return err
+		}
 		instance, err = s.runInstance("bastion", defaultBastion)
 		if err != nil {
 			record.Warnf(s.scope.InfraCluster(), "FailedCreateBastion", "Failed to create bastion instance: %v", err)
@@ -91,6 +96,8 @@ func (s *Service) ReconcileBastion() error {
 		s.scope.Info("Created new bastion host", "id", instance.ID)
 	} else if err != nil {
 		return err
+	} else if err := s.reconcileBastionTags(instance); err != nil {
+		return err
 	}
 
 	// TODO(vincepri): check for possible changes between the default spec and the instance.
@@ -166,8 +173,46 @@ func (s *Service) describeBastionInstance() (*infrav1.Instance, error) {
 	return nil, awserrors.NewNotFound("bastion host not found")
 }
 
-func (s *Service) getDefaultBastion(instanceType, ami string) (*infrav1.Instance, error) {
+// reconcileBastionTags makes sure the tags of the bastion instance are up to date.
+func (s *Service) reconcileBastionTags(instance *infrav1.Instance) error {
+	buildParams := s.getBastionTagParams()
+	buildParams.ResourceID = instance.ID
+	tagsBuilder := tags.New(&buildParams, tags.WithEC2(s.EC2Client), tags.WithRemovedKeys(s.scope.RemovedCostAllocationTagKeys()...))
+	if err := tagsBuilder.Ensure(instance.Tags); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedTagBastion", "Failed to tag bastion instance %q: %v", instance.ID, err)
+		return errors.Wrapf(err, "failed to tag bastion instance %q", instance.ID)
+	}
+
+	return nil
+}
+
+// reconcileBastionServiceQuotas checks the headroom of the service quotas for the bastion instance to create
+// and its network interface, when enabled for the cluster.
+func (s *Service) reconcileBastionServiceQuotas(bastion *infrav1.Instance) error {
+	if s.scope.ServiceQuotaChecks() == nil {
+		return nil
+	}
+
+	return s.ServiceQuotaService.ReconcileHeadroom(s.scope.InfraCluster(), servicequotas.Request{
+		InstanceType:      bastion.Type,
+		Instances:         1,
+		NetworkInterfaces: 1,
+	})
+}
+
+func (s *Service) getBastionTagParams() infrav1.BuildParams {
 	name := fmt.Sprintf("%s-bastion", s.scope.Name())
+
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(name),
+		Role:        aws.String(infrav1.BastionRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
+
+func (s *Service) getDefaultBastion(instanceType, ami string) (*infrav1.Instance, error) {
 	userData, _ := userdata.NewBastion(&userdata.BastionInput{})
 
 	// If SSHKeyName WAS NOT provided, use the defaultSSHKeyName
@@ -203,13 +248,7 @@ func (s *Service) getDefaultBastion(instanceType, ami string) (*infrav1.Instance
 		SecurityGroupIDs: []string{
 			s.scope.Network().SecurityGroups[infrav1.SecurityGroupBastion].ID,
 		},
-		Tags: infrav1.Build(infrav1.BuildParams{
-			ClusterName: s.scope.Name(),
-			Lifecycle:   infrav1.ResourceLifecycleOwned,
-			Name:        aws.String(name),
-			Role:        aws.String(infrav1.BastionRoleTagValue),
-			Additional:  s.scope.AdditionalTags(),
-		}),
+		Tags: infrav1.Build(s.getBastionTagParams()),
 	}
 
 	return i, nil
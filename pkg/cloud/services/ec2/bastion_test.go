@@ -30,6 +30,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/converters"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/filter"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
@@ -260,12 +261,21 @@ func TestService_ReconcileBastion(t *testing.T) {
 		},
 	}
 
+	bastionTags := map[string]string{
+		"Name": "cluster-bastion",
+		"sigs.k8s.io/cluster-api-provider-aws/cluster/cluster": "owned",
+		"sigs.k8s.io/cluster-api-provider-aws/role":            "bastion",
+		"cost-center": "1234",
+	}
+
 	tests := []struct {
-		name           string
-		bastionEnabled bool
-		expect         func(m *mock_ec2iface.MockEC2APIMockRecorder)
-		expectError    bool
-		bastionStatus  *infrav1.Instance
+		name               string
+		bastionEnabled     bool
+		costAllocationTags infrav1.Tags
+		lastApplied        string
+		expect             func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		expectError        bool
+		bastionStatus      *infrav1.Instance
 	}{
 		{
 			name: "Should ignore reconciliation if instance not found",
@@ -383,6 +393,66 @@ func TestService_ReconcileBastion(t *testing.T) {
 				VolumeIDs:        []string{"volume-1"},
 			},
 		},
+		{
+			name:               "Should reconcile the tags of the existing bastion",
+			bastionEnabled:     true,
+			costAllocationTags: infrav1.Tags{"team": "a"},
+			lastApplied:        `["cost-center"]`,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstances(gomock.Eq(describeInput)).
+					Return(&ec2.DescribeInstancesOutput{
+						Reservations: []*ec2.Reservation{
+							{
+								Instances: []*ec2.Instance{
+									{
+										InstanceId: aws.String("id123"),
+										State: &ec2.InstanceState{
+											Name: aws.String(ec2.InstanceStateNameRunning),
+										},
+										Placement: &ec2.Placement{
+											AvailabilityZone: aws.String("us-east-1"),
+										},
+										Tags: converters.MapToTags(bastionTags),
+									},
+								},
+							},
+						},
+					}, nil)
+				m.CreateTags(gomock.Eq(&ec2.CreateTagsInput{
+					Resources: aws.StringSlice([]string{"id123"}),
+					Tags: []*ec2.Tag{
+						{
+							Key:   aws.String("Name"),
+							Value: aws.String("cluster-bastion"),
+						},
+						{
+							Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/cluster"),
+							Value: aws.String("owned"),
+						},
+						{
+							Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+							Value: aws.String("bastion"),
+						},
+						{
+							Key:   aws.String("team"),
+							Value: aws.String("a"),
+						},
+					},
+				})).Return(nil, nil)
+				m.DeleteTags(gomock.Eq(&ec2.DeleteTagsInput{
+					Resources: aws.StringSlice([]string{"id123"}),
+					Tags:      []*ec2.Tag{{Key: aws.String("cost-center")}},
+				})).Return(nil, nil)
+			},
+			expectError: false,
+			bastionStatus: &infrav1.Instance{
+				ID:               "id123",
+				State:            "running",
+				Addresses:        []clusterv1.MachineAddress{},
+				AvailabilityZone: "us-east-1",
+				Tags:             bastionTags,
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -418,9 +488,13 @@ func TestService_ReconcileBastion(t *testing.T) {
 								},
 							},
 						},
-						Bastion: infrav1.Bastion{Enabled: tc.bastionEnabled},
+						Bastion:            infrav1.Bastion{Enabled: tc.bastionEnabled},
+						CostAllocationTags: tc.costAllocationTags,
 					},
 				}
+				if tc.lastApplied != "" {
+					awsCluster.Annotations = map[string]string{infrav1.CostAllocationTagsLastAppliedAnnotation: tc.lastApplied}
+				}
 
 				client := fake.NewClientBuilder().WithScheme(scheme).Build()
 				ctx := context.TODO()
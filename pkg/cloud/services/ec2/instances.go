@@ -171,6 +171,12 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte, use
 		}
 	}
 
+	if scope.AWSMachine.Spec.BootMode != "" {
+		if err := s.checkBootMode(scope.AWSMachine.Spec.BootMode, input.ImageID); err != nil {
+			return nil, err
+		}
+	}
+
 	subnetID, err := s.findSubnet(scope)
 	if err != nil {
 		return nil, err
@@ -227,7 +233,7 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte, use
 
 	input.SpotMarketOptions = scope.AWSMachine.Spec.SpotMarketOptions
 
-	input.Tenancy = scope.AWSMachine.Spec.Tenancy
+	input.Tenancy = s.instanceTenancy(scope, subnetID)
 
 	s.scope.V(2).Info("Running instance", "machine-role", scope.Role())
 	out, err := s.runInstance(scope.Role(), input)
@@ -253,6 +259,17 @@ func (s *Service) CreateInstance(scope *scope.MachineScope, userData []byte, use
 	return out, nil
 }
 
+// instanceTenancy returns the tenancy of the machine, falling back to the tenancy of its subnet.
+func (s *Service) instanceTenancy(scope *scope.MachineScope, subnetID string) string {
+	if scope.AWSMachine.Spec.Tenancy != "" {
+		return scope.AWSMachine.Spec.Tenancy
+	}
+	if subnet := s.scope.Subnets().FindByID(subnetID); subnet != nil {
+		return subnet.Tenancy
+	}
+	return ""
+}
+
 // findSubnet attempts to retrieve a subnet ID in the following order:
 // - subnetID specified in machine configuration,
 // - subnet based on filters in machine configuration
@@ -537,21 +554,27 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 	}
 
 	if len(i.Tags) > 0 {
-		spec := &ec2.TagSpecification{ResourceType: aws.String(ec2.ResourceTypeInstance)}
 		// We need to sort keys for tests to work
 		keys := make([]string, 0, len(i.Tags))
 		for k := range i.Tags {
 			keys = append(keys, k)
 		}
 		sort.Strings(keys)
+		tags := make([]*ec2.Tag, 0, len(keys))
 		for _, key := range keys {
-			spec.Tags = append(spec.Tags, &ec2.Tag{
+			tags = append(tags, &ec2.Tag{
 				Key:   aws.String(key),
 				Value: aws.String(i.Tags[key]),
 			})
 		}
 
-		input.TagSpecifications = append(input.TagSpecifications, spec)
+		// The volumes are tagged as well, so that they can be selected as owned by the cluster, e.g. for backups.
+		for _, resourceType := range []string{ec2.ResourceTypeInstance, ec2.ResourceTypeVolume} {
+			input.TagSpecifications = append(input.TagSpecifications, &ec2.TagSpecification{
+				ResourceType: aws.String(resourceType),
+				Tags:         tags,
+			})
+		}
 	}
 
 	input.InstanceMarketOptions = getInstanceMarketOptionsRequest(i.SpotMarketOptions)
@@ -740,6 +763,66 @@ func (s *Service) getImageRootDevice(imageID string) (*string, error) {
 	return output.Images[0].RootDeviceName, nil
 }
 
+// checkBootMode verifies that instances launched from the image boot with the requested boot mode.
+// The boot mode can't be set when running an instance, it's determined by the AMI.
+func (s *Service) checkBootMode(bootMode, imageID string) error {
+	input := &ec2.DescribeImagesInput{
+		ImageIds: []*string{aws.String(imageID)},
+	}
+
+	output, err := s.EC2Client.DescribeImages(input)
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe image %q", imageID)
+	}
+
+	if len(output.Images) == 0 {
+		return errors.Errorf("no images returned when looking up ID %q", imageID)
+	}
+
+	if imageBootMode := imageBootMode(output.Images[0]); imageBootMode != bootMode {
+		return errors.Errorf("boot mode %q is not supported by image %q which boots with %q", bootMode, imageID, imageBootMode)
+	}
+
+	return nil
+}
+
+// checkParavirtualImage verifies that the image uses paravirtual virtualization. A kernel and RAM
+// disk can only be chosen at launch for paravirtual images, HVM images boot their own kernel.
+func (s *Service) checkParavirtualImage(imageID string) error {
+	input := &ec2.DescribeImagesInput{
+		ImageIds: []*string{aws.String(imageID)},
+	}
+
+	output, err := s.EC2Client.DescribeImages(input)
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe image %q", imageID)
+	}
+
+	if len(output.Images) == 0 {
+		return errors.Errorf("no images returned when looking up ID %q", imageID)
+	}
+
+	if virtualizationType := aws.StringValue(output.Images[0].VirtualizationType); virtualizationType != ec2.VirtualizationTypeParavirtual {
+		return errors.Errorf("a kernel can't be set for image %q with %q virtualization, only paravirtual images are supported", imageID, virtualizationType)
+	}
+
+	return nil
+}
+
+// imageBootMode returns the boot mode of instances launched from the image. Images registered
+// without a boot mode boot with the default of their architecture.
+func imageBootMode(image *ec2.Image) string {
+	if image.BootMode != nil {
+		return aws.StringValue(image.BootMode)
+	}
+
+	if aws.StringValue(image.Architecture) == ec2.ArchitectureValuesArm64 {
+		return ec2.BootModeValuesUefi
+	}
+
+	return ec2.BootModeValuesLegacyBios
+}
+
 func (s *Service) getImageSnapshotSize(imageID string) (*int64, error) {
 	input := &ec2.DescribeImagesInput{
 		ImageIds: []*string{aws.String(imageID)},
@@ -195,6 +195,17 @@ func (s *Service) createLaunchTemplateData(scope *scope.MachinePoolScope, imageI
 	// set the AMI ID
 	data.ImageId = imageID
 
+	// Set up the kernel and RAM disk for paravirtual AMIs
+	if lt.KernelID != "" {
+		if err := s.checkParavirtualImage(*data.ImageId); err != nil {
+			return nil, err
+		}
+		data.KernelId = aws.String(lt.KernelID)
+		if lt.RamdiskID != "" {
+			data.RamDiskId = aws.String(lt.RamdiskID)
+		}
+	}
+
 	// Set up root volume
 	if lt.RootVolume != nil {
 		rootDeviceName, err := s.checkRootVolume(lt.RootVolume, *data.ImageId)
@@ -330,6 +341,8 @@ func (s *Service) SDKToLaunchTemplate(d *ec2.LaunchTemplateVersion) (*expinfrav1
 		InstanceType:       aws.StringValue(v.InstanceType),
 		SSHKeyName:         v.KeyName,
 		VersionNumber:      d.VersionNumber,
+		KernelID:           aws.StringValue(v.KernelId),
+		RamdiskID:          aws.StringValue(v.RamDiskId),
 	}
 
 	// Extract IAM Instance Profile name from ARN
@@ -371,6 +384,10 @@ func (s *Service) LaunchTemplateNeedsUpdate(scope *scope.MachinePoolScope, incom
 		return true, nil
 	}
 
+	if incoming.KernelID != existing.KernelID || incoming.RamdiskID != existing.RamdiskID {
+		return true, nil
+	}
+
 	incomingIDs, err := s.GetAdditionalSecurityGroupsIDs(incoming.AdditionalSecurityGroups)
 	if err != nil {
 		return false, err
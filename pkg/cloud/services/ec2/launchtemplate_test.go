@@ -340,6 +340,31 @@ func TestService_SDKToLaunchTemplate(t *testing.T) {
 			},
 			wantHash: testUserDataHash,
 		},
+		{
+			name: "kernel and ramdisk",
+			input: &ec2.LaunchTemplateVersion{
+				LaunchTemplateId:   aws.String("lt-12345"),
+				LaunchTemplateName: aws.String("foo"),
+				LaunchTemplateData: &ec2.ResponseLaunchTemplateData{
+					ImageId:            aws.String("foo-image"),
+					IamInstanceProfile: &ec2.LaunchTemplateIamInstanceProfileSpecification{},
+					KernelId:           aws.String("aki-919dcaf8"),
+					RamDiskId:          aws.String("ari-9e7cb6f7"),
+					UserData:           aws.String(base64.StdEncoding.EncodeToString([]byte(testUserData))),
+				},
+				VersionNumber: aws.Int64(1),
+			},
+			wantLT: &expinfrav1.AWSLaunchTemplate{
+				Name: "foo",
+				AMI: infrav1.AMIReference{
+					ID: aws.String("foo-image"),
+				},
+				VersionNumber: aws.Int64(1),
+				KernelID:      "aki-919dcaf8",
+				RamdiskID:     "ari-9e7cb6f7",
+			},
+			wantHash: testUserDataHash,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -387,6 +412,29 @@ func TestService_LaunchTemplateNeedsUpdate(t *testing.T) {
 			want:    false,
 			wantErr: false,
 		},
+		{
+			name: "kernel changed",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				KernelID: "aki-919dcaf8",
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				KernelID: "aki-88aa75e1",
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "ramdisk removed",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				KernelID: "aki-919dcaf8",
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				KernelID:  "aki-919dcaf8",
+				RamdiskID: "ari-9e7cb6f7",
+			},
+			want:    true,
+			wantErr: false,
+		},
 		{
 			name: "core security group removed externally",
 			incoming: &expinfrav1.AWSLaunchTemplate{
@@ -936,6 +984,86 @@ func Test_LaunchTemplateDataCreation(t *testing.T) {
 	})
 }
 
+func TestCreateLaunchTemplateData_Kernel(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name              string
+		kernelID          string
+		ramdiskID         string
+		expect            func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		expectedKernelID  *string
+		expectedRamdiskID *string
+		expectError       bool
+	}{
+		{
+			name:   "no kernel",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {},
+		},
+		{
+			name:      "kernel and ramdisk with paravirtual image",
+			kernelID:  "aki-919dcaf8",
+			ramdiskID: "ari-9e7cb6f7",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeImages(&ec2.DescribeImagesInput{ImageIds: aws.StringSlice([]string{"imageID"})}).
+					Return(&ec2.DescribeImagesOutput{Images: []*ec2.Image{{VirtualizationType: aws.String(ec2.VirtualizationTypeParavirtual)}}}, nil)
+			},
+			expectedKernelID:  aws.String("aki-919dcaf8"),
+			expectedRamdiskID: aws.String("ari-9e7cb6f7"),
+		},
+		{
+			name:     "kernel without ramdisk",
+			kernelID: "aki-919dcaf8",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeImages(&ec2.DescribeImagesInput{ImageIds: aws.StringSlice([]string{"imageID"})}).
+					Return(&ec2.DescribeImagesOutput{Images: []*ec2.Image{{VirtualizationType: aws.String(ec2.VirtualizationTypeParavirtual)}}}, nil)
+			},
+			expectedKernelID: aws.String("aki-919dcaf8"),
+		},
+		{
+			name:     "kernel with HVM image",
+			kernelID: "aki-919dcaf8",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeImages(&ec2.DescribeImagesInput{ImageIds: aws.StringSlice([]string{"imageID"})}).
+					Return(&ec2.DescribeImagesOutput{Images: []*ec2.Image{{VirtualizationType: aws.String(ec2.VirtualizationTypeHvm)}}}, nil)
+			},
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			cs, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+			mockEC2Client := mock_ec2iface.NewMockEC2API(mockCtrl)
+			tc.expect(mockEC2Client.EXPECT())
+
+			ms, err := setupMachinePoolScope(client, cs)
+			g.Expect(err).NotTo(HaveOccurred())
+			ms.AWSMachinePool.Spec.AWSLaunchTemplate.KernelID = tc.kernelID
+			ms.AWSMachinePool.Spec.AWSLaunchTemplate.RamdiskID = tc.ramdiskID
+
+			s := NewService(cs)
+			s.EC2Client = mockEC2Client
+
+			data, err := s.createLaunchTemplateData(ms, aws.String("imageID"), nil)
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(data.KernelId).To(Equal(tc.expectedKernelID))
+			g.Expect(data.RamDiskId).To(Equal(tc.expectedRamdiskID))
+		})
+	}
+}
+
 func TestCreateLaunchTemplateVersion(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
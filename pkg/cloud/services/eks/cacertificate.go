@@ -0,0 +1,207 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"encoding/base64"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+// defaultCACertificateParameterKeyID is the AWS managed key SecureString parameters are encrypted
+// with when no key is given.
+const defaultCACertificateParameterKeyID = "alias/aws/ssm"
+
+// reconcileCACertificateParameter publishes the CA certificate of the cluster to the configured
+// SSM parameter, overwriting it when the CA has been rotated or the KMS key changed. A parameter
+// published under a different name before is deleted. Parameters that aren't tagged as owned by
+// the cluster are never overwritten or deleted.
+func (s *Service) reconcileCACertificateParameter(cluster *eks.Cluster) error {
+	spec := s.scope.ControlPlane.Spec.CACertificateParameter
+	published := s.scope.ControlPlane.Status.CACertificateParameterName
+	if published != "" && (spec == nil || spec.Name != published) {
+		if err := s.deleteCACertificateParameter(); err != nil {
+			return err
+		}
+	}
+
+	if spec == nil || cluster.CertificateAuthority == nil || cluster.CertificateAuthority.Data == nil {
+		return nil
+	}
+
+	certData, err := base64.StdEncoding.DecodeString(*cluster.CertificateAuthority.Data)
+	if err != nil {
+		return errors.Wrap(err, "decoding cluster CA cert")
+	}
+	value := string(certData)
+
+	out, err := s.SSMClient.GetParameter(&ssm.GetParameterInput{
+		Name:           aws.String(spec.Name),
+		WithDecryption: aws.Bool(true),
+	})
+	switch {
+	case err == nil:
+		owned, err := s.isCACertificateParameterOwned(spec.Name)
+		if err != nil {
+			return err
+		}
+		if !owned {
+			record.Warnf(s.scope.ControlPlane, "FailedUpdateCACertificateParameter", "CA certificate parameter %q already exists and isn't owned by the cluster", spec.Name)
+			return errors.Errorf("CA certificate parameter %q already exists and isn't owned by the cluster", spec.Name)
+		}
+
+		keyID, err := s.caCertificateParameterCurrentKeyID(spec.Name)
+		if err != nil {
+			return err
+		}
+		desiredKeyID := defaultCACertificateParameterKeyID
+		if spec.KMSKeyID != "" {
+			desiredKeyID = spec.KMSKeyID
+		}
+		if aws.StringValue(out.Parameter.Value) == value && keyID == desiredKeyID {
+			s.scope.V(2).Info("CA certificate parameter is up to date", "name", spec.Name)
+			break
+		}
+
+		// Tags can't be set when overwriting a parameter, they were set on creation.
+		if _, err := s.SSMClient.PutParameter(&ssm.PutParameterInput{
+			Name:      aws.String(spec.Name),
+			Value:     aws.String(value),
+			Type:      aws.String(ssm.ParameterTypeSecureString),
+			KeyId:     s.caCertificateParameterKeyID(),
+			Overwrite: aws.Bool(true),
+		}); err != nil {
+			record.Warnf(s.scope.ControlPlane, "FailedUpdateCACertificateParameter", "Failed to update CA certificate parameter %q: %v", spec.Name, err)
+			return errors.Wrapf(err, "failed to update CA certificate parameter %q", spec.Name)
+		}
+		record.Eventf(s.scope.ControlPlane, "SuccessfulUpdateCACertificateParameter", "Updated CA certificate parameter %q", spec.Name)
+	case awserrors.IsNotFound(err):
+		if _, err := s.SSMClient.PutParameter(&ssm.PutParameterInput{
+			Name:  aws.String(spec.Name),
+			Value: aws.String(value),
+			Type:  aws.String(ssm.ParameterTypeSecureString),
+			KeyId: s.caCertificateParameterKeyID(),
+			Tags:  converters.MapToSSMTags(s.caCertificateParameterTags()),
+		}); err != nil {
+			record.Warnf(s.scope.ControlPlane, "FailedCreateCACertificateParameter", "Failed to create CA certificate parameter %q: %v", spec.Name, err)
+			return errors.Wrapf(err, "failed to create CA certificate parameter %q", spec.Name)
+		}
+		record.Eventf(s.scope.ControlPlane, "SuccessfulCreateCACertificateParameter", "Created CA certificate parameter %q", spec.Name)
+	default:
+		return errors.Wrapf(err, "failed to get CA certificate parameter %q", spec.Name)
+	}
+
+	s.scope.ControlPlane.Status.CACertificateParameterName = spec.Name
+	return nil
+}
+
+// deleteCACertificateParameter deletes the SSM parameter the CA certificate was published to, if any.
+func (s *Service) deleteCACertificateParameter() error {
+	name := s.scope.ControlPlane.Status.CACertificateParameterName
+	if name == "" {
+		return nil
+	}
+
+	owned, err := s.isCACertificateParameterOwned(name)
+	switch {
+	case isInvalidResourceID(err):
+		s.scope.ControlPlane.Status.CACertificateParameterName = ""
+		return nil
+	case err != nil:
+		return err
+	case !owned:
+		record.Warnf(s.scope.ControlPlane, "FailedDeleteCACertificateParameter", "CA certificate parameter %q isn't owned by the cluster, not deleting it", name)
+		s.scope.ControlPlane.Status.CACertificateParameterName = ""
+		return nil
+	}
+
+	if _, err := s.SSMClient.DeleteParameter(&ssm.DeleteParameterInput{
+		Name: aws.String(name),
+	}); err != nil && !awserrors.IsNotFound(err) {
+		record.Warnf(s.scope.ControlPlane, "FailedDeleteCACertificateParameter", "Failed to delete CA certificate parameter %q: %v", name, err)
+		return errors.Wrapf(err, "failed to delete CA certificate parameter %q", name)
+	}
+	record.Eventf(s.scope.ControlPlane, "SuccessfulDeleteCACertificateParameter", "Deleted CA certificate parameter %q", name)
+
+	s.scope.ControlPlane.Status.CACertificateParameterName = ""
+	return nil
+}
+
+// isCACertificateParameterOwned returns whether the parameter is tagged as owned by the cluster.
+func (s *Service) isCACertificateParameterOwned(name string) (bool, error) {
+	out, err := s.SSMClient.ListTagsForResource(&ssm.ListTagsForResourceInput{
+		ResourceType: aws.String(ssm.ResourceTypeForTaggingParameter),
+		ResourceId:   aws.String(name),
+	})
+	if err != nil {
+		if isInvalidResourceID(err) {
+			return false, err
+		}
+		return false, errors.Wrapf(err, "failed to list tags of CA certificate parameter %q", name)
+	}
+	return converters.SSMTagsToMap(out.TagList).HasOwned(s.scope.Name()), nil
+}
+
+// isInvalidResourceID returns whether the error is returned for a parameter that doesn't exist
+// when listing its tags.
+func isInvalidResourceID(err error) bool {
+	code, ok := awserrors.Code(err)
+	return ok && code == ssm.ErrCodeInvalidResourceId
+}
+
+// caCertificateParameterCurrentKeyID returns the ID of the KMS key the parameter is encrypted with.
+func (s *Service) caCertificateParameterCurrentKeyID(name string) (string, error) {
+	out, err := s.SSMClient.DescribeParameters(&ssm.DescribeParametersInput{
+		ParameterFilters: []*ssm.ParameterStringFilter{{
+			Key:    aws.String("Name"),
+			Option: aws.String("Equals"),
+			Values: aws.StringSlice([]string{name}),
+		}},
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to describe CA certificate parameter %q", name)
+	}
+	if len(out.Parameters) == 0 {
+		return "", nil
+	}
+	return aws.StringValue(out.Parameters[0].KeyId), nil
+}
+
+func (s *Service) caCertificateParameterKeyID() *string {
+	if s.scope.ControlPlane.Spec.CACertificateParameter.KMSKeyID == "" {
+		return nil
+	}
+	return aws.String(s.scope.ControlPlane.Spec.CACertificateParameter.KMSKeyID)
+}
+
+func (s *Service) caCertificateParameterTags() infrav1.Tags {
+	return infrav1.Build(infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(s.scope.ControlPlane.Spec.CACertificateParameter.Name),
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	})
+}
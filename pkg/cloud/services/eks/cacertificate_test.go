@@ -0,0 +1,245 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ssm/mock_ssmiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestReconcileCACertificateParameter(t *testing.T) {
+	const (
+		caCert    = "-----BEGIN CERTIFICATE-----\nnew\n-----END CERTIFICATE-----\n"
+		oldCACert = "-----BEGIN CERTIFICATE-----\nold\n-----END CERTIFICATE-----\n"
+	)
+	notFound := awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil)
+	owned := &ssm.ListTagsForResourceOutput{TagList: []*ssm.Tag{{
+		Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/capi-name"),
+		Value: aws.String("owned"),
+	}}}
+	keyID := func(id string) *ssm.DescribeParametersOutput {
+		return &ssm.DescribeParametersOutput{Parameters: []*ssm.ParameterMetadata{{KeyId: aws.String(id)}}}
+	}
+
+	tests := []struct {
+		name           string
+		param          *ekscontrolplanev1.CACertificateParameter
+		published      string
+		expect         func(m *mock_ssmiface.MockSSMAPIMockRecorder)
+		expectError    bool
+		expectedStatus string
+	}{
+		{
+			name:   "not configured",
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {},
+		},
+		{
+			name:  "creates the parameter",
+			param: &ekscontrolplanev1.CACertificateParameter{Name: "/cluster.x-k8s.io/prod/ca.crt", KMSKeyID: "alias/clusters"},
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.GetParameter(&ssm.GetParameterInput{
+					Name:           aws.String("/cluster.x-k8s.io/prod/ca.crt"),
+					WithDecryption: aws.Bool(true),
+				}).Return(nil, notFound)
+				m.PutParameter(gomock.Any()).DoAndReturn(func(input *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+					g := NewWithT(t)
+					g.Expect(aws.StringValue(input.Name)).To(Equal("/cluster.x-k8s.io/prod/ca.crt"))
+					g.Expect(aws.StringValue(input.Value)).To(Equal(caCert))
+					g.Expect(aws.StringValue(input.Type)).To(Equal(ssm.ParameterTypeSecureString))
+					g.Expect(aws.StringValue(input.KeyId)).To(Equal("alias/clusters"))
+					g.Expect(input.Overwrite).To(BeNil())
+					g.Expect(input.Tags).To(ContainElement(&ssm.Tag{
+						Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/capi-name"),
+						Value: aws.String("owned"),
+					}))
+					return &ssm.PutParameterOutput{}, nil
+				})
+			},
+			expectedStatus: "/cluster.x-k8s.io/prod/ca.crt",
+		},
+		{
+			name:      "parameter is up to date",
+			param:     &ekscontrolplanev1.CACertificateParameter{Name: "/cluster.x-k8s.io/prod/ca.crt"},
+			published: "/cluster.x-k8s.io/prod/ca.crt",
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.GetParameter(gomock.Any()).Return(&ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{Value: aws.String(caCert)},
+				}, nil)
+				m.ListTagsForResource(&ssm.ListTagsForResourceInput{
+					ResourceType: aws.String(ssm.ResourceTypeForTaggingParameter),
+					ResourceId:   aws.String("/cluster.x-k8s.io/prod/ca.crt"),
+				}).Return(owned, nil)
+				m.DescribeParameters(gomock.Any()).Return(keyID("alias/aws/ssm"), nil)
+			},
+			expectedStatus: "/cluster.x-k8s.io/prod/ca.crt",
+		},
+		{
+			name:      "overwrites the parameter after CA rotation",
+			param:     &ekscontrolplanev1.CACertificateParameter{Name: "/cluster.x-k8s.io/prod/ca.crt"},
+			published: "/cluster.x-k8s.io/prod/ca.crt",
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.GetParameter(gomock.Any()).Return(&ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{Value: aws.String(oldCACert)},
+				}, nil)
+				m.ListTagsForResource(gomock.Any()).Return(owned, nil)
+				m.DescribeParameters(gomock.Any()).Return(keyID("alias/aws/ssm"), nil)
+				m.PutParameter(&ssm.PutParameterInput{
+					Name:      aws.String("/cluster.x-k8s.io/prod/ca.crt"),
+					Value:     aws.String(caCert),
+					Type:      aws.String(ssm.ParameterTypeSecureString),
+					Overwrite: aws.Bool(true),
+				}).Return(&ssm.PutParameterOutput{}, nil)
+			},
+			expectedStatus: "/cluster.x-k8s.io/prod/ca.crt",
+		},
+		{
+			name:      "overwrites the parameter when the KMS key changed",
+			param:     &ekscontrolplanev1.CACertificateParameter{Name: "/cluster.x-k8s.io/prod/ca.crt", KMSKeyID: "alias/clusters"},
+			published: "/cluster.x-k8s.io/prod/ca.crt",
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.GetParameter(gomock.Any()).Return(&ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{Value: aws.String(caCert)},
+				}, nil)
+				m.ListTagsForResource(gomock.Any()).Return(owned, nil)
+				m.DescribeParameters(gomock.Any()).Return(keyID("alias/aws/ssm"), nil)
+				m.PutParameter(&ssm.PutParameterInput{
+					Name:      aws.String("/cluster.x-k8s.io/prod/ca.crt"),
+					Value:     aws.String(caCert),
+					Type:      aws.String(ssm.ParameterTypeSecureString),
+					KeyId:     aws.String("alias/clusters"),
+					Overwrite: aws.Bool(true),
+				}).Return(&ssm.PutParameterOutput{}, nil)
+			},
+			expectedStatus: "/cluster.x-k8s.io/prod/ca.crt",
+		},
+		{
+			name:  "does not overwrite a parameter not owned by the cluster",
+			param: &ekscontrolplanev1.CACertificateParameter{Name: "/cluster.x-k8s.io/prod/ca.crt"},
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.GetParameter(gomock.Any()).Return(&ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{Value: aws.String(oldCACert)},
+				}, nil)
+				m.ListTagsForResource(gomock.Any()).Return(&ssm.ListTagsForResourceOutput{}, nil)
+			},
+			expectError: true,
+		},
+		{
+			name:      "does not delete a parameter not owned by the cluster",
+			published: "/cluster.x-k8s.io/prod/ca.crt",
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.ListTagsForResource(gomock.Any()).Return(&ssm.ListTagsForResourceOutput{}, nil)
+			},
+		},
+		{
+			name:      "deletes the parameter when no longer configured",
+			published: "/cluster.x-k8s.io/prod/ca.crt",
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.ListTagsForResource(gomock.Any()).Return(owned, nil)
+				m.DeleteParameter(&ssm.DeleteParameterInput{
+					Name: aws.String("/cluster.x-k8s.io/prod/ca.crt"),
+				}).Return(&ssm.DeleteParameterOutput{}, nil)
+			},
+		},
+		{
+			name:      "moves the parameter when renamed",
+			param:     &ekscontrolplanev1.CACertificateParameter{Name: "/cluster.x-k8s.io/prod/new-ca.crt"},
+			published: "/cluster.x-k8s.io/prod/ca.crt",
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.ListTagsForResource(gomock.Any()).Return(nil, awserr.New(ssm.ErrCodeInvalidResourceId, "not found", nil))
+				m.GetParameter(gomock.Any()).Return(nil, notFound)
+				m.PutParameter(gomock.Any()).Return(&ssm.PutParameterOutput{}, nil)
+			},
+			expectedStatus: "/cluster.x-k8s.io/prod/new-ca.crt",
+		},
+		{
+			name:  "create fails",
+			param: &ekscontrolplanev1.CACertificateParameter{Name: "/cluster.x-k8s.io/prod/ca.crt"},
+			expect: func(m *mock_ssmiface.MockSSMAPIMockRecorder) {
+				m.GetParameter(gomock.Any()).Return(nil, notFound)
+				m.PutParameter(gomock.Any()).Return(nil, awserr.New(ssm.ErrCodeParameterLimitExceeded, "limit exceeded", nil))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			ssmMock := mock_ssmiface.NewMockSSMAPI(mockControl)
+			tc.expect(ssmMock.EXPECT())
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      "capi-name",
+					},
+				},
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+						EKSClusterName:         "cluster-name",
+						CACertificateParameter: tc.param,
+					},
+					Status: ekscontrolplanev1.AWSManagedControlPlaneStatus{
+						CACertificateParameterName: tc.published,
+					},
+				},
+			})
+			g.Expect(err).To(BeNil())
+
+			s := NewService(scope)
+			s.SSMClient = ssmMock
+
+			err = s.reconcileCACertificateParameter(&eks.Cluster{
+				CertificateAuthority: &eks.Certificate{
+					Data: aws.String(base64.StdEncoding.EncodeToString([]byte(caCert))),
+				},
+			})
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(scope.ControlPlane.Status.CACertificateParameterName).To(Equal(tc.expectedStatus))
+		})
+	}
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+const (
+	// capacityBlockMarketType is the instance market type for capacity blocks.
+	capacityBlockMarketType = "capacity-block"
+	// capacityBlockReservationType is the type of the capacity reservations backing capacity blocks.
+	capacityBlockReservationType = "capacity-block"
+)
+
+// capacityReservation is the subset of an EC2 capacity reservation used to validate a capacity block.
+// The vendored AWS SDK predates capacity blocks and doesn't model the reservation type, so the
+// DescribeCapacityReservations response is unmarshaled into this type instead.
+type capacityReservation struct {
+	_ struct{} `type:"structure"`
+
+	CapacityReservationID *string `locationName:"capacityReservationId" type:"string"`
+	InstanceType          *string `locationName:"instanceType" type:"string"`
+	ReservationType       *string `locationName:"reservationType" type:"string"`
+}
+
+type describeCapacityReservationsOutput struct {
+	_ struct{} `type:"structure"`
+
+	CapacityReservations []*capacityReservation `locationName:"capacityReservationSet" locationNameList:"item" type:"list"`
+}
+
+// capacityBlockLaunchTemplateData returns the launch template data targeting the capacity block reservation.
+func capacityBlockLaunchTemplateData(reservationID string) *ec2.RequestLaunchTemplateData {
+	return &ec2.RequestLaunchTemplateData{
+		InstanceMarketOptions: &ec2.LaunchTemplateInstanceMarketOptionsRequest{
+			MarketType: aws.String(capacityBlockMarketType),
+		},
+		CapacityReservationSpecification: &ec2.LaunchTemplateCapacityReservationSpecificationRequest{
+			CapacityReservationTarget: &ec2.CapacityReservationTarget{
+				CapacityReservationId: aws.String(reservationID),
+			},
+		},
+	}
+}
+
+// describeCapacityReservation returns the capacity reservation with the given ID.
+func (s *NodegroupService) describeCapacityReservation(reservationID string) (*capacityReservation, error) {
+	req, _ := s.EC2Client.DescribeCapacityReservationsRequest(&ec2.DescribeCapacityReservationsInput{
+		CapacityReservationIds: aws.StringSlice([]string{reservationID}),
+	})
+	out := &describeCapacityReservationsOutput{}
+	req.Data = out
+	if err := req.Send(); err != nil {
+		return nil, errors.Wrapf(err, "failed to describe capacity reservation %q", reservationID)
+	}
+	if len(out.CapacityReservations) == 0 {
+		return nil, errors.Errorf("capacity reservation %q not found", reservationID)
+	}
+
+	return out.CapacityReservations[0], nil
+}
+
+// validateCapacityBlockReservation checks the reservation is a capacity block for the instance type of the nodegroup.
+func (s *NodegroupService) validateCapacityBlockReservation(reservationID, instanceType string) error {
+	reservation, err := s.describeCapacityReservation(reservationID)
+	if err != nil {
+		return err
+	}
+
+	if aws.StringValue(reservation.ReservationType) != capacityBlockReservationType {
+		return errors.Errorf("capacity reservation %q is not a capacity block", reservationID)
+	}
+	if aws.StringValue(reservation.InstanceType) != instanceType {
+		return errors.Errorf("capacity reservation %q is for instance type %q, not %q", reservationID, aws.StringValue(reservation.InstanceType), instanceType)
+	}
+
+	return nil
+}
+
+// reconcileCapacityBlockLaunchTemplate ensures the latest version of the launch template used to launch the
+// nodegroup instances targets the capacity block, and returns its specification for the nodegroup. A new
+// version is created when the capacity block reservation changes.
+func (s *NodegroupService) reconcileCapacityBlockLaunchTemplate() (*eks.LaunchTemplateSpecification, error) {
+	managedPool := s.scope.ManagedMachinePool.Spec
+	if managedPool.CapacityBlockReservationID == nil {
+		return nil, nil
+	}
+	reservationID := *managedPool.CapacityBlockReservationID
+
+	if err := s.validateCapacityBlockReservation(reservationID, aws.StringValue(managedPool.InstanceType)); err != nil {
+		return nil, err
+	}
+
+	name := s.scope.NodegroupName()
+	out, err := s.EC2Client.DescribeLaunchTemplateVersions(&ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateName: aws.String(name),
+		Versions:           aws.StringSlice([]string{expinfrav1.LaunchTemplateLatestVersion}),
+	})
+	switch {
+	case awserrors.IsNotFound(err):
+	case err != nil:
+		return nil, errors.Wrapf(err, "failed to describe launch template %q", name)
+	case len(out.LaunchTemplateVersions) > 0:
+		latest := out.LaunchTemplateVersions[0]
+		if launchTemplateReservationID(latest) == reservationID {
+			return launchTemplateSpecification(latest.LaunchTemplateId, latest.VersionNumber), nil
+		}
+
+		created, err := s.EC2Client.CreateLaunchTemplateVersion(&ec2.CreateLaunchTemplateVersionInput{
+			LaunchTemplateId:   latest.LaunchTemplateId,
+			LaunchTemplateData: capacityBlockLaunchTemplateData(reservationID),
+		})
+		if err != nil {
+			record.Warnf(s.scope.ManagedMachinePool, "FailedCreateLaunchTemplateVersion", "Failed to create capacity block launch template %q version: %v", name, err)
+			return nil, errors.Wrapf(err, "failed to create launch template %q version", name)
+		}
+		record.Eventf(s.scope.ManagedMachinePool, "SuccessfulCreateLaunchTemplateVersion", "Created capacity block launch template %q version for reservation %q", name, reservationID)
+
+		return launchTemplateSpecification(created.LaunchTemplateVersion.LaunchTemplateId, created.LaunchTemplateVersion.VersionNumber), nil
+	}
+
+	tags := ngTags(s.scope.ClusterName(), s.scope.AdditionalTags())
+	created, err := s.EC2Client.CreateLaunchTemplate(&ec2.CreateLaunchTemplateInput{
+		LaunchTemplateName: aws.String(name),
+		LaunchTemplateData: capacityBlockLaunchTemplateData(reservationID),
+		TagSpecifications: []*ec2.TagSpecification{
+			{
+				ResourceType: aws.String(ec2.ResourceTypeLaunchTemplate),
+				Tags:         converters.MapToTags(infrav1.Tags(tags)),
+			},
+		},
+	})
+	if err != nil {
+		record.Warnf(s.scope.ManagedMachinePool, "FailedCreateLaunchTemplate", "Failed to create capacity block launch template %q: %v", name, err)
+		return nil, errors.Wrapf(err, "failed to create launch template %q", name)
+	}
+	record.Eventf(s.scope.ManagedMachinePool, "SuccessfulCreateLaunchTemplate", "Created capacity block launch template %q", name)
+
+	return launchTemplateSpecification(created.LaunchTemplate.LaunchTemplateId, created.LaunchTemplate.LatestVersionNumber), nil
+}
+
+// capacityBlockLaunchTemplateUpdate returns the latest version of the capacity block launch template when
+// the nodegroup doesn't use it yet, e.g. after the capacity block reservation changed.
+func (s *NodegroupService) capacityBlockLaunchTemplateUpdate(ng *eks.Nodegroup) (*eks.LaunchTemplateSpecification, error) {
+	launchTemplate, err := s.reconcileCapacityBlockLaunchTemplate()
+	if err != nil || launchTemplate == nil {
+		return nil, err
+	}
+	if ng.LaunchTemplate != nil && aws.StringValue(ng.LaunchTemplate.Version) == aws.StringValue(launchTemplate.Version) {
+		return nil, nil
+	}
+
+	return launchTemplate, nil
+}
+
+// launchTemplateReservationID returns the ID of the capacity reservation targeted by the launch template version.
+func launchTemplateReservationID(version *ec2.LaunchTemplateVersion) string {
+	if version.LaunchTemplateData == nil || version.LaunchTemplateData.CapacityReservationSpecification == nil || version.LaunchTemplateData.CapacityReservationSpecification.CapacityReservationTarget == nil {
+		return ""
+	}
+	return aws.StringValue(version.LaunchTemplateData.CapacityReservationSpecification.CapacityReservationTarget.CapacityReservationId)
+}
+
+func launchTemplateSpecification(id *string, version *int64) *eks.LaunchTemplateSpecification {
+	return &eks.LaunchTemplateSpecification{
+		Id:      id,
+		Version: aws.String(strconv.FormatInt(aws.Int64Value(version), 10)),
+	}
+}
+
+// deleteCapacityBlockLaunchTemplate deletes the launch template created for the capacity block.
+func (s *NodegroupService) deleteCapacityBlockLaunchTemplate() error {
+	if s.scope.ManagedMachinePool.Spec.CapacityBlockReservationID == nil {
+		return nil
+	}
+
+	name := s.scope.NodegroupName()
+	if _, err := s.EC2Client.DeleteLaunchTemplate(&ec2.DeleteLaunchTemplateInput{
+		LaunchTemplateName: aws.String(name),
+	}); err != nil && !awserrors.IsNotFound(err) {
+		record.Warnf(s.scope.ManagedMachinePool, "FailedDeleteLaunchTemplate", "Failed to delete capacity block launch template %q: %v", name, err)
+		return errors.Wrapf(err, "failed to delete launch template %q", name)
+	}
+
+	return nil
+}
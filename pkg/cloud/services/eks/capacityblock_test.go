@@ -0,0 +1,222 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+)
+
+func TestCapacityBlockLaunchTemplateData(t *testing.T) {
+	g := NewWithT(t)
+
+	data := capacityBlockLaunchTemplateData("cr-0123456789abcdef0")
+	g.Expect(aws.StringValue(data.InstanceMarketOptions.MarketType)).To(Equal("capacity-block"))
+	g.Expect(aws.StringValue(data.CapacityReservationSpecification.CapacityReservationTarget.CapacityReservationId)).To(Equal("cr-0123456789abcdef0"))
+}
+
+// capacityReservationsRequest returns a DescribeCapacityReservations request answered with the given
+// capacity reservations by a test server, as the reservation type isn't modeled by the AWS SDK.
+func capacityReservationsRequest(t *testing.T, status int, reservations string) func(*ec2.DescribeCapacityReservationsInput) (*request.Request, *ec2.DescribeCapacityReservationsOutput) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		if status != http.StatusOK {
+			fmt.Fprint(w, `<Response><Errors><Error><Code>InvalidCapacityReservationId.NotFound</Code><Message>not found</Message></Error></Errors><RequestID>1</RequestID></Response>`)
+			return
+		}
+		fmt.Fprintf(w, `<DescribeCapacityReservationsResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/"><requestId>1</requestId><capacityReservationSet>%s</capacityReservationSet></DescribeCapacityReservationsResponse>`, reservations)
+	}))
+	t.Cleanup(server.Close)
+
+	sess, err := session.NewSession(&aws.Config{
+		Region:      aws.String("us-east-1"),
+		Endpoint:    aws.String(server.URL),
+		Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+		MaxRetries:  aws.Int(0),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := ec2.New(sess)
+
+	return client.DescribeCapacityReservationsRequest
+}
+
+func capacityReservationItem(instanceType, reservationType string) string {
+	return fmt.Sprintf(`<item><capacityReservationId>cr-0123456789abcdef0</capacityReservationId><instanceType>%s</instanceType><instanceMatchCriteria>targeted</instanceMatchCriteria><endDateType>limited</endDateType><reservationType>%s</reservationType></item>`, instanceType, reservationType)
+}
+
+func TestValidateCapacityBlockReservation(t *testing.T) {
+	reservationID := "cr-0123456789abcdef0"
+
+	tests := []struct {
+		name         string
+		status       int
+		reservations string
+		expectError  bool
+	}{
+		{
+			name:         "capacity block for the instance type is valid",
+			status:       http.StatusOK,
+			reservations: capacityReservationItem("p5.48xlarge", "capacity-block"),
+		},
+		{
+			name:         "capacity block for another instance type is invalid",
+			status:       http.StatusOK,
+			reservations: capacityReservationItem("p4d.24xlarge", "capacity-block"),
+			expectError:  true,
+		},
+		{
+			name:         "targeted time limited on-demand reservation is not a capacity block",
+			status:       http.StatusOK,
+			reservations: capacityReservationItem("p5.48xlarge", "default"),
+			expectError:  true,
+		},
+		{
+			name:        "missing reservation is invalid",
+			status:      http.StatusOK,
+			expectError: true,
+		},
+		{
+			name:        "describe failure is returned",
+			status:      http.StatusBadRequest,
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			ec2Mock.EXPECT().DescribeCapacityReservationsRequest(&ec2.DescribeCapacityReservationsInput{
+				CapacityReservationIds: aws.StringSlice([]string{reservationID}),
+			}).DoAndReturn(capacityReservationsRequest(t, tc.status, tc.reservations))
+
+			s := &NodegroupService{EC2Client: ec2Mock}
+			err := s.validateCapacityBlockReservation(reservationID, "p5.48xlarge")
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func TestReconcileCapacityBlockLaunchTemplate(t *testing.T) {
+	latestVersion := func(reservationID string) *ec2.DescribeLaunchTemplateVersionsOutput {
+		return &ec2.DescribeLaunchTemplateVersionsOutput{
+			LaunchTemplateVersions: []*ec2.LaunchTemplateVersion{
+				{
+					LaunchTemplateId: aws.String("lt-1"),
+					VersionNumber:    aws.Int64(1),
+					LaunchTemplateData: &ec2.ResponseLaunchTemplateData{
+						CapacityReservationSpecification: &ec2.LaunchTemplateCapacityReservationSpecificationResponse{
+							CapacityReservationTarget: &ec2.CapacityReservationTargetResponse{
+								CapacityReservationId: aws.String(reservationID),
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		expect        func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		expectVersion string
+	}{
+		{
+			name: "reuses the latest version targeting the reservation",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeLaunchTemplateVersions(gomock.Any()).Return(latestVersion("cr-0123456789abcdef0"), nil)
+			},
+			expectVersion: "1",
+		},
+		{
+			name: "creates a new version when the reservation changed",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeLaunchTemplateVersions(gomock.Any()).Return(latestVersion("cr-0123456789abcdef1"), nil)
+				m.CreateLaunchTemplateVersion(&ec2.CreateLaunchTemplateVersionInput{
+					LaunchTemplateId:   aws.String("lt-1"),
+					LaunchTemplateData: capacityBlockLaunchTemplateData("cr-0123456789abcdef0"),
+				}).Return(&ec2.CreateLaunchTemplateVersionOutput{
+					LaunchTemplateVersion: &ec2.LaunchTemplateVersion{
+						LaunchTemplateId: aws.String("lt-1"),
+						VersionNumber:    aws.Int64(2),
+					},
+				}, nil)
+			},
+			expectVersion: "2",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			ec2Mock.EXPECT().DescribeCapacityReservationsRequest(gomock.Any()).
+				DoAndReturn(capacityReservationsRequest(t, http.StatusOK, capacityReservationItem("p5.48xlarge", "capacity-block")))
+			tc.expect(ec2Mock.EXPECT())
+
+			capacityType := expinfrav1.ManagedMachinePoolCapacityTypeCapacityBlock
+			s := &NodegroupService{
+				scope: &scope.ManagedMachinePoolScope{
+					Logger: logr.Discard(),
+					ManagedMachinePool: &expinfrav1.AWSManagedMachinePool{
+						Spec: expinfrav1.AWSManagedMachinePoolSpec{
+							EKSNodegroupName:           "nodegroup",
+							InstanceType:               aws.String("p5.48xlarge"),
+							CapacityType:               &capacityType,
+							CapacityBlockReservationID: aws.String("cr-0123456789abcdef0"),
+						},
+					},
+				},
+				EC2Client: ec2Mock,
+			}
+
+			launchTemplate, err := s.reconcileCapacityBlockLaunchTemplate()
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(aws.StringValue(launchTemplate.Id)).To(Equal("lt-1"))
+			g.Expect(aws.StringValue(launchTemplate.Version)).To(Equal(tc.expectVersion))
+		})
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -106,6 +107,10 @@ func (s *Service) reconcileCluster(ctx context.Context) error {
 		return errors.Wrap(err, "failed reconciling additional kubeconfigs")
 	}
 
+	if err := s.reconcileCACertificateParameter(cluster); err != nil {
+		return errors.Wrap(err, "failed reconciling CA certificate parameter")
+	}
+
 	if err := s.reconcileClusterVersion(cluster); err != nil {
 		return errors.Wrap(err, "failed reconciling cluster version")
 	}
@@ -239,7 +244,15 @@ func makeEksEncryptionConfigs(encryptionConfig *ekscontrolplanev1.EncryptionConf
 	})
 }
 
-func makeKubernetesNetworkConfig(serviceCidrs *clusterv1.NetworkRanges) (*eks.KubernetesNetworkConfigRequest, error) {
+func makeKubernetesNetworkConfig(ipFamily ekscontrolplanev1.EKSIPFamily, serviceCidrs *clusterv1.NetworkRanges) (*eks.KubernetesNetworkConfigRequest, error) {
+	if ipFamily == ekscontrolplanev1.EKSIPFamilyIPv6 {
+		// EKS assigns IPv6 service addresses from the unique local address range,
+		// a service CIDR can't be requested.
+		return &eks.KubernetesNetworkConfigRequest{
+			IpFamily: aws.String(eks.IpFamilyIpv6),
+		}, nil
+	}
+
 	if serviceCidrs == nil || len(serviceCidrs.CIDRBlocks) == 0 {
 		return nil, nil
 	}
@@ -258,8 +271,32 @@ func makeKubernetesNetworkConfig(serviceCidrs *clusterv1.NetworkRanges) (*eks.Ku
 	}, nil
 }
 
+// validateIPv6VPC checks the VPC of the cluster has an IPv6 CIDR block, which EKS requires to create ipv6 clusters.
+func (s *Service) validateIPv6VPC() error {
+	vpcID := s.scope.VPC().ID
+	out, err := s.EC2Client.DescribeVpcs(&ec2.DescribeVpcsInput{
+		VpcIds: aws.StringSlice([]string{vpcID}),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe vpc %q", vpcID)
+	}
+
+	for _, vpc := range out.Vpcs {
+		for _, association := range vpc.Ipv6CidrBlockAssociationSet {
+			if association.Ipv6CidrBlockState != nil && aws.StringValue(association.Ipv6CidrBlockState.State) == ec2.VpcCidrBlockStateCodeAssociated {
+				return nil
+			}
+		}
+	}
+
+	record.Warnf(s.scope.ControlPlane, "FailedCreateEKSControlPlane", "VPC %q has no IPv6 CIDR block, which is required for ipv6 clusters", vpcID)
+	return errors.Errorf("vpc %q has no associated IPv6 CIDR block, which is required for ipv6 clusters", vpcID)
+}
+
 func makeVpcConfig(subnets infrav1.Subnets, endpointAccess ekscontrolplanev1.EndpointAccess, securityGroups map[infrav1.SecurityGroupRole]infrav1.SecurityGroup) (*eks.VpcConfigRequest, error) {
 	// TODO: Do we need to just add the private subnets?
+	// Database subnets are isolated and reserved for databases.
+	subnets = subnets.ExcludeTier(infrav1.SubnetTierDatabase)
 	if len(subnets) < 2 {
 		return nil, awserrors.NewFailedDependency("at least 2 subnets is required")
 	}
@@ -353,7 +390,13 @@ func (s *Service) createCluster(eksClusterName string) (*eks.Cluster, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't create vpc config for cluster")
 	}
-	netConfig, err := makeKubernetesNetworkConfig(s.scope.ServiceCidrs())
+	ipFamily := s.scope.ControlPlane.Spec.KubernetesNetworkConfig.GetIPFamily()
+	if ipFamily == ekscontrolplanev1.EKSIPFamilyIPv6 {
+		if err := s.validateIPv6VPC(); err != nil {
+			return nil, err
+		}
+	}
+	netConfig, err := makeKubernetesNetworkConfig(ipFamily, s.scope.ServiceCidrs())
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't create Kubernetes network config for cluster")
 	}
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/golang/mock/gomock"
@@ -34,6 +35,7 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/eks/mock_eksiface"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/iamauth/mock_iamauth"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -179,6 +181,35 @@ func TestMakeVPCConfig(t *testing.T) {
 				SubnetIds: []*string{&idOne, &idTwo},
 			},
 		},
+		{
+			name: "database subnets are left out",
+			input: input{
+				subnets: []infrav1.SubnetSpec{
+					{
+						ID:               idOne,
+						CidrBlock:        "10.0.10.0/24",
+						AvailabilityZone: "us-west-2a",
+						Tier:             infrav1.SubnetTierNode,
+					},
+					{
+						ID:               idTwo,
+						CidrBlock:        "10.0.11.0/24",
+						AvailabilityZone: "us-west-2b",
+						Tier:             infrav1.SubnetTierNode,
+					},
+					{
+						ID:               "three",
+						CidrBlock:        "10.0.12.0/24",
+						AvailabilityZone: "us-west-2a",
+						Tier:             infrav1.SubnetTierDatabase,
+					},
+				},
+				endpointAccess: ekscontrolplanev1.EndpointAccess{},
+			},
+			expect: &eks.VpcConfigRequest{
+				SubnetIds: []*string{&idOne, &idTwo},
+			},
+		},
 		{
 			name: "security groups",
 			input: input{
@@ -642,3 +673,134 @@ func TestReconcileEKSEncryptionConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestMakeKubernetesNetworkConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		ipFamily     ekscontrolplanev1.EKSIPFamily
+		serviceCidrs *clusterv1.NetworkRanges
+		expect       *eks.KubernetesNetworkConfigRequest
+	}{
+		{
+			name:     "ipv4 without service cidrs",
+			ipFamily: ekscontrolplanev1.EKSIPFamilyIPv4,
+			expect:   nil,
+		},
+		{
+			name:         "ipv4 with service cidrs",
+			ipFamily:     ekscontrolplanev1.EKSIPFamilyIPv4,
+			serviceCidrs: &clusterv1.NetworkRanges{CIDRBlocks: []string{"fd00::/108", "172.20.0.0/16"}},
+			expect: &eks.KubernetesNetworkConfigRequest{
+				ServiceIpv4Cidr: aws.String("172.20.0.0/16"),
+			},
+		},
+		{
+			name:         "ipv6 ignores service cidrs",
+			ipFamily:     ekscontrolplanev1.EKSIPFamilyIPv6,
+			serviceCidrs: &clusterv1.NetworkRanges{CIDRBlocks: []string{"172.20.0.0/16"}},
+			expect: &eks.KubernetesNetworkConfigRequest{
+				IpFamily: aws.String(eks.IpFamilyIpv6),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			config, err := makeKubernetesNetworkConfig(tc.ipFamily, tc.serviceCidrs)
+			g.Expect(err).To(BeNil())
+			g.Expect(config).To(Equal(tc.expect))
+		})
+	}
+}
+
+func TestValidateIPv6VPC(t *testing.T) {
+	associatedVPC := func(state string) *ec2.DescribeVpcsOutput {
+		return &ec2.DescribeVpcsOutput{
+			Vpcs: []*ec2.Vpc{
+				{
+					VpcId: aws.String("vpc-123"),
+					Ipv6CidrBlockAssociationSet: []*ec2.VpcIpv6CidrBlockAssociation{
+						{
+							Ipv6CidrBlock:      aws.String("2001:db8::/56"),
+							Ipv6CidrBlockState: &ec2.VpcCidrBlockState{State: aws.String(state)},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		output      *ec2.DescribeVpcsOutput
+		describeErr error
+		expectError bool
+	}{
+		{
+			name:   "vpc with associated ipv6 cidr block",
+			output: associatedVPC(ec2.VpcCidrBlockStateCodeAssociated),
+		},
+		{
+			name:        "vpc with disassociated ipv6 cidr block",
+			output:      associatedVPC(ec2.VpcCidrBlockStateCodeDisassociated),
+			expectError: true,
+		},
+		{
+			name:        "vpc without ipv6 cidr block",
+			output:      &ec2.DescribeVpcsOutput{Vpcs: []*ec2.Vpc{{VpcId: aws.String("vpc-123")}}},
+			expectError: true,
+		},
+		{
+			name:        "describe failure",
+			describeErr: errors.New("boom"),
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockControl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      "capi-name",
+					},
+				},
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+						EKSClusterName: "cluster.default",
+						NetworkSpec:    infrav1.NetworkSpec{VPC: infrav1.VPCSpec{ID: "vpc-123"}},
+					},
+				},
+			})
+			g.Expect(err).To(BeNil())
+
+			ec2Mock.EXPECT().DescribeVpcs(&ec2.DescribeVpcsInput{
+				VpcIds: aws.StringSlice([]string{"vpc-123"}),
+			}).Return(tc.output, tc.describeErr)
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = s.validateIPv6VPC()
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).To(BeNil())
+		})
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -84,8 +85,8 @@ func (s *Service) reconcileAdditionalKubeconfigs(ctx context.Context, cluster *e
 		Namespace: s.scope.Cluster.Namespace,
 	}
 
-	// Create the additional kubeconfig for users. This doesn't need updating on every sync
-	_, err := secret.GetFromNamespacedName(ctx, s.scope.Client, clusterRef, secret.Kubeconfig)
+	// Create the additional kubeconfig for users. Only the exec plugin needs updating afterwards.
+	configSecret, err := secret.GetFromNamespacedName(ctx, s.scope.Client, clusterRef, secret.Kubeconfig)
 	if err != nil {
 		if !apierrors.IsNotFound(err) {
 			return errors.Wrap(err, "failed to get kubeconfig (user) secret")
@@ -99,6 +100,8 @@ func (s *Service) reconcileAdditionalKubeconfigs(ctx context.Context, cluster *e
 		if createErr != nil {
 			return err
 		}
+	} else if updateErr := s.updateUserKubeconfigSecret(ctx, configSecret); updateErr != nil {
+		return fmt.Errorf("updating user kubeconfig secret: %w", updateErr)
 	}
 
 	return nil
@@ -187,6 +190,81 @@ func (s *Service) createUserKubeconfigSecret(ctx context.Context, cluster *eks.C
 		return fmt.Errorf("creating base kubeconfig: %w", err)
 	}
 
+	execConfig, err := s.userExecConfig(clusterName)
+	if err != nil {
+		return err
+	}
+	cfg.AuthInfos = map[string]*api.AuthInfo{
+		userName: {
+			Exec: execConfig,
+		},
+	}
+
+	out, err := clientcmd.Write(*cfg)
+	if err != nil {
+		return errors.Wrap(err, "failed to serialize config to yaml")
+	}
+
+	kubeconfigSecret := kubeconfig.GenerateSecretWithOwner(*clusterRef, out, controllerOwnerRef)
+	if err := s.scope.Client.Create(ctx, kubeconfigSecret); err != nil {
+		return errors.Wrap(err, "failed to create kubeconfig secret")
+	}
+
+	record.Eventf(s.scope.ControlPlane, "SucessfulCreateUserKubeconfig", "Created user kubeconfig for cluster %q", s.scope.Name())
+	return nil
+}
+
+// updateUserKubeconfigSecret updates the exec plugin of the user kubeconfig when it no longer
+// matches the token method and KubeconfigExec of the control plane.
+func (s *Service) updateUserKubeconfigSecret(ctx context.Context, configSecret *corev1.Secret) error {
+	data, ok := configSecret.Data[secret.KubeconfigDataName]
+	if !ok {
+		return errors.Errorf("missing key %q in secret data", secret.KubeconfigDataName)
+	}
+
+	config, err := clientcmd.Load(data)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert kubeconfig Secret into a clientcmdapi.Config")
+	}
+
+	clusterName := s.scope.KubernetesClusterName()
+	execConfig, err := s.userExecConfig(clusterName)
+	if err != nil {
+		return err
+	}
+
+	userName := s.getKubeConfigUserName(clusterName, true)
+	authInfo, ok := config.AuthInfos[userName]
+	if !ok {
+		return errors.Errorf("missing user %q in kubeconfig", userName)
+	}
+	if authInfo.Exec != nil &&
+		authInfo.Exec.APIVersion == execConfig.APIVersion &&
+		authInfo.Exec.Command == execConfig.Command &&
+		equality.Semantic.DeepEqual(authInfo.Exec.Args, execConfig.Args) &&
+		equality.Semantic.DeepEqual(authInfo.Exec.Env, execConfig.Env) {
+		return nil
+	}
+
+	s.scope.V(2).Info("Updating EKS user kubeconfig for cluster", "cluster-name", clusterName)
+	authInfo.Exec = execConfig
+
+	out, err := clientcmd.Write(*config)
+	if err != nil {
+		return errors.Wrap(err, "failed to serialize config to yaml")
+	}
+
+	configSecret.Data[secret.KubeconfigDataName] = out
+	if err := s.scope.Client.Update(ctx, configSecret); err != nil {
+		return errors.Wrap(err, "failed to update user kubeconfig secret")
+	}
+
+	record.Eventf(s.scope.ControlPlane, "SuccessfulUpdateUserKubeconfig", "Updated user kubeconfig for cluster %q", s.scope.Name())
+	return nil
+}
+
+// userExecConfig returns the exec plugin of the user kubeconfig for the token method of the cluster.
+func (s *Service) userExecConfig(clusterName string) (*api.ExecConfig, error) {
 	execConfig := &api.ExecConfig{APIVersion: "client.authentication.k8s.io/v1alpha1"}
 	switch s.scope.TokenMethod() {
 	case ekscontrolplanev1.EKSTokenMethodIAMAuthenticator:
@@ -205,26 +283,17 @@ func (s *Service) createUserKubeconfigSecret(ctx context.Context, cluster *eks.C
 			clusterName,
 		}
 	default:
-		return fmt.Errorf("using token method %s: %w", s.scope.TokenMethod(), ErrUnknownTokenMethod)
-	}
-	cfg.AuthInfos = map[string]*api.AuthInfo{
-		userName: {
-			Exec: execConfig,
-		},
+		return nil, fmt.Errorf("using token method %s: %w", s.scope.TokenMethod(), ErrUnknownTokenMethod)
 	}
 
-	out, err := clientcmd.Write(*cfg)
-	if err != nil {
-		return errors.Wrap(err, "failed to serialize config to yaml")
-	}
-
-	kubeconfigSecret := kubeconfig.GenerateSecretWithOwner(*clusterRef, out, controllerOwnerRef)
-	if err := s.scope.Client.Create(ctx, kubeconfigSecret); err != nil {
-		return errors.Wrap(err, "failed to create kubeconfig secret")
+	if exec := s.scope.ControlPlane.Spec.KubeconfigExec; exec != nil {
+		execConfig.Args = append(execConfig.Args, exec.AdditionalArgs...)
+		if exec.Profile != "" {
+			execConfig.Env = []api.ExecEnvVar{{Name: "AWS_PROFILE", Value: exec.Profile}}
+		}
 	}
 
-	record.Eventf(s.scope.ControlPlane, "SucessfulCreateUserKubeconfig", "Created user kubeconfig for cluster %q", s.scope.Name())
-	return nil
+	return execConfig, nil
 }
 
 func (s *Service) createBaseKubeConfig(cluster *eks.Cluster, userName string) (*api.Config, error) {
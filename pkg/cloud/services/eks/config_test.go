@@ -0,0 +1,217 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/secret"
+)
+
+func TestCreateUserKubeconfigSecret(t *testing.T) {
+	iamAuthenticator := ekscontrolplanev1.EKSTokenMethodIAMAuthenticator
+	awsCli := ekscontrolplanev1.EKSTokenMethodAWSCli
+
+	tests := []struct {
+		name         string
+		tokenMethod  *ekscontrolplanev1.EKSTokenMethod
+		exec         *ekscontrolplanev1.KubeconfigExec
+		expectedExec *api.ExecConfig
+	}{
+		{
+			name:        "iam-authenticator",
+			tokenMethod: &iamAuthenticator,
+			expectedExec: &api.ExecConfig{
+				APIVersion: "client.authentication.k8s.io/v1alpha1",
+				Command:    "aws-iam-authenticator",
+				Args:       []string{"token", "-i", "cluster-name"},
+			},
+		},
+		{
+			name:        "iam-authenticator with profile and additional args",
+			tokenMethod: &iamAuthenticator,
+			exec: &ekscontrolplanev1.KubeconfigExec{
+				Profile:        "platform",
+				AdditionalArgs: []string{"--role", "arn:aws:iam::123456789012:role/cluster-admins"},
+			},
+			expectedExec: &api.ExecConfig{
+				APIVersion: "client.authentication.k8s.io/v1alpha1",
+				Command:    "aws-iam-authenticator",
+				Args:       []string{"token", "-i", "cluster-name", "--role", "arn:aws:iam::123456789012:role/cluster-admins"},
+				Env:        []api.ExecEnvVar{{Name: "AWS_PROFILE", Value: "platform"}},
+			},
+		},
+		{
+			name:        "aws-cli",
+			tokenMethod: &awsCli,
+			expectedExec: &api.ExecConfig{
+				APIVersion: "client.authentication.k8s.io/v1alpha1",
+				Command:    "aws",
+				Args:       []string{"eks", "get-token", "--cluster-name", "cluster-name"},
+			},
+		},
+		{
+			name:        "aws-cli with profile and additional args",
+			tokenMethod: &awsCli,
+			exec: &ekscontrolplanev1.KubeconfigExec{
+				Profile:        "platform",
+				AdditionalArgs: []string{"--role-arn", "arn:aws:iam::123456789012:role/cluster-admins"},
+			},
+			expectedExec: &api.ExecConfig{
+				APIVersion: "client.authentication.k8s.io/v1alpha1",
+				Command:    "aws",
+				Args:       []string{"eks", "get-token", "--cluster-name", "cluster-name", "--role-arn", "arn:aws:iam::123456789012:role/cluster-admins"},
+				Env:        []api.ExecEnvVar{{Name: "AWS_PROFILE", Value: "platform"}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			_ = clusterv1.AddToScheme(scheme)
+			_ = corev1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      "capi-name",
+					},
+				},
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      "capi-name-control-plane",
+					},
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+						EKSClusterName: "cluster-name",
+						TokenMethod:    tc.tokenMethod,
+						KubeconfigExec: tc.exec,
+					},
+				},
+			})
+			g.Expect(err).To(BeNil())
+
+			s := NewService(scope)
+			cluster := &eks.Cluster{
+				Name:     aws.String("cluster-name"),
+				Endpoint: aws.String("https://F00BA4.gr4.us-east-1.eks.amazonaws.com"),
+				CertificateAuthority: &eks.Certificate{
+					Data: aws.String(base64.StdEncoding.EncodeToString([]byte("ca-data"))),
+				},
+			}
+			clusterRef := types.NamespacedName{Namespace: "ns", Name: "capi-name-user"}
+
+			g.Expect(s.createUserKubeconfigSecret(context.TODO(), cluster, &clusterRef)).To(Succeed())
+
+			kubeconfigSecret, err := secret.GetFromNamespacedName(context.TODO(), client, clusterRef, secret.Kubeconfig)
+			g.Expect(err).NotTo(HaveOccurred())
+			cfg, err := clientcmd.Load(kubeconfigSecret.Data[secret.KubeconfigDataName])
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(cfg.AuthInfos).To(HaveKey("cluster-name-user"))
+
+			exec := cfg.AuthInfos["cluster-name-user"].Exec
+			g.Expect(exec).NotTo(BeNil())
+			g.Expect(exec.APIVersion).To(Equal(tc.expectedExec.APIVersion))
+			g.Expect(exec.Command).To(Equal(tc.expectedExec.Command))
+			g.Expect(exec.Args).To(Equal(tc.expectedExec.Args))
+			g.Expect(exec.Env).To(Equal(tc.expectedExec.Env))
+		})
+	}
+}
+
+func TestUpdateUserKubeconfigSecret(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	_ = ekscontrolplanev1.AddToScheme(scheme)
+	_ = clusterv1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "capi-name-control-plane",
+		},
+		Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+			EKSClusterName: "cluster-name",
+		},
+	}
+	scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "capi-name",
+			},
+		},
+		ControlPlane: controlPlane,
+	})
+	g.Expect(err).To(BeNil())
+
+	s := NewService(scope)
+	cluster := &eks.Cluster{
+		Name:     aws.String("cluster-name"),
+		Endpoint: aws.String("https://F00BA4.gr4.us-east-1.eks.amazonaws.com"),
+		CertificateAuthority: &eks.Certificate{
+			Data: aws.String(base64.StdEncoding.EncodeToString([]byte("ca-data"))),
+		},
+	}
+	g.Expect(s.reconcileAdditionalKubeconfigs(context.TODO(), cluster)).To(Succeed())
+
+	controlPlane.Spec.KubeconfigExec = &ekscontrolplanev1.KubeconfigExec{
+		Profile:        "dev",
+		AdditionalArgs: []string{"--region", "us-east-1"},
+	}
+	g.Expect(s.reconcileAdditionalKubeconfigs(context.TODO(), cluster)).To(Succeed())
+
+	clusterRef := types.NamespacedName{Namespace: "ns", Name: "capi-name-user"}
+	kubeconfigSecret, err := secret.GetFromNamespacedName(context.TODO(), client, clusterRef, secret.Kubeconfig)
+	g.Expect(err).NotTo(HaveOccurred())
+	cfg, err := clientcmd.Load(kubeconfigSecret.Data[secret.KubeconfigDataName])
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cfg.AuthInfos).To(HaveKey("cluster-name-user"))
+
+	exec := cfg.AuthInfos["cluster-name-user"].Exec
+	g.Expect(exec).NotTo(BeNil())
+	g.Expect(exec.Args).To(Equal([]string{"token", "-i", "cluster-name", "--region", "us-east-1"}))
+	g.Expect(exec.Env).To(Equal([]api.ExecEnvVar{{Name: "AWS_PROFILE", Value: "dev"}}))
+}
@@ -61,6 +61,11 @@ func (s *Service) ReconcileControlPlane(ctx context.Context) error {
 	}
 	conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSIdentityProviderConfiguredCondition)
 
+	// Operator access identity provider and roles
+	if err := s.reconcileOperatorAccess(); err != nil {
+		return errors.Wrap(err, "failed reconciling operator access")
+	}
+
 	s.scope.V(2).Info("Reconcile EKS control plane completed successfully")
 	return nil
 }
@@ -74,6 +79,11 @@ func (s *Service) DeleteControlPlane() (err error) {
 		return err
 	}
 
+	// CA certificate SSM parameter
+	if err := s.deleteCACertificateParameter(); err != nil {
+		return err
+	}
+
 	// Control Plane IAM role
 	if err := s.deleteControlPlaneIAMRole(); err != nil {
 		return err
@@ -84,6 +94,11 @@ func (s *Service) DeleteControlPlane() (err error) {
 		return err
 	}
 
+	// Operator access identity provider and roles
+	if err := s.deleteOperatorAccess(); err != nil {
+		return err
+	}
+
 	s.scope.V(2).Info("Delete EKS control plane completed successfully")
 	return nil
 }
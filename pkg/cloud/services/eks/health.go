@@ -0,0 +1,142 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/eks"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/feature"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// ReconcileHealthSummary computes the health summary of the cluster and reports it in the
+// status and conditions of the control plane.
+func (s *Service) ReconcileHealthSummary(ctx context.Context) error {
+	s.scope.V(2).Info("Reconciling health summary")
+
+	controlPlane := s.scope.ControlPlane
+
+	reachable := true
+	if err := s.checkControlPlaneReachable(ctx); err != nil {
+		reachable = false
+		conditions.MarkFalse(controlPlane, ekscontrolplanev1.ControlPlaneReachableCondition, ekscontrolplanev1.ControlPlaneUnreachableReason, clusterv1.ConditionSeverityWarning, err.Error())
+	} else {
+		conditions.MarkTrue(controlPlane, ekscontrolplanev1.ControlPlaneReachableCondition)
+	}
+
+	addonsHealthy := true
+	if unhealthy := unhealthyAddons(controlPlane.Status.Addons); len(unhealthy) > 0 {
+		addonsHealthy = false
+		conditions.MarkFalse(controlPlane, ekscontrolplanev1.AddonsHealthyCondition, ekscontrolplanev1.AddonsUnhealthyReason, clusterv1.ConditionSeverityWarning, "addons not active: %v", unhealthy)
+	} else {
+		conditions.MarkTrue(controlPlane, ekscontrolplanev1.AddonsHealthyCondition)
+	}
+
+	unhealthyNodeGroups, err := s.unhealthyNodeGroups(ctx)
+	if err != nil {
+		return err
+	}
+	nodeGroupsHealthy := true
+	if len(unhealthyNodeGroups) > 0 {
+		nodeGroupsHealthy = false
+		conditions.MarkFalse(controlPlane, ekscontrolplanev1.NodeGroupsHealthyCondition, ekscontrolplanev1.NodeGroupsUnhealthyReason, clusterv1.ConditionSeverityWarning, "node groups not ready: %v", unhealthyNodeGroups)
+	} else {
+		conditions.MarkTrue(controlPlane, ekscontrolplanev1.NodeGroupsHealthyCondition)
+	}
+
+	controlPlane.Status.Health = computeHealthSummary(reachable, addonsHealthy, nodeGroupsHealthy)
+
+	return nil
+}
+
+// computeHealthSummary rolls up the individual health checks. The cluster is unhealthy when
+// its control plane can't be reached, as nothing else can be trusted then, and degraded when
+// any of the other checks fails.
+func computeHealthSummary(controlPlaneReachable, addonsHealthy, nodeGroupsHealthy bool) *ekscontrolplanev1.HealthSummary {
+	summary := &ekscontrolplanev1.HealthSummary{
+		ControlPlaneReachable: controlPlaneReachable,
+		AddonsHealthy:         addonsHealthy,
+		NodeGroupsHealthy:     nodeGroupsHealthy,
+	}
+
+	switch {
+	case !controlPlaneReachable:
+		summary.Overall = ekscontrolplanev1.HealthStateUnhealthy
+	case !addonsHealthy || !nodeGroupsHealthy:
+		summary.Overall = ekscontrolplanev1.HealthStateDegraded
+	default:
+		summary.Overall = ekscontrolplanev1.HealthStateHealthy
+	}
+
+	return summary
+}
+
+func (s *Service) checkControlPlaneReachable(ctx context.Context) error {
+	remoteClient, err := s.scope.RemoteClient()
+	if err != nil {
+		return fmt.Errorf("getting client for remote cluster: %w", err)
+	}
+
+	namespace := &corev1.Namespace{}
+	if err := remoteClient.Get(ctx, client.ObjectKey{Name: "kube-system"}, namespace); err != nil {
+		return fmt.Errorf("getting kube-system namespace: %w", err)
+	}
+
+	return nil
+}
+
+// unhealthyAddons returns the names of the addons that aren't active.
+func unhealthyAddons(addons []ekscontrolplanev1.AddonState) []string {
+	unhealthy := []string{}
+	for _, addon := range addons {
+		if addon.Status == nil || *addon.Status != eks.AddonStatusActive {
+			unhealthy = append(unhealthy, addon.Name)
+		}
+	}
+	return unhealthy
+}
+
+// unhealthyNodeGroups returns the names of the managed machine pools of the cluster that aren't ready.
+func (s *Service) unhealthyNodeGroups(ctx context.Context) ([]string, error) {
+	unhealthy := []string{}
+	if !feature.Gates.Enabled(feature.MachinePool) {
+		return unhealthy, nil
+	}
+
+	managedMachinePools := &expinfrav1.AWSManagedMachinePoolList{}
+	if err := s.scope.Client.List(ctx, managedMachinePools,
+		client.InNamespace(s.scope.Namespace()),
+		client.MatchingLabels{clusterv1.ClusterLabelName: s.scope.Name()},
+	); err != nil {
+		return nil, fmt.Errorf("failed to list managed machine pools for cluster %s/%s: %w", s.scope.Namespace(), s.scope.Name(), err)
+	}
+
+	for _, pool := range managedMachinePools.Items {
+		if !pool.Status.Ready {
+			unhealthy = append(unhealthy, pool.Name)
+		}
+	}
+	return unhealthy, nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/gomega"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
+)
+
+func TestComputeHealthSummary(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		controlPlaneReachable bool
+		addonsHealthy         bool
+		nodeGroupsHealthy     bool
+		expect                ekscontrolplanev1.HealthState
+	}{
+		{
+			name:                  "all checks pass",
+			controlPlaneReachable: true,
+			addonsHealthy:         true,
+			nodeGroupsHealthy:     true,
+			expect:                ekscontrolplanev1.HealthStateHealthy,
+		},
+		{
+			name:                  "addons unhealthy",
+			controlPlaneReachable: true,
+			addonsHealthy:         false,
+			nodeGroupsHealthy:     true,
+			expect:                ekscontrolplanev1.HealthStateDegraded,
+		},
+		{
+			name:                  "node groups unhealthy",
+			controlPlaneReachable: true,
+			addonsHealthy:         true,
+			nodeGroupsHealthy:     false,
+			expect:                ekscontrolplanev1.HealthStateDegraded,
+		},
+		{
+			name:                  "control plane unreachable",
+			controlPlaneReachable: false,
+			addonsHealthy:         true,
+			nodeGroupsHealthy:     true,
+			expect:                ekscontrolplanev1.HealthStateUnhealthy,
+		},
+		{
+			name:                  "control plane unreachable takes precedence",
+			controlPlaneReachable: false,
+			addonsHealthy:         false,
+			nodeGroupsHealthy:     false,
+			expect:                ekscontrolplanev1.HealthStateUnhealthy,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			summary := computeHealthSummary(tc.controlPlaneReachable, tc.addonsHealthy, tc.nodeGroupsHealthy)
+			g.Expect(summary.Overall).To(Equal(tc.expect))
+			g.Expect(summary.ControlPlaneReachable).To(Equal(tc.controlPlaneReachable))
+			g.Expect(summary.AddonsHealthy).To(Equal(tc.addonsHealthy))
+			g.Expect(summary.NodeGroupsHealthy).To(Equal(tc.nodeGroupsHealthy))
+		})
+	}
+}
+
+func TestUnhealthyAddons(t *testing.T) {
+	testCases := []struct {
+		name   string
+		addons []ekscontrolplanev1.AddonState
+		expect []string
+	}{
+		{
+			name:   "no addons",
+			expect: []string{},
+		},
+		{
+			name: "all addons active",
+			addons: []ekscontrolplanev1.AddonState{
+				{Name: "vpc-cni", Status: aws.String(eks.AddonStatusActive)},
+				{Name: "coredns", Status: aws.String(eks.AddonStatusActive)},
+			},
+			expect: []string{},
+		},
+		{
+			name: "degraded and unknown addons",
+			addons: []ekscontrolplanev1.AddonState{
+				{Name: "vpc-cni", Status: aws.String(eks.AddonStatusActive)},
+				{Name: "coredns", Status: aws.String(eks.AddonStatusDegraded)},
+				{Name: "kube-proxy"},
+			},
+			expect: []string{"coredns", "kube-proxy"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(unhealthyAddons(tc.addons)).To(Equal(tc.expect))
+		})
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"net/url"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
@@ -283,6 +284,85 @@ func (s *IAMService) EnsureTagsAndPolicy(
 	return updated, nil
 }
 
+// EnsureInlinePolicy will ensure the inline policy with the given name is set on the role.
+func (s *IAMService) EnsureInlinePolicy(role *iam.Role, name string, policy *iamv1.PolicyDocument) (bool, error) {
+	s.V(2).Info("Ensuring inline policy is set on role", "role", aws.StringValue(role.RoleName), "policy", name)
+
+	out, err := s.IAMClient.GetRolePolicy(&iam.GetRolePolicyInput{
+		RoleName:   role.RoleName,
+		PolicyName: aws.String(name),
+	})
+	if err != nil && !isNoSuchEntity(err) {
+		return false, errors.Wrapf(err, "error getting inline policy %s of role %s", name, aws.StringValue(role.RoleName))
+	}
+
+	if err == nil {
+		currentRaw, err := url.PathUnescape(aws.StringValue(out.PolicyDocument))
+		if err != nil {
+			return false, errors.Wrapf(err, "couldn't decode inline policy %s", name)
+		}
+		var current iamv1.PolicyDocument
+		if err := json.Unmarshal([]byte(currentRaw), &current); err != nil {
+			return false, errors.Wrapf(err, "couldn't unmarshal inline policy %s", name)
+		}
+		if cmp.Equal(*policy, current) {
+			return false, nil
+		}
+	}
+
+	policyJSON, err := converters.IAMPolicyDocumentToJSON(*policy)
+	if err != nil {
+		return false, errors.Wrapf(err, "error converting inline policy %s to json", name)
+	}
+	if _, err := s.IAMClient.PutRolePolicy(&iam.PutRolePolicyInput{
+		RoleName:       role.RoleName,
+		PolicyName:     aws.String(name),
+		PolicyDocument: aws.String(policyJSON),
+	}); err != nil {
+		return false, errors.Wrapf(err, "error putting inline policy %s on role %s", name, aws.StringValue(role.RoleName))
+	}
+
+	return true, nil
+}
+
+// DeleteInlinePolicy will delete the inline policy with the given name from the role, if it exists.
+func (s *IAMService) DeleteInlinePolicy(role *iam.Role, name string) error {
+	if _, err := s.IAMClient.DeleteRolePolicy(&iam.DeleteRolePolicyInput{
+		RoleName:   role.RoleName,
+		PolicyName: aws.String(name),
+	}); err != nil && !isNoSuchEntity(err) {
+		return errors.Wrapf(err, "error deleting inline policy %s of role %s", name, aws.StringValue(role.RoleName))
+	}
+	return nil
+}
+
+func (s *IAMService) deleteAllInlinePoliciesForRole(name string) error {
+	s.V(3).Info("Deleting all inline policies for role", "role", name)
+	policies, err := s.IAMClient.ListRolePolicies(&iam.ListRolePoliciesInput{
+		RoleName: aws.String(name),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "error fetching inline policies for role %s", name)
+	}
+	for _, p := range policies.PolicyNames {
+		s.V(2).Info("Deleting inline policy", "policy", aws.StringValue(p))
+		if _, err := s.IAMClient.DeleteRolePolicy(&iam.DeleteRolePolicyInput{
+			RoleName:   aws.String(name),
+			PolicyName: p,
+		}); err != nil && !isNoSuchEntity(err) {
+			return errors.Wrapf(err, "error deleting inline policy %s of role %s", aws.StringValue(p), name)
+		}
+	}
+	return nil
+}
+
+func isNoSuchEntity(err error) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		return aerr.Code() == iam.ErrCodeNoSuchEntityException
+	}
+	return false
+}
+
 func (s *IAMService) detachAllPoliciesForRole(name string) error {
 	s.V(3).Info("Detaching all policies for role", "role", name)
 	input := &iam.ListAttachedRolePoliciesInput{
@@ -306,6 +386,9 @@ func (s *IAMService) DeleteRole(name string) error {
 	if err := s.detachAllPoliciesForRole(name); err != nil {
 		return errors.Wrapf(err, "error detaching policies for role %s", name)
 	}
+	if err := s.deleteAllInlinePoliciesForRole(name); err != nil {
+		return errors.Wrapf(err, "error deleting inline policies for role %s", name)
+	}
 
 	input := &iam.DeleteRoleInput{
 		RoleName: aws.String(name),
@@ -33,6 +33,7 @@ import (
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/servicequotas"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/wait"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -185,6 +186,31 @@ func (s *NodegroupService) remoteAccess() (*eks.RemoteAccessConfig, error) {
 	}, nil
 }
 
+// reconcileServiceQuotas checks the headroom of the service quotas for the instances of the nodegroup to create
+// and their network interfaces, when enabled for the control plane.
+func (s *NodegroupService) reconcileServiceQuotas() error {
+	if s.scope.ServiceQuotaChecks() == nil {
+		return nil
+	}
+
+	instances := aws.Int64Value(s.scalingConfig().DesiredSize)
+	req := servicequotas.Request{
+		NetworkInterfaces: instances,
+	}
+	// Spot instances have their own quotas.
+	managedPool := s.scope.ManagedMachinePool.Spec
+	if managedPool.CapacityType == nil || *managedPool.CapacityType != expinfrav1.ManagedMachinePoolCapacityTypeSpot {
+		// EKS defaults the instance type of the nodegroup.
+		req.InstanceType = "t3.medium"
+		if managedPool.InstanceType != nil {
+			req.InstanceType = *managedPool.InstanceType
+		}
+		req.Instances = instances
+	}
+
+	return s.ServiceQuotaService.ReconcileHeadroom(s.scope.ManagedMachinePool, req)
+}
+
 func (s *NodegroupService) createNodegroup() (*eks.Nodegroup, error) {
 	eksClusterName := s.scope.KubernetesClusterName()
 	nodegroupName := s.scope.NodegroupName()
@@ -241,6 +267,11 @@ func (s *NodegroupService) createNodegroup() (*eks.Nodegroup, error) {
 		}
 		input.CapacityType = aws.String(capacityType)
 	}
+	launchTemplate, err := s.reconcileCapacityBlockLaunchTemplate()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to reconcile capacity block launch template")
+	}
+	input.LaunchTemplate = launchTemplate
 
 	if err := input.Validate(); err != nil {
 		return nil, errors.Wrap(err, "created invalid CreateNodegroupInput")
@@ -296,7 +327,7 @@ func (s *NodegroupService) deleteNodegroupAndWait() (reterr error) {
 			switch aerr.Code() {
 			// TODO
 			case eks.ErrCodeResourceNotFoundException:
-				return nil
+				return s.deleteCapacityBlockLaunchTemplate()
 			default:
 				return errors.Wrap(err, "failed to delete nodegroup")
 			}
@@ -314,7 +345,7 @@ func (s *NodegroupService) deleteNodegroupAndWait() (reterr error) {
 		return errors.Wrapf(err, "failed waiting for EKS nodegroup %s to delete", nodegroupName)
 	}
 
-	return nil
+	return s.deleteCapacityBlockLaunchTemplate()
 }
 
 func (s *NodegroupService) reconcileNodegroupVersion(ng *eks.Nodegroup) error {
@@ -326,11 +357,18 @@ func (s *NodegroupService) reconcileNodegroupVersion(ng *eks.Nodegroup) error {
 	specAMI := s.scope.ManagedMachinePool.Spec.AMIVersion
 	ngAMI := *ng.ReleaseVersion
 
+	launchTemplate, err := s.capacityBlockLaunchTemplateUpdate(ng)
+	if err != nil {
+		return errors.Wrap(err, "failed to reconcile capacity block launch template")
+	}
+
 	eksClusterName := s.scope.KubernetesClusterName()
-	if (specVersion != nil && ngVersion.LessThan(specVersion)) || (specAMI != nil && *specAMI != ngAMI) {
+	if (specVersion != nil && ngVersion.LessThan(specVersion)) || (specAMI != nil && *specAMI != ngAMI) || launchTemplate != nil {
 		input := &eks.UpdateNodegroupVersionInput{
 			ClusterName:   aws.String(eksClusterName),
 			NodegroupName: aws.String(s.scope.NodegroupName()),
+			// The capacity block launch template doesn't set the AMI, so it can be updated along with the versions.
+			LaunchTemplate: launchTemplate,
 		}
 
 		var updateMsg string
@@ -343,6 +381,8 @@ func (s *NodegroupService) reconcileNodegroupVersion(ng *eks.Nodegroup) error {
 		} else if specAMI != nil && *specAMI != ngAMI {
 			input.ReleaseVersion = specAMI
 			updateMsg = fmt.Sprintf("to AMI version %s", *input.ReleaseVersion)
+		} else {
+			updateMsg = fmt.Sprintf("to launch template version %s", *input.LaunchTemplate.Version)
 		}
 
 		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
@@ -489,6 +529,9 @@ func (s *NodegroupService) reconcileNodegroup() error {
 	}
 
 	if eksClusterName, eksNodegroupName := s.scope.KubernetesClusterName(), s.scope.NodegroupName(); ng == nil {
+		if err := s.reconcileServiceQuotas(); err != nil {
+			return errors.Wrap(err, "failed to check service quotas")
+		}
 		ng, err = s.createNodegroup()
 		if err != nil {
 			return errors.Wrap(err, "failed to create nodegroup")
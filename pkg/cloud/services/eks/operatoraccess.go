@@ -0,0 +1,455 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
+	iamv1 "sigs.k8s.io/cluster-api-provider-aws/iam/api/v1beta1"
+	eksiam "sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/eks/iam"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+const samlSignInAudience = "https://signin.aws.amazon.com/saml"
+
+// reconcileOperatorAccess reconciles the IAM identity provider and roles operators use to sign
+// in to the cluster account, and deletes them once operator access is removed from the spec.
+func (s *Service) reconcileOperatorAccess() error {
+	spec := s.scope.ControlPlane.Spec.OperatorAccess
+	if spec == nil {
+		return s.deleteOperatorAccess()
+	}
+
+	if !s.scope.EnableIAM() {
+		return errors.New("'OperatorAccess' provided without enabling the 'EKSEnableIAM' feature flag")
+	}
+
+	s.scope.V(2).Info("Reconciling operator access identity provider and roles")
+
+	if s.scope.ControlPlane.Status.OperatorAccess == nil {
+		s.scope.ControlPlane.Status.OperatorAccess = &ekscontrolplanev1.OperatorAccessStatus{}
+	}
+	status := s.scope.ControlPlane.Status.OperatorAccess
+
+	if status.ProviderARN != "" && !operatorAccessProviderMatches(spec, status.ProviderARN) {
+		if err := s.deleteOperatorAccessProvider(status.ProviderARN); err != nil {
+			return err
+		}
+		status.ProviderARN = ""
+	}
+
+	var err error
+	status.ProviderARN, err = s.reconcileOperatorAccessProvider(spec, status.ProviderARN)
+	if err != nil {
+		return err
+	}
+
+	wanted := make(map[string]bool, len(spec.Roles))
+	for _, role := range spec.Roles {
+		wanted[role.Name] = true
+		managed, err := s.reconcileOperatorAccessRole(role, operatorAccessTrustPolicy(spec, status.ProviderARN, role))
+		if err != nil {
+			return err
+		}
+		if managed && !sets.NewString(status.Roles...).Has(role.Name) {
+			status.Roles = append(status.Roles, role.Name)
+		}
+	}
+
+	roles := make([]string, 0, len(status.Roles))
+	for _, name := range status.Roles {
+		if wanted[name] {
+			roles = append(roles, name)
+			continue
+		}
+		if err := s.deleteOperatorAccessRole(name); err != nil {
+			return err
+		}
+	}
+	status.Roles = roles
+
+	return nil
+}
+
+// reconcileOperatorAccessProvider reconciles the identity provider of the spec. IAM allows a single
+// provider per SAML name or OIDC issuer in an account, so a provider that already exists is adopted,
+// and left untouched unless it's tagged as owned by the cluster.
+func (s *Service) reconcileOperatorAccessProvider(spec *ekscontrolplanev1.OperatorAccess, providerARN string) (string, error) {
+	if providerARN == "" {
+		var err error
+		if providerARN, err = s.findOperatorAccessProvider(spec); err != nil {
+			return "", err
+		}
+	}
+
+	if providerARN != "" {
+		owned, err := s.isOperatorAccessProviderOwned(providerARN)
+		switch {
+		case isNotFound(err):
+			providerARN = ""
+		case err != nil:
+			return "", errors.Wrapf(err, "failed to list tags of identity provider %q", providerARN)
+		case !owned:
+			s.scope.V(2).Info("Skipping, operator access identity provider is unmanaged", "provider-arn", providerARN)
+			return providerARN, nil
+		}
+	}
+
+	if spec.SAML != nil {
+		return s.reconcileOperatorAccessSAMLProvider(spec.SAML, providerARN)
+	}
+	return s.reconcileOperatorAccessOIDCProvider(spec.OIDC, providerARN)
+}
+
+// findOperatorAccessProvider returns the ARN of the identity provider of the spec if it's already
+// registered in the account.
+func (s *Service) findOperatorAccessProvider(spec *ekscontrolplanev1.OperatorAccess) (string, error) {
+	var arns []string
+	if spec.SAML != nil {
+		out, err := s.IAMClient.ListSAMLProviders(&iam.ListSAMLProvidersInput{})
+		if err != nil {
+			return "", errors.Wrap(err, "failed to list SAML providers")
+		}
+		for _, provider := range out.SAMLProviderList {
+			arns = append(arns, aws.StringValue(provider.Arn))
+		}
+	} else {
+		out, err := s.IAMClient.ListOpenIDConnectProviders(&iam.ListOpenIDConnectProvidersInput{})
+		if err != nil {
+			return "", errors.Wrap(err, "failed to list OIDC providers")
+		}
+		for _, provider := range out.OpenIDConnectProviderList {
+			arns = append(arns, aws.StringValue(provider.Arn))
+		}
+	}
+
+	for _, arn := range arns {
+		if operatorAccessProviderMatches(spec, arn) {
+			return arn, nil
+		}
+	}
+	return "", nil
+}
+
+// isOperatorAccessProviderOwned reports whether the identity provider is tagged as owned by the cluster.
+func (s *Service) isOperatorAccessProviderOwned(providerARN string) (bool, error) {
+	var tags []*iam.Tag
+	if isSAMLProviderARN(providerARN) {
+		out, err := s.IAMClient.ListSAMLProviderTags(&iam.ListSAMLProviderTagsInput{
+			SAMLProviderArn: aws.String(providerARN),
+		})
+		if err != nil {
+			return false, err
+		}
+		tags = out.Tags
+	} else {
+		out, err := s.IAMClient.ListOpenIDConnectProviderTags(&iam.ListOpenIDConnectProviderTagsInput{
+			OpenIDConnectProviderArn: aws.String(providerARN),
+		})
+		if err != nil {
+			return false, err
+		}
+		tags = out.Tags
+	}
+
+	keyToFind := infrav1.ClusterAWSCloudProviderTagKey(s.scope.Name())
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == keyToFind && aws.StringValue(tag.Value) == string(infrav1.ResourceLifecycleOwned) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *Service) reconcileOperatorAccessSAMLProvider(spec *ekscontrolplanev1.OperatorAccessSAMLProvider, providerARN string) (string, error) {
+	if providerARN != "" {
+		out, err := s.IAMClient.GetSAMLProvider(&iam.GetSAMLProviderInput{
+			SAMLProviderArn: aws.String(providerARN),
+		})
+		switch {
+		case err == nil && aws.StringValue(out.SAMLMetadataDocument) == spec.MetadataDocument:
+			return providerARN, nil
+		case err == nil:
+			if _, err := s.IAMClient.UpdateSAMLProvider(&iam.UpdateSAMLProviderInput{
+				SAMLProviderArn:      aws.String(providerARN),
+				SAMLMetadataDocument: aws.String(spec.MetadataDocument),
+			}); err != nil {
+				return "", errors.Wrapf(err, "failed to update SAML provider %q", providerARN)
+			}
+			record.Eventf(s.scope.ControlPlane, "SuccessfulUpdateSAMLProvider", "Updated operator access SAML provider %q", spec.Name)
+			return providerARN, nil
+		case !isNotFound(err):
+			return "", errors.Wrapf(err, "failed to get SAML provider %q", providerARN)
+		}
+	}
+
+	out, err := s.IAMClient.CreateSAMLProvider(&iam.CreateSAMLProviderInput{
+		Name:                 aws.String(spec.Name),
+		SAMLMetadataDocument: aws.String(spec.MetadataDocument),
+		Tags:                 eksiam.RoleTags(s.scope.Name(), s.scope.AdditionalTags()),
+	})
+	if err != nil {
+		record.Warnf(s.scope.ControlPlane, "FailedCreateSAMLProvider", "Failed to create operator access SAML provider %q: %v", spec.Name, err)
+		return "", errors.Wrapf(err, "failed to create SAML provider %q", spec.Name)
+	}
+	record.Eventf(s.scope.ControlPlane, "SuccessfulCreateSAMLProvider", "Created operator access SAML provider %q", spec.Name)
+
+	return aws.StringValue(out.SAMLProviderArn), nil
+}
+
+func (s *Service) reconcileOperatorAccessOIDCProvider(spec *ekscontrolplanev1.OperatorAccessOIDCProvider, providerARN string) (string, error) {
+	if providerARN != "" {
+		out, err := s.IAMClient.GetOpenIDConnectProvider(&iam.GetOpenIDConnectProviderInput{
+			OpenIDConnectProviderArn: aws.String(providerARN),
+		})
+		if err == nil {
+			return providerARN, s.updateOperatorAccessOIDCProvider(spec, providerARN, out)
+		}
+		if !isNotFound(err) {
+			return "", errors.Wrapf(err, "failed to get OIDC provider %q", providerARN)
+		}
+	}
+
+	out, err := s.IAMClient.CreateOpenIDConnectProvider(&iam.CreateOpenIDConnectProviderInput{
+		Url:            aws.String(spec.IssuerURL),
+		ClientIDList:   aws.StringSlice(spec.ClientIDs),
+		ThumbprintList: aws.StringSlice(spec.Thumbprints),
+		Tags:           eksiam.RoleTags(s.scope.Name(), s.scope.AdditionalTags()),
+	})
+	if err != nil {
+		record.Warnf(s.scope.ControlPlane, "FailedCreateOIDCProvider", "Failed to create operator access OIDC provider for %q: %v", spec.IssuerURL, err)
+		return "", errors.Wrapf(err, "failed to create OIDC provider for %q", spec.IssuerURL)
+	}
+	record.Eventf(s.scope.ControlPlane, "SuccessfulCreateOIDCProvider", "Created operator access OIDC provider for %q", spec.IssuerURL)
+
+	return aws.StringValue(out.OpenIDConnectProviderArn), nil
+}
+
+// updateOperatorAccessOIDCProvider brings the client IDs and thumbprints of an existing OIDC
+// provider in line with the spec.
+func (s *Service) updateOperatorAccessOIDCProvider(spec *ekscontrolplanev1.OperatorAccessOIDCProvider, providerARN string, current *iam.GetOpenIDConnectProviderOutput) error {
+	currentClientIDs := sets.NewString(aws.StringValueSlice(current.ClientIDList)...)
+	wantedClientIDs := sets.NewString(spec.ClientIDs...)
+	for _, clientID := range spec.ClientIDs {
+		if currentClientIDs.Has(clientID) {
+			continue
+		}
+		if _, err := s.IAMClient.AddClientIDToOpenIDConnectProvider(&iam.AddClientIDToOpenIDConnectProviderInput{
+			OpenIDConnectProviderArn: aws.String(providerARN),
+			ClientID:                 aws.String(clientID),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to add client ID %q to OIDC provider %q", clientID, providerARN)
+		}
+	}
+	for _, clientID := range currentClientIDs.List() {
+		if wantedClientIDs.Has(clientID) {
+			continue
+		}
+		if _, err := s.IAMClient.RemoveClientIDFromOpenIDConnectProvider(&iam.RemoveClientIDFromOpenIDConnectProviderInput{
+			OpenIDConnectProviderArn: aws.String(providerARN),
+			ClientID:                 aws.String(clientID),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to remove client ID %q from OIDC provider %q", clientID, providerARN)
+		}
+	}
+
+	if !sets.NewString(aws.StringValueSlice(current.ThumbprintList)...).Equal(sets.NewString(spec.Thumbprints...)) {
+		if _, err := s.IAMClient.UpdateOpenIDConnectProviderThumbprint(&iam.UpdateOpenIDConnectProviderThumbprintInput{
+			OpenIDConnectProviderArn: aws.String(providerARN),
+			ThumbprintList:           aws.StringSlice(spec.Thumbprints),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to update thumbprints of OIDC provider %q", providerARN)
+		}
+		record.Eventf(s.scope.ControlPlane, "SuccessfulUpdateOIDCProvider", "Updated thumbprints of operator access OIDC provider for %q", spec.IssuerURL)
+	}
+
+	return nil
+}
+
+// reconcileOperatorAccessRole creates the role if needed and ensures its trust policy, tags and
+// policies. It reports whether the role is managed by the cluster; existing roles that aren't
+// are left untouched.
+func (s *Service) reconcileOperatorAccessRole(spec ekscontrolplanev1.OperatorAccessRole, trustPolicy *iamv1.PolicyDocument) (bool, error) {
+	role, err := s.GetIAMRole(spec.Name)
+	if err != nil {
+		if !isNotFound(err) {
+			return false, errors.Wrapf(err, "failed to get operator access role %q", spec.Name)
+		}
+
+		role, err = s.CreateRole(spec.Name, s.scope.Name(), trustPolicy, s.scope.AdditionalTags())
+		if err != nil {
+			record.Warnf(s.scope.ControlPlane, "FailedIAMRoleCreation", "Failed to create operator access IAM role %q: %v", spec.Name, err)
+			return false, errors.Wrapf(err, "failed to create operator access role %q", spec.Name)
+		}
+		record.Eventf(s.scope.ControlPlane, "SuccessfulIAMRoleCreation", "Created operator access IAM role %q", spec.Name)
+	} else {
+		if s.IsUnmanaged(role, s.scope.Name()) {
+			s.scope.V(2).Info("Skipping, operator access role is unmanaged", "role-name", spec.Name)
+			return false, nil
+		}
+
+		if _, err := s.EnsureTagsAndPolicy(role, s.scope.Name(), trustPolicy, s.scope.AdditionalTags()); err != nil {
+			return true, errors.Wrapf(err, "error ensuring tags and policy document are set on operator access role %q", spec.Name)
+		}
+	}
+
+	if len(spec.PolicyARNs) > 0 && !s.scope.AllowAdditionalRoles() {
+		return true, ErrCannotUseAdditionalRoles
+	}
+	if _, err := s.EnsurePoliciesAttached(role, aws.StringSlice(spec.PolicyARNs)); err != nil {
+		return true, errors.Wrapf(err, "error ensuring policies are attached to operator access role %q", spec.Name)
+	}
+
+	return true, nil
+}
+
+// deleteOperatorAccess deletes the operator access roles and identity provider recorded in the
+// status.
+func (s *Service) deleteOperatorAccess() error {
+	status := s.scope.ControlPlane.Status.OperatorAccess
+	if status == nil {
+		return nil
+	}
+
+	for len(status.Roles) > 0 {
+		if err := s.deleteOperatorAccessRole(status.Roles[0]); err != nil {
+			return err
+		}
+		status.Roles = status.Roles[1:]
+	}
+
+	if status.ProviderARN != "" {
+		if err := s.deleteOperatorAccessProvider(status.ProviderARN); err != nil {
+			return err
+		}
+	}
+
+	s.scope.ControlPlane.Status.OperatorAccess = nil
+	return nil
+}
+
+func (s *Service) deleteOperatorAccessRole(name string) error {
+	if err := s.DeleteRole(name); err != nil && !isNotFound(errors.Cause(err)) {
+		record.Warnf(s.scope.ControlPlane, "FailedIAMRoleDeletion", "Failed to delete operator access IAM role %q: %v", name, err)
+		return err
+	}
+	record.Eventf(s.scope.ControlPlane, "SuccessfulIAMRoleDeletion", "Deleted operator access IAM role %q", name)
+	return nil
+}
+
+// deleteOperatorAccessProvider deletes the identity provider if it's owned by the cluster.
+func (s *Service) deleteOperatorAccessProvider(providerARN string) error {
+	owned, err := s.isOperatorAccessProviderOwned(providerARN)
+	switch {
+	case isNotFound(err):
+		return nil
+	case err != nil:
+		return errors.Wrapf(err, "failed to list tags of identity provider %q", providerARN)
+	case !owned:
+		s.scope.V(2).Info("Skipping deletion, operator access identity provider is unmanaged", "provider-arn", providerARN)
+		return nil
+	}
+
+	if isSAMLProviderARN(providerARN) {
+		_, err = s.IAMClient.DeleteSAMLProvider(&iam.DeleteSAMLProviderInput{
+			SAMLProviderArn: aws.String(providerARN),
+		})
+	} else {
+		_, err = s.IAMClient.DeleteOpenIDConnectProvider(&iam.DeleteOpenIDConnectProviderInput{
+			OpenIDConnectProviderArn: aws.String(providerARN),
+		})
+	}
+	if err != nil && !isNotFound(err) {
+		record.Warnf(s.scope.ControlPlane, "FailedDeleteIdentityProvider", "Failed to delete operator access identity provider %q: %v", providerARN, err)
+		return errors.Wrapf(err, "failed to delete identity provider %q", providerARN)
+	}
+	record.Eventf(s.scope.ControlPlane, "SuccessfulDeleteIdentityProvider", "Deleted operator access identity provider %q", providerARN)
+	return nil
+}
+
+// operatorAccessProviderMatches reports whether the provider ARN refers to the provider in the
+// spec. The name of a SAML provider and the issuer URL of an OIDC provider can't be changed in
+// place, so the provider has to be replaced when they change.
+func operatorAccessProviderMatches(spec *ekscontrolplanev1.OperatorAccess, providerARN string) bool {
+	if spec.SAML != nil {
+		return strings.HasSuffix(providerARN, ":saml-provider/"+spec.SAML.Name)
+	}
+	return strings.HasSuffix(providerARN, ":oidc-provider/"+oidcIssuerHostPath(spec.OIDC.IssuerURL))
+}
+
+func isSAMLProviderARN(providerARN string) bool {
+	return strings.Contains(providerARN, ":saml-provider/")
+}
+
+func oidcIssuerHostPath(issuerURL string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(issuerURL, "https://"), "/")
+}
+
+// operatorAccessTrustPolicy gives the trust policy allowing the subjects of the role authenticated
+// by the operator access identity provider to assume it.
+func operatorAccessTrustPolicy(spec *ekscontrolplanev1.OperatorAccess, providerARN string, role ekscontrolplanev1.OperatorAccessRole) *iamv1.PolicyDocument {
+	subjects := make([]interface{}, 0, len(role.Subjects))
+	for _, subject := range role.Subjects {
+		subjects = append(subjects, subject)
+	}
+
+	action := "sts:AssumeRoleWithSAML"
+	audience := map[string]interface{}{
+		"SAML:aud": samlSignInAudience,
+	}
+	subject := map[string]interface{}{
+		"SAML:sub": subjects,
+	}
+	if spec.OIDC != nil {
+		audiences := make([]interface{}, 0, len(spec.OIDC.ClientIDs))
+		for _, clientID := range spec.OIDC.ClientIDs {
+			audiences = append(audiences, clientID)
+		}
+		issuer := oidcIssuerHostPath(spec.OIDC.IssuerURL)
+		action = "sts:AssumeRoleWithWebIdentity"
+		audience = map[string]interface{}{
+			issuer + ":aud": audiences,
+		}
+		subject = map[string]interface{}{
+			issuer + ":sub": subjects,
+		}
+	}
+
+	return &iamv1.PolicyDocument{
+		Version: "2012-10-17",
+		Statement: iamv1.Statements{
+			{
+				Effect: iamv1.EffectAllow,
+				Principal: iamv1.Principals{
+					iamv1.PrincipalFederated: iamv1.PrincipalID{providerARN},
+				},
+				Action: iamv1.Actions{action},
+				Condition: iamv1.Conditions{
+					iamv1.StringEquals: audience,
+					iamv1.StringLike:   subject,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,387 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/cmd/clusterawsadm/converters"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/iamauth/mock_iamauth"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestReconcileOperatorAccess(t *testing.T) {
+	const (
+		samlARN        = "arn:aws:iam::123456789012:saml-provider/corp-sso"
+		oidcARN        = "arn:aws:iam::123456789012:oidc-provider/sso.example.com"
+		adminPolicyARN = "arn:aws:iam::aws:policy/AdministratorAccess"
+	)
+	notFound := awserr.New(iam.ErrCodeNoSuchEntityException, "not found", nil)
+	ownedTags := []*iam.Tag{{
+		Key:   aws.String(infrav1.ClusterAWSCloudProviderTagKey("capi-name")),
+		Value: aws.String(string(infrav1.ResourceLifecycleOwned)),
+	}}
+
+	saml := &ekscontrolplanev1.OperatorAccessSAMLProvider{Name: "corp-sso", MetadataDocument: "<md:EntityDescriptor/>"}
+	oidc := &ekscontrolplanev1.OperatorAccessOIDCProvider{
+		IssuerURL:   "https://sso.example.com",
+		ClientIDs:   []string{"capa"},
+		Thumbprints: []string{"9e99a48a9960b14926bb7f3b02e22da2b0ab7280"},
+	}
+	samlAccess := &ekscontrolplanev1.OperatorAccess{
+		SAML:  saml,
+		Roles: []ekscontrolplanev1.OperatorAccessRole{{Name: "cluster-admins", Subjects: []string{"admin@example.com"}, PolicyARNs: []string{adminPolicyARN}}},
+	}
+	oidcAccess := &ekscontrolplanev1.OperatorAccess{
+		OIDC:  oidc,
+		Roles: []ekscontrolplanev1.OperatorAccessRole{{Name: "cluster-viewers", Subjects: []string{"viewers-*"}}},
+	}
+	samlTrustPolicy, err := converters.IAMPolicyDocumentToJSON(*operatorAccessTrustPolicy(samlAccess, samlARN, samlAccess.Roles[0]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	oidcTrustPolicy, err := converters.IAMPolicyDocumentToJSON(*operatorAccessTrustPolicy(oidcAccess, oidcARN, oidcAccess.Roles[0]))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectSAMLProviderTags := func(m *mock_iamauth.MockIAMAPIMockRecorder, tags []*iam.Tag) {
+		m.ListSAMLProviderTags(&iam.ListSAMLProviderTagsInput{SAMLProviderArn: aws.String(samlARN)}).
+			Return(&iam.ListSAMLProviderTagsOutput{Tags: tags}, nil)
+	}
+	expectOIDCProviderTags := func(m *mock_iamauth.MockIAMAPIMockRecorder, tags []*iam.Tag) {
+		m.ListOpenIDConnectProviderTags(&iam.ListOpenIDConnectProviderTagsInput{OpenIDConnectProviderArn: aws.String(oidcARN)}).
+			Return(&iam.ListOpenIDConnectProviderTagsOutput{Tags: tags}, nil)
+	}
+	expectOIDCProviders := func(m *mock_iamauth.MockIAMAPIMockRecorder, arns ...string) {
+		providers := []*iam.OpenIDConnectProviderListEntry{}
+		for _, arn := range arns {
+			providers = append(providers, &iam.OpenIDConnectProviderListEntry{Arn: aws.String(arn)})
+		}
+		m.ListOpenIDConnectProviders(&iam.ListOpenIDConnectProvidersInput{}).
+			Return(&iam.ListOpenIDConnectProvidersOutput{OpenIDConnectProviderList: providers}, nil)
+	}
+	expectOIDCProviderUpToDate := func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+		m.GetOpenIDConnectProvider(&iam.GetOpenIDConnectProviderInput{OpenIDConnectProviderArn: aws.String(oidcARN)}).
+			Return(&iam.GetOpenIDConnectProviderOutput{
+				ClientIDList:   aws.StringSlice([]string{"capa"}),
+				ThumbprintList: aws.StringSlice([]string{"9e99a48a9960b14926bb7f3b02e22da2b0ab7280"}),
+			}, nil)
+	}
+	expectViewersRoleUpToDate := func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+		m.GetRole(&iam.GetRoleInput{RoleName: aws.String("cluster-viewers")}).Return(&iam.GetRoleOutput{Role: &iam.Role{
+			RoleName:                 aws.String("cluster-viewers"),
+			AssumeRolePolicyDocument: aws.String(oidcTrustPolicy),
+			Tags:                     ownedTags,
+		}}, nil)
+		m.ListAttachedRolePolicies(&iam.ListAttachedRolePoliciesInput{RoleName: aws.String("cluster-viewers")}).
+			Return(&iam.ListAttachedRolePoliciesOutput{}, nil)
+	}
+
+	expectRoleDeleted := func(m *mock_iamauth.MockIAMAPIMockRecorder, name string) {
+		m.ListAttachedRolePolicies(&iam.ListAttachedRolePoliciesInput{RoleName: aws.String(name)}).
+			Return(&iam.ListAttachedRolePoliciesOutput{}, nil)
+		m.ListRolePolicies(&iam.ListRolePoliciesInput{RoleName: aws.String(name)}).
+			Return(&iam.ListRolePoliciesOutput{}, nil)
+		m.DeleteRole(&iam.DeleteRoleInput{RoleName: aws.String(name)}).Return(&iam.DeleteRoleOutput{}, nil)
+	}
+
+	tests := []struct {
+		name                 string
+		access               *ekscontrolplanev1.OperatorAccess
+		status               *ekscontrolplanev1.OperatorAccessStatus
+		enableIAM            bool
+		allowAdditionalRoles bool
+		expect               func(m *mock_iamauth.MockIAMAPIMockRecorder)
+		expectError          bool
+		expectedStatus       *ekscontrolplanev1.OperatorAccessStatus
+	}{
+		{
+			name:      "not configured",
+			enableIAM: true,
+			expect:    func(m *mock_iamauth.MockIAMAPIMockRecorder) {},
+		},
+		{
+			name:        "IAM disabled",
+			access:      oidcAccess,
+			expect:      func(m *mock_iamauth.MockIAMAPIMockRecorder) {},
+			expectError: true,
+		},
+		{
+			name:                 "creates SAML provider and role",
+			access:               samlAccess,
+			enableIAM:            true,
+			allowAdditionalRoles: true,
+			expect: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.ListSAMLProviders(&iam.ListSAMLProvidersInput{}).Return(&iam.ListSAMLProvidersOutput{}, nil)
+				m.CreateSAMLProvider(&iam.CreateSAMLProviderInput{
+					Name:                 aws.String("corp-sso"),
+					SAMLMetadataDocument: aws.String("<md:EntityDescriptor/>"),
+					Tags:                 ownedTags,
+				}).Return(&iam.CreateSAMLProviderOutput{SAMLProviderArn: aws.String(samlARN)}, nil)
+				m.GetRole(&iam.GetRoleInput{RoleName: aws.String("cluster-admins")}).Return(nil, notFound)
+				m.CreateRole(&iam.CreateRoleInput{
+					RoleName:                 aws.String("cluster-admins"),
+					AssumeRolePolicyDocument: aws.String(samlTrustPolicy),
+					Tags:                     ownedTags,
+				}).Return(&iam.CreateRoleOutput{Role: &iam.Role{RoleName: aws.String("cluster-admins"), Tags: ownedTags}}, nil)
+				m.ListAttachedRolePolicies(&iam.ListAttachedRolePoliciesInput{RoleName: aws.String("cluster-admins")}).
+					Return(&iam.ListAttachedRolePoliciesOutput{}, nil)
+				m.GetPolicy(&iam.GetPolicyInput{PolicyArn: aws.String(adminPolicyARN)}).Return(&iam.GetPolicyOutput{}, nil)
+				m.AttachRolePolicy(&iam.AttachRolePolicyInput{
+					RoleName:  aws.String("cluster-admins"),
+					PolicyArn: aws.String(adminPolicyARN),
+				}).Return(&iam.AttachRolePolicyOutput{}, nil)
+			},
+			expectedStatus: &ekscontrolplanev1.OperatorAccessStatus{ProviderARN: samlARN, Roles: []string{"cluster-admins"}},
+		},
+		{
+			name:      "creates OIDC provider and role",
+			access:    oidcAccess,
+			enableIAM: true,
+			expect: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				expectOIDCProviders(m, "arn:aws:iam::123456789012:oidc-provider/other.example.com")
+				m.CreateOpenIDConnectProvider(&iam.CreateOpenIDConnectProviderInput{
+					Url:            aws.String("https://sso.example.com"),
+					ClientIDList:   aws.StringSlice([]string{"capa"}),
+					ThumbprintList: aws.StringSlice([]string{"9e99a48a9960b14926bb7f3b02e22da2b0ab7280"}),
+					Tags:           ownedTags,
+				}).Return(&iam.CreateOpenIDConnectProviderOutput{OpenIDConnectProviderArn: aws.String(oidcARN)}, nil)
+				m.GetRole(&iam.GetRoleInput{RoleName: aws.String("cluster-viewers")}).Return(nil, notFound)
+				m.CreateRole(&iam.CreateRoleInput{
+					RoleName:                 aws.String("cluster-viewers"),
+					AssumeRolePolicyDocument: aws.String(oidcTrustPolicy),
+					Tags:                     ownedTags,
+				}).Return(&iam.CreateRoleOutput{Role: &iam.Role{RoleName: aws.String("cluster-viewers"), Tags: ownedTags}}, nil)
+				m.ListAttachedRolePolicies(&iam.ListAttachedRolePoliciesInput{RoleName: aws.String("cluster-viewers")}).
+					Return(&iam.ListAttachedRolePoliciesOutput{}, nil)
+			},
+			expectedStatus: &ekscontrolplanev1.OperatorAccessStatus{ProviderARN: oidcARN, Roles: []string{"cluster-viewers"}},
+		},
+		{
+			name:      "up to date",
+			access:    oidcAccess,
+			status:    &ekscontrolplanev1.OperatorAccessStatus{ProviderARN: oidcARN, Roles: []string{"cluster-viewers"}},
+			enableIAM: true,
+			expect: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				expectOIDCProviderTags(m, ownedTags)
+				expectOIDCProviderUpToDate(m)
+				expectViewersRoleUpToDate(m)
+			},
+			expectedStatus: &ekscontrolplanev1.OperatorAccessStatus{ProviderARN: oidcARN, Roles: []string{"cluster-viewers"}},
+		},
+		{
+			name:      "adopts provider owned by the cluster missing from the status",
+			access:    oidcAccess,
+			enableIAM: true,
+			expect: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				expectOIDCProviders(m, "arn:aws:iam::123456789012:oidc-provider/other.example.com", oidcARN)
+				expectOIDCProviderTags(m, ownedTags)
+				expectOIDCProviderUpToDate(m)
+				expectViewersRoleUpToDate(m)
+			},
+			expectedStatus: &ekscontrolplanev1.OperatorAccessStatus{ProviderARN: oidcARN, Roles: []string{"cluster-viewers"}},
+		},
+		{
+			name:      "uses unmanaged provider without updating it",
+			access:    oidcAccess,
+			enableIAM: true,
+			expect: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				expectOIDCProviders(m, oidcARN)
+				expectOIDCProviderTags(m, []*iam.Tag{{
+					Key:   aws.String(infrav1.ClusterAWSCloudProviderTagKey("other-cluster")),
+					Value: aws.String(string(infrav1.ResourceLifecycleOwned)),
+				}})
+				expectViewersRoleUpToDate(m)
+			},
+			expectedStatus: &ekscontrolplanev1.OperatorAccessStatus{ProviderARN: oidcARN, Roles: []string{"cluster-viewers"}},
+		},
+		{
+			name:      "updates OIDC provider client IDs and thumbprints",
+			access:    oidcAccess,
+			status:    &ekscontrolplanev1.OperatorAccessStatus{ProviderARN: oidcARN, Roles: []string{"cluster-viewers"}},
+			enableIAM: true,
+			expect: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				expectOIDCProviderTags(m, ownedTags)
+				m.GetOpenIDConnectProvider(&iam.GetOpenIDConnectProviderInput{OpenIDConnectProviderArn: aws.String(oidcARN)}).
+					Return(&iam.GetOpenIDConnectProviderOutput{
+						ClientIDList:   aws.StringSlice([]string{"legacy"}),
+						ThumbprintList: aws.StringSlice([]string{"0000000000000000000000000000000000000000"}),
+					}, nil)
+				m.AddClientIDToOpenIDConnectProvider(&iam.AddClientIDToOpenIDConnectProviderInput{
+					OpenIDConnectProviderArn: aws.String(oidcARN),
+					ClientID:                 aws.String("capa"),
+				}).Return(&iam.AddClientIDToOpenIDConnectProviderOutput{}, nil)
+				m.RemoveClientIDFromOpenIDConnectProvider(&iam.RemoveClientIDFromOpenIDConnectProviderInput{
+					OpenIDConnectProviderArn: aws.String(oidcARN),
+					ClientID:                 aws.String("legacy"),
+				}).Return(&iam.RemoveClientIDFromOpenIDConnectProviderOutput{}, nil)
+				m.UpdateOpenIDConnectProviderThumbprint(&iam.UpdateOpenIDConnectProviderThumbprintInput{
+					OpenIDConnectProviderArn: aws.String(oidcARN),
+					ThumbprintList:           aws.StringSlice([]string{"9e99a48a9960b14926bb7f3b02e22da2b0ab7280"}),
+				}).Return(&iam.UpdateOpenIDConnectProviderThumbprintOutput{}, nil)
+				expectViewersRoleUpToDate(m)
+			},
+			expectedStatus: &ekscontrolplanev1.OperatorAccessStatus{ProviderARN: oidcARN, Roles: []string{"cluster-viewers"}},
+		},
+		{
+			name:      "replaces provider and deletes removed roles",
+			access:    oidcAccess,
+			status:    &ekscontrolplanev1.OperatorAccessStatus{ProviderARN: samlARN, Roles: []string{"cluster-admins", "cluster-viewers"}},
+			enableIAM: true,
+			expect: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				expectSAMLProviderTags(m, ownedTags)
+				m.DeleteSAMLProvider(&iam.DeleteSAMLProviderInput{SAMLProviderArn: aws.String(samlARN)}).
+					Return(&iam.DeleteSAMLProviderOutput{}, nil)
+				expectOIDCProviders(m)
+				m.CreateOpenIDConnectProvider(gomock.Any()).
+					Return(&iam.CreateOpenIDConnectProviderOutput{OpenIDConnectProviderArn: aws.String(oidcARN)}, nil)
+				m.GetRole(&iam.GetRoleInput{RoleName: aws.String("cluster-viewers")}).Return(&iam.GetRoleOutput{Role: &iam.Role{
+					RoleName:                 aws.String("cluster-viewers"),
+					AssumeRolePolicyDocument: aws.String(samlTrustPolicy),
+					Tags:                     ownedTags,
+				}}, nil)
+				m.UpdateAssumeRolePolicy(&iam.UpdateAssumeRolePolicyInput{
+					RoleName:       aws.String("cluster-viewers"),
+					PolicyDocument: aws.String(oidcTrustPolicy),
+				}).Return(&iam.UpdateAssumeRolePolicyOutput{}, nil)
+				m.ListAttachedRolePolicies(&iam.ListAttachedRolePoliciesInput{RoleName: aws.String("cluster-viewers")}).
+					Return(&iam.ListAttachedRolePoliciesOutput{}, nil)
+				expectRoleDeleted(m, "cluster-admins")
+			},
+			expectedStatus: &ekscontrolplanev1.OperatorAccessStatus{ProviderARN: oidcARN, Roles: []string{"cluster-viewers"}},
+		},
+		{
+			name:      "skips unmanaged roles",
+			access:    oidcAccess,
+			status:    &ekscontrolplanev1.OperatorAccessStatus{ProviderARN: oidcARN},
+			enableIAM: true,
+			expect: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				expectOIDCProviderTags(m, ownedTags)
+				expectOIDCProviderUpToDate(m)
+				m.GetRole(&iam.GetRoleInput{RoleName: aws.String("cluster-viewers")}).Return(&iam.GetRoleOutput{Role: &iam.Role{
+					RoleName: aws.String("cluster-viewers"),
+				}}, nil)
+			},
+			expectedStatus: &ekscontrolplanev1.OperatorAccessStatus{ProviderARN: oidcARN, Roles: []string{}},
+		},
+		{
+			name:      "policies without additional roles allowed",
+			access:    samlAccess,
+			status:    &ekscontrolplanev1.OperatorAccessStatus{ProviderARN: samlARN},
+			enableIAM: true,
+			expect: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				expectSAMLProviderTags(m, ownedTags)
+				m.GetSAMLProvider(&iam.GetSAMLProviderInput{SAMLProviderArn: aws.String(samlARN)}).
+					Return(&iam.GetSAMLProviderOutput{SAMLMetadataDocument: aws.String("<md:EntityDescriptor/>")}, nil)
+				m.GetRole(&iam.GetRoleInput{RoleName: aws.String("cluster-admins")}).Return(nil, notFound)
+				m.CreateRole(gomock.Any()).
+					Return(&iam.CreateRoleOutput{Role: &iam.Role{RoleName: aws.String("cluster-admins"), Tags: ownedTags}}, nil)
+			},
+			expectError: true,
+		},
+		{
+			name:      "deletes provider and roles when removed",
+			status:    &ekscontrolplanev1.OperatorAccessStatus{ProviderARN: samlARN, Roles: []string{"cluster-admins"}},
+			enableIAM: true,
+			expect: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				expectRoleDeleted(m, "cluster-admins")
+				expectSAMLProviderTags(m, ownedTags)
+				m.DeleteSAMLProvider(&iam.DeleteSAMLProviderInput{SAMLProviderArn: aws.String(samlARN)}).
+					Return(&iam.DeleteSAMLProviderOutput{}, nil)
+			},
+		},
+		{
+			name:      "skips deleting unmanaged provider",
+			status:    &ekscontrolplanev1.OperatorAccessStatus{ProviderARN: samlARN},
+			enableIAM: true,
+			expect: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				expectSAMLProviderTags(m, nil)
+			},
+		},
+		{
+			name:      "skips already removed provider",
+			status:    &ekscontrolplanev1.OperatorAccessStatus{ProviderARN: oidcARN},
+			enableIAM: true,
+			expect: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.ListOpenIDConnectProviderTags(&iam.ListOpenIDConnectProviderTagsInput{OpenIDConnectProviderArn: aws.String(oidcARN)}).
+					Return(nil, notFound)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			iamMock := mock_iamauth.NewMockIAMAPI(mockControl)
+			tc.expect(iamMock.EXPECT())
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      "capi-name",
+					},
+				},
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+						EKSClusterName: "cluster-name",
+						OperatorAccess: tc.access,
+					},
+					Status: ekscontrolplanev1.AWSManagedControlPlaneStatus{
+						OperatorAccess: tc.status,
+					},
+				},
+				EnableIAM:            tc.enableIAM,
+				AllowAdditionalRoles: tc.allowAdditionalRoles,
+			})
+			g.Expect(err).To(BeNil())
+
+			s := NewService(scope)
+			s.IAMClient = iamMock
+
+			err = s.reconcileOperatorAccess()
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(scope.ControlPlane.Status.OperatorAccess).To(Equal(tc.expectedStatus))
+		})
+	}
+}
@@ -20,20 +20,27 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/pkg/errors"
 
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1beta1"
+	iamv1 "sigs.k8s.io/cluster-api-provider-aws/iam/api/v1beta1"
 	eksiam "sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/eks/iam"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/eks"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 const (
 	maxIAMRoleNameLength = 64
+
+	// crossAccountECRPolicyName is the name of the inline policy granting the nodegroup role
+	// read access to the ECR registries of other accounts.
+	crossAccountECRPolicyName = "cross-account-ecr-read"
 )
 
 // NodegroupRolePolicies gives the policies required for a nodegroup role.
@@ -91,7 +98,11 @@ func (s *Service) reconcileControlPlaneIAMRole() error {
 		return nil
 	}
 
-	//TODO: check tags and trust relationship to see if they need updating
+	// Cost allocation tags removed from the control plane aren't desired anymore, so they are untagged as well.
+	_, err = s.EnsureTagsAndPolicy(role, s.scope.Name(), eksiam.ControlPlaneTrustRelationship(false), s.scope.AdditionalTags())
+	if err != nil {
+		return errors.Wrapf(err, "error ensuring tags and policy document are set on control plane role")
+	}
 
 	policies := []*string{
 		aws.String("arn:aws:iam::aws:policy/AmazonEKSClusterPolicy"),
@@ -195,6 +206,11 @@ func (s *NodegroupService) reconcileNodegroupIAMRole() error {
 
 	if s.IsUnmanaged(role, s.scope.ClusterName()) {
 		s.scope.V(2).Info("Skipping, EKS nodegroup role policy assignment as role is unamanged")
+		if len(s.scope.ManagedMachinePool.Spec.CrossAccountECRRegistries) > 0 {
+			record.Warnf(s.scope.ManagedMachinePool, "CrossAccountECRPolicyIgnored", "Cross-account ECR registries ignored as nodegroup IAM role %q is unmanaged", s.scope.RoleName())
+			conditions.MarkFalse(s.scope.ManagedMachinePool, expinfrav1.CrossAccountECRPolicyReadyCondition, expinfrav1.CrossAccountECRPolicyUnmanagedRoleReason, clusterv1.ConditionSeverityWarning,
+				"nodegroup IAM role %q is unmanaged, grant it read access to the registries", s.scope.RoleName())
+		}
 		return nil
 	}
 
@@ -217,9 +233,70 @@ func (s *NodegroupService) reconcileNodegroupIAMRole() error {
 		return errors.Wrapf(err, "error ensuring policies are attached: %v", policies)
 	}
 
+	if err := s.reconcileCrossAccountECRPolicy(role); err != nil {
+		return errors.Wrap(err, "error reconciling cross-account ECR policy")
+	}
+
+	return nil
+}
+
+// reconcileCrossAccountECRPolicy puts the inline policy granting read access to the ECR
+// registries of other accounts on the nodegroup role, and removes it once none are declared.
+// Whether the policy was put is tracked by the CrossAccountECRPolicyReady condition.
+func (s *NodegroupService) reconcileCrossAccountECRPolicy(role *iam.Role) error {
+	registries := s.scope.ManagedMachinePool.Spec.CrossAccountECRRegistries
+	if len(registries) == 0 {
+		if !conditions.Has(s.scope.ManagedMachinePool, expinfrav1.CrossAccountECRPolicyReadyCondition) {
+			return nil
+		}
+		if err := s.DeleteInlinePolicy(role, crossAccountECRPolicyName); err != nil {
+			return err
+		}
+		conditions.Delete(s.scope.ManagedMachinePool, expinfrav1.CrossAccountECRPolicyReadyCondition)
+		return nil
+	}
+
+	roleARN, err := arn.Parse(aws.StringValue(role.Arn))
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse nodegroup role ARN %q", aws.StringValue(role.Arn))
+	}
+
+	updated, err := s.EnsureInlinePolicy(role, crossAccountECRPolicyName, CrossAccountECRPolicy(roleARN.Partition, registries))
+	if err != nil {
+		conditions.MarkFalse(s.scope.ManagedMachinePool, expinfrav1.CrossAccountECRPolicyReadyCondition, expinfrav1.CrossAccountECRPolicyFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return err
+	}
+	conditions.MarkTrue(s.scope.ManagedMachinePool, expinfrav1.CrossAccountECRPolicyReadyCondition)
+	if updated {
+		record.Eventf(s.scope.ManagedMachinePool, "SuccessfulIAMRolePolicyUpdate", "Updated cross-account ECR policy of nodegroup IAM role %q", s.scope.RoleName())
+	}
 	return nil
 }
 
+// CrossAccountECRPolicy gives the policy granting read access to the repositories of the ECR
+// registries of the given accounts.
+func CrossAccountECRPolicy(partition string, accountIDs []string) *iamv1.PolicyDocument {
+	resources := make(iamv1.Resources, 0, len(accountIDs))
+	for _, accountID := range accountIDs {
+		resources = append(resources, fmt.Sprintf("arn:%s:ecr:*:%s:repository/*", partition, accountID))
+	}
+
+	return &iamv1.PolicyDocument{
+		Version: iamv1.CurrentVersion,
+		Statement: iamv1.Statements{
+			{
+				Effect: iamv1.EffectAllow,
+				Action: iamv1.Actions{
+					"ecr:BatchCheckLayerAvailability",
+					"ecr:BatchGetImage",
+					"ecr:GetDownloadUrlForLayer",
+				},
+				Resource: resources,
+			},
+		},
+	}
+}
+
 func (s *NodegroupService) deleteNodegroupIAMRole() (reterr error) {
 	if err := s.scope.IAMReadyFalse(clusterv1.DeletingReason, ""); err != nil {
 		return err
@@ -0,0 +1,314 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/cmd/clusterawsadm/converters"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1beta1"
+	iamv1 "sigs.k8s.io/cluster-api-provider-aws/iam/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	eksiam "sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/eks/iam"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/iamauth/mock_iamauth"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestCrossAccountECRPolicy(t *testing.T) {
+	g := NewWithT(t)
+
+	policy := CrossAccountECRPolicy("aws-us-gov", []string{"111111111111", "222222222222"})
+	g.Expect(policy.Statement).To(HaveLen(1))
+	g.Expect(policy.Statement[0].Effect).To(Equal(iamv1.EffectAllow))
+	g.Expect(policy.Statement[0].Action).To(ConsistOf("ecr:BatchCheckLayerAvailability", "ecr:BatchGetImage", "ecr:GetDownloadUrlForLayer"))
+	g.Expect(policy.Statement[0].Resource).To(Equal(iamv1.Resources{
+		"arn:aws-us-gov:ecr:*:111111111111:repository/*",
+		"arn:aws-us-gov:ecr:*:222222222222:repository/*",
+	}))
+}
+
+func TestReconcileCrossAccountECRPolicy(t *testing.T) {
+	registries := []string{"111111111111"}
+	policyJSON, err := converters.IAMPolicyDocumentToJSON(*CrossAccountECRPolicy("aws", registries))
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherPolicyJSON, err := converters.IAMPolicyDocumentToJSON(*CrossAccountECRPolicy("aws", []string{"222222222222"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		registries  []string
+		applied     bool
+		expect      func(m *mock_iamauth.MockIAMAPIMockRecorder)
+		expectError bool
+	}{
+		{
+			name:       "no registries and no policy",
+			registries: nil,
+			expect:     func(m *mock_iamauth.MockIAMAPIMockRecorder) {},
+		},
+		{
+			name:       "registries removed but policy already gone",
+			registries: nil,
+			applied:    true,
+			expect: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.DeleteRolePolicy(&iam.DeleteRolePolicyInput{
+					RoleName:   aws.String("nodegroup-role"),
+					PolicyName: aws.String("cross-account-ecr-read"),
+				}).Return(nil, awserr.New(iam.ErrCodeNoSuchEntityException, "not found", nil))
+			},
+		},
+		{
+			name:       "registries removed",
+			registries: nil,
+			applied:    true,
+			expect: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.DeleteRolePolicy(&iam.DeleteRolePolicyInput{
+					RoleName:   aws.String("nodegroup-role"),
+					PolicyName: aws.String("cross-account-ecr-read"),
+				}).Return(&iam.DeleteRolePolicyOutput{}, nil)
+			},
+		},
+		{
+			name:       "policy created",
+			registries: registries,
+			expect: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.GetRolePolicy(gomock.Any()).Return(nil, awserr.New(iam.ErrCodeNoSuchEntityException, "not found", nil))
+				m.PutRolePolicy(&iam.PutRolePolicyInput{
+					RoleName:       aws.String("nodegroup-role"),
+					PolicyName:     aws.String("cross-account-ecr-read"),
+					PolicyDocument: aws.String(policyJSON),
+				}).Return(&iam.PutRolePolicyOutput{}, nil)
+			},
+		},
+		{
+			name:       "policy up to date",
+			registries: registries,
+			expect: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.GetRolePolicy(gomock.Any()).Return(&iam.GetRolePolicyOutput{
+					PolicyDocument: aws.String(url.PathEscape(policyJSON)),
+				}, nil)
+			},
+		},
+		{
+			name:       "policy updated",
+			registries: registries,
+			expect: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.GetRolePolicy(gomock.Any()).Return(&iam.GetRolePolicyOutput{
+					PolicyDocument: aws.String(url.PathEscape(otherPolicyJSON)),
+				}, nil)
+				m.PutRolePolicy(&iam.PutRolePolicyInput{
+					RoleName:       aws.String("nodegroup-role"),
+					PolicyName:     aws.String("cross-account-ecr-read"),
+					PolicyDocument: aws.String(policyJSON),
+				}).Return(&iam.PutRolePolicyOutput{}, nil)
+			},
+		},
+		{
+			name:       "put fails",
+			registries: registries,
+			expect: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.GetRolePolicy(gomock.Any()).Return(nil, awserr.New(iam.ErrCodeNoSuchEntityException, "not found", nil))
+				m.PutRolePolicy(gomock.Any()).Return(nil, awserr.New(iam.ErrCodeLimitExceededException, "limit exceeded", nil))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			iamMock := mock_iamauth.NewMockIAMAPI(mockControl)
+			tc.expect(iamMock.EXPECT())
+
+			pool := &expinfrav1.AWSManagedMachinePool{
+				ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: "default"},
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					RoleName:                  "nodegroup-role",
+					CrossAccountECRRegistries: tc.registries,
+				},
+			}
+			if tc.applied {
+				conditions.MarkTrue(pool, expinfrav1.CrossAccountECRPolicyReadyCondition)
+			}
+			s := &NodegroupService{
+				scope: &scope.ManagedMachinePoolScope{
+					Logger:             logr.Discard(),
+					ManagedMachinePool: pool,
+				},
+				IAMService: eksiam.IAMService{
+					Logger:    logr.Discard(),
+					IAMClient: iamMock,
+				},
+			}
+
+			err := s.reconcileCrossAccountECRPolicy(&iam.Role{
+				RoleName: aws.String("nodegroup-role"),
+				Arn:      aws.String("arn:aws:iam::123456789012:role/nodegroup-role"),
+			})
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(conditions.IsFalse(pool, expinfrav1.CrossAccountECRPolicyReadyCondition)).To(BeTrue())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(conditions.Has(pool, expinfrav1.CrossAccountECRPolicyReadyCondition)).To(Equal(len(tc.registries) > 0))
+		})
+	}
+}
+
+func TestReconcileControlPlaneIAMRole(t *testing.T) {
+	trustPolicyJSON, err := converters.IAMPolicyDocumentToJSON(*eksiam.ControlPlaneTrustRelationship(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ownedTag := &iam.Tag{Key: aws.String("kubernetes.io/cluster/test-cluster"), Value: aws.String("owned")}
+	attachedPolicies := &iam.ListAttachedRolePoliciesOutput{
+		AttachedPolicies: []*iam.AttachedPolicy{
+			{PolicyArn: aws.String("arn:aws:iam::aws:policy/AmazonEKSClusterPolicy")},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		tags        []*iam.Tag
+		expect      func(m *mock_iamauth.MockIAMAPIMockRecorder)
+		expectError bool
+	}{
+		{
+			name: "tags up to date",
+			tags: []*iam.Tag{
+				ownedTag,
+				{Key: aws.String("team"), Value: aws.String("platform")},
+				{Key: aws.String("cost-center"), Value: aws.String("1234")},
+			},
+			expect: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.ListAttachedRolePolicies(gomock.Any()).Return(attachedPolicies, nil)
+			},
+		},
+		{
+			name: "tags updated and removed cost allocation tags untagged",
+			tags: []*iam.Tag{
+				ownedTag,
+				{Key: aws.String("team"), Value: aws.String("other")},
+				{Key: aws.String("environment"), Value: aws.String("test")},
+			},
+			expect: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.TagRole(gomock.AssignableToTypeOf(&iam.TagRoleInput{})).DoAndReturn(func(input *iam.TagRoleInput) (*iam.TagRoleOutput, error) {
+					g := NewWithT(t)
+					g.Expect(input.RoleName).To(Equal(aws.String("control-plane-role")))
+					g.Expect(input.Tags).To(ConsistOf(
+						&iam.Tag{Key: aws.String("team"), Value: aws.String("platform")},
+						&iam.Tag{Key: aws.String("cost-center"), Value: aws.String("1234")},
+					))
+					return &iam.TagRoleOutput{}, nil
+				})
+				m.UntagRole(&iam.UntagRoleInput{
+					RoleName: aws.String("control-plane-role"),
+					TagKeys:  aws.StringSlice([]string{"environment"}),
+				}).Return(&iam.UntagRoleOutput{}, nil)
+				m.ListAttachedRolePolicies(gomock.Any()).Return(attachedPolicies, nil)
+			},
+		},
+		{
+			name: "unmanaged role left alone",
+			tags: []*iam.Tag{
+				{Key: aws.String("environment"), Value: aws.String("test")},
+			},
+			expect: func(m *mock_iamauth.MockIAMAPIMockRecorder) {},
+		},
+		{
+			name: "tagging fails",
+			tags: []*iam.Tag{ownedTag},
+			expect: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.TagRole(gomock.Any()).Return(nil, awserr.New(iam.ErrCodeLimitExceededException, "limit exceeded", nil))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			iamMock := mock_iamauth.NewMockIAMAPI(mockControl)
+			iamMock.EXPECT().GetRole(&iam.GetRoleInput{RoleName: aws.String("control-plane-role")}).Return(&iam.GetRoleOutput{
+				Role: &iam.Role{
+					RoleName:                 aws.String("control-plane-role"),
+					Arn:                      aws.String("arn:aws:iam::123456789012:role/control-plane-role"),
+					AssumeRolePolicyDocument: aws.String(url.PathEscape(trustPolicyJSON)),
+					Tags:                     tc.tags,
+				},
+			}, nil)
+			tc.expect(iamMock.EXPECT())
+
+			s := &Service{
+				scope: &scope.ManagedControlPlaneScope{
+					Logger:  logr.Discard(),
+					Cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"}},
+					ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "test-cluster-control-plane",
+							Namespace: "default",
+							Annotations: map[string]string{
+								infrav1.CostAllocationTagsLastAppliedAnnotation: `["cost-center","environment"]`,
+							},
+						},
+						Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+							RoleName:           aws.String("control-plane-role"),
+							AdditionalTags:     infrav1.Tags{"team": "platform"},
+							CostAllocationTags: infrav1.Tags{"cost-center": "1234"},
+						},
+					},
+				},
+				IAMService: eksiam.IAMService{
+					Logger:    logr.Discard(),
+					IAMClient: iamMock,
+				},
+			}
+
+			err := s.reconcileControlPlaneIAMRole()
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
@@ -22,10 +22,13 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/aws/aws-sdk-go/service/eks/eksiface"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
 
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/eks/iam"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/servicequotas"
 )
 
 // EKSAPI defines the EKS API interface.
@@ -48,6 +51,7 @@ type Service struct {
 	EKSClient EKSAPI
 	iam.IAMService
 	STSClient stsiface.STSAPI
+	SSMClient ssmiface.SSMAPI
 }
 
 // NewService returns a new service given the api clients.
@@ -63,6 +67,7 @@ func NewService(controlPlaneScope *scope.ManagedControlPlaneScope) *Service {
 			IAMClient: scope.NewIAMClient(controlPlaneScope, controlPlaneScope, controlPlaneScope, controlPlaneScope.ControlPlane),
 		},
 		STSClient: scope.NewSTSClient(controlPlaneScope, controlPlaneScope, controlPlaneScope, controlPlaneScope.ControlPlane),
+		SSMClient: scope.NewSSMClient(controlPlaneScope, controlPlaneScope, controlPlaneScope, controlPlaneScope.ControlPlane),
 	}
 }
 
@@ -72,9 +77,13 @@ func NewService(controlPlaneScope *scope.ManagedControlPlaneScope) *Service {
 type NodegroupService struct {
 	scope             *scope.ManagedMachinePoolScope
 	AutoscalingClient autoscalingiface.AutoScalingAPI
+	EC2Client         ec2iface.EC2API
 	EKSClient         eksiface.EKSAPI
 	iam.IAMService
 	STSClient stsiface.STSAPI
+
+	// ServiceQuotaService checks the service quota headroom before the nodegroup is created.
+	ServiceQuotaService services.ServiceQuotaInterface
 }
 
 // NewNodegroupService returns a new service given the api clients.
@@ -82,12 +91,14 @@ func NewNodegroupService(machinePoolScope *scope.ManagedMachinePoolScope) *Nodeg
 	return &NodegroupService{
 		scope:             machinePoolScope,
 		AutoscalingClient: scope.NewASGClient(machinePoolScope, machinePoolScope, machinePoolScope, machinePoolScope.ManagedMachinePool),
+		EC2Client:         scope.NewEC2Client(machinePoolScope, machinePoolScope, machinePoolScope, machinePoolScope.ManagedMachinePool),
 		EKSClient:         scope.NewEKSClient(machinePoolScope, machinePoolScope, machinePoolScope, machinePoolScope.ManagedMachinePool),
 		IAMService: iam.IAMService{
 			Logger:    machinePoolScope.Logger,
 			IAMClient: scope.NewIAMClient(machinePoolScope, machinePoolScope, machinePoolScope, machinePoolScope.ManagedMachinePool),
 		},
-		STSClient: scope.NewSTSClient(machinePoolScope, machinePoolScope, machinePoolScope, machinePoolScope.ManagedMachinePool),
+		STSClient:           scope.NewSTSClient(machinePoolScope, machinePoolScope, machinePoolScope, machinePoolScope.ManagedMachinePool),
+		ServiceQuotaService: servicequotas.NewService(machinePoolScope),
 	}
 }
 
@@ -40,7 +40,7 @@ const (
 func (s *Service) reconcileTags(cluster *eks.Cluster) error {
 	clusterTags := converters.MapPtrToMap(cluster.Tags)
 	buildParams := s.getEKSTagParams(*cluster.Arn)
-	tagsBuilder := tags.New(buildParams, tags.WithEKS(s.EKSClient))
+	tagsBuilder := tags.New(buildParams, tags.WithEKS(s.EKSClient), tags.WithRemovedKeys(s.scope.RemovedCostAllocationTagKeys()...))
 	if err := tagsBuilder.Ensure(clusterTags); err != nil {
 		return fmt.Errorf("failed ensuring tags on cluster: %w", err)
 	}
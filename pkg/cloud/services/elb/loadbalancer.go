@@ -33,6 +33,7 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/filter"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/wait"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/hash"
@@ -78,6 +79,9 @@ func (s *Service) ReconcileLoadbalancers() error {
 
 	apiELB, err := s.describeClassicELB(spec.Name)
 	switch {
+	case IsNotFound(err) && s.isExistingLoadBalancer():
+		// An existing load balancer is never created by the provider.
+		return errors.Wrapf(err, "the existing control plane load balancer %q of the AWSCluster %s is not found", spec.Name, s.scope.InfraClusterName())
 	case IsNotFound(err) && s.scope.ControlPlaneEndpoint().IsValid():
 		// if elb is not found and owner cluster ControlPlaneEndpoint is already populated, then we should not recreate the elb.
 		return errors.Wrapf(err, "no loadbalancer exists for the AWSCluster %s, the cluster has become unrecoverable and should be deleted manually", s.scope.InfraClusterName())
@@ -92,7 +96,18 @@ func (s *Service) ReconcileLoadbalancers() error {
 		return err
 	}
 
-	if apiELB.IsManaged(s.scope.Name()) {
+	if s.isExistingLoadBalancer() {
+		for k, v := range s.scope.ControlPlaneLoadBalancer().Existing.Tags {
+			if apiELB.Tags[k] != v {
+				return errors.Errorf("the existing control plane load balancer %q doesn't have the tag %s=%s", apiELB.Name, k, v)
+			}
+		}
+		if !hasListener(apiELB, spec.Listeners[0]) {
+			return errors.Errorf("the existing control plane load balancer %q has no listener forwarding port %d to the instance port %d",
+				apiELB.Name, spec.Listeners[0].Port, spec.Listeners[0].InstancePort)
+		}
+		s.scope.V(4).Info("Existing control plane load balancer, skipping load balancer configuration", "api-server-elb", apiELB)
+	} else if apiELB.IsManaged(s.scope.Name()) {
 		if !cmp.Equal(spec.Attributes, apiELB.Attributes) {
 			err := s.configureAttributes(apiELB.Name, spec.Attributes)
 			if err != nil {
@@ -154,6 +169,11 @@ func (s *Service) deleteAPIServerELB() error {
 		return err
 	}
 
+	if s.isExistingLoadBalancer() {
+		s.scope.V(2).Info("Found existing classic load balancer for apiserver, deregistering the cluster instances", "api-server-elb-name", elbName)
+		return s.deregisterClusterInstances(elbName)
+	}
+
 	apiELB, err := s.describeClassicELB(elbName)
 	if IsNotFound(err) {
 		return nil
@@ -281,12 +301,19 @@ func (s *Service) RegisterInstanceWithAPIServerELB(i *infrav1.Instance) error {
 	instanceAZ := subnet.AvailabilityZone
 
 	var subnets infrav1.Subnets
-	if s.scope.ControlPlaneLoadBalancer() != nil && len(s.scope.ControlPlaneLoadBalancer().Subnets) > 0 {
+	switch {
+	case s.isExistingLoadBalancer():
+		// The subnets of an existing load balancer aren't necessarily the ones of the cluster.
+		subnets, err = s.describeSubnets(out.SubnetIDs)
+		if err != nil {
+			return err
+		}
+	case s.scope.ControlPlaneLoadBalancer() != nil && len(s.scope.ControlPlaneLoadBalancer().Subnets) > 0:
 		subnets, err = s.getControlPlaneLoadBalancerSubnets()
 		if err != nil {
 			return err
 		}
-	} else {
+	default:
 		subnets = s.scope.Subnets()
 	}
 
@@ -312,10 +339,15 @@ func (s *Service) RegisterInstanceWithAPIServerELB(i *infrav1.Instance) error {
 
 // getControlPlaneLoadBalancerSubnets retrieves ControlPlaneLoadBalancer subnets information.
 func (s *Service) getControlPlaneLoadBalancerSubnets() (infrav1.Subnets, error) {
+	return s.describeSubnets(s.scope.ControlPlaneLoadBalancer().Subnets)
+}
+
+// describeSubnets retrieves the availability zones of the given subnets.
+func (s *Service) describeSubnets(ids []string) (infrav1.Subnets, error) {
 	var subnets infrav1.Subnets
 
 	input := &ec2.DescribeSubnetsInput{
-		SubnetIds: aws.StringSlice(s.scope.ControlPlaneLoadBalancer().Subnets),
+		SubnetIds: aws.StringSlice(ids),
 	}
 	res, err := s.EC2Client.DescribeSubnets(input)
 	if err != nil {
@@ -333,6 +365,75 @@ func (s *Service) getControlPlaneLoadBalancerSubnets() (infrav1.Subnets, error)
 	return subnets, nil
 }
 
+// hasListener returns whether the load balancer forwards the port of the listener to its instance port.
+func hasListener(lb *infrav1.ClassicELB, listener infrav1.ClassicELBListener) bool {
+	for _, l := range lb.Listeners {
+		if l.Port == listener.Port && l.InstancePort == listener.InstancePort {
+			return true
+		}
+	}
+	return false
+}
+
+// isExistingLoadBalancer returns whether the control plane load balancer was created outside of the provider.
+func (s *Service) isExistingLoadBalancer() bool {
+	return s.scope.ControlPlaneLoadBalancer() != nil && s.scope.ControlPlaneLoadBalancer().Existing != nil
+}
+
+// deregisterClusterInstances deregisters the instances owned by the cluster from the load balancer,
+// leaving the other registered instances untouched.
+func (s *Service) deregisterClusterInstances(name string) error {
+	out, err := s.ELBClient.DescribeLoadBalancers(&elb.DescribeLoadBalancersInput{
+		LoadBalancerNames: aws.StringSlice([]string{name}),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == elb.ErrCodeAccessPointNotFoundException {
+			return nil
+		}
+		return errors.Wrapf(err, "failed to describe classic load balancer %q", name)
+	}
+
+	var registered []*string
+	for _, lb := range out.LoadBalancerDescriptions {
+		for _, i := range lb.Instances {
+			registered = append(registered, i.InstanceId)
+		}
+	}
+	if len(registered) == 0 {
+		return nil
+	}
+
+	var instances []*elb.Instance
+	if err := s.EC2Client.DescribeInstancesPages(&ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			{Name: aws.String("instance-id"), Values: registered},
+			filter.EC2.ClusterOwned(s.scope.Name()),
+		},
+	}, func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
+		for _, r := range page.Reservations {
+			for _, i := range r.Instances {
+				instances = append(instances, &elb.Instance{InstanceId: i.InstanceId})
+			}
+		}
+		return true
+	}); err != nil {
+		return errors.Wrapf(err, "failed to describe the instances registered with load balancer %q", name)
+	}
+	if len(instances) == 0 {
+		return nil
+	}
+
+	if _, err := s.ELBClient.DeregisterInstancesFromLoadBalancer(&elb.DeregisterInstancesFromLoadBalancerInput{
+		Instances:        instances,
+		LoadBalancerName: aws.String(name),
+	}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedDeregisterInstances", "Failed to deregister the cluster instances from load balancer %q: %v", name, err)
+		return errors.Wrapf(err, "failed to deregister the cluster instances from load balancer %q", name)
+	}
+	s.scope.Info("Deregistered the cluster instances from the existing control plane load balancer", "name", name, "instances", len(instances))
+	return nil
+}
+
 // DeregisterInstanceFromAPIServerELB de-registers an instance from a classic ELB.
 func (s *Service) DeregisterInstanceFromAPIServerELB(i *infrav1.Instance) error {
 	name, err := ELBName(s.scope)
@@ -802,6 +903,18 @@ func fromSDKTypeToClassicELB(v *elb.LoadBalancerDescription, attrs *elb.LoadBala
 		Tags:             converters.ELBTagsToMap(tags),
 	}
 
+	for _, ld := range v.ListenerDescriptions {
+		if ld.Listener == nil {
+			continue
+		}
+		res.Listeners = append(res.Listeners, infrav1.ClassicELBListener{
+			Protocol:         infrav1.ClassicELBProtocol(aws.StringValue(ld.Listener.Protocol)),
+			Port:             aws.Int64Value(ld.Listener.LoadBalancerPort),
+			InstanceProtocol: infrav1.ClassicELBProtocol(aws.StringValue(ld.Listener.InstanceProtocol)),
+			InstancePort:     aws.Int64Value(ld.Listener.InstancePort),
+		})
+	}
+
 	if attrs.ConnectionSettings != nil && attrs.ConnectionSettings.IdleTimeout != nil {
 		res.Attributes.IdleTimeout = time.Duration(*attrs.ConnectionSettings.IdleTimeout) * time.Second
 	}
@@ -549,6 +549,88 @@ func TestRegisterInstanceWithAPIServerELB(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "existing load balancer with subnets outside of the cluster",
+			awsCluster: &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: clusterName},
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{{
+							ID:               clusterSubnetID,
+							AvailabilityZone: az,
+						}},
+					},
+					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+						Existing: &infrav1.ExistingLoadBalancer{
+							ARN: "arn:aws:elasticloadbalancing:us-west-1:123456789012:loadbalancer/corp-apiserver",
+						},
+					},
+				},
+			},
+			elbAPIMocks: func(m *mock_elbiface.MockELBAPIMockRecorder) {
+				m.DescribeLoadBalancers(gomock.Eq(&elb.DescribeLoadBalancersInput{
+					LoadBalancerNames: aws.StringSlice([]string{"corp-apiserver"}),
+				})).
+					Return(&elb.DescribeLoadBalancersOutput{
+						LoadBalancerDescriptions: []*elb.LoadBalancerDescription{
+							{
+								LoadBalancerName: aws.String("corp-apiserver"),
+								Scheme:           aws.String(string(infrav1.ClassicELBSchemeInternetFacing)),
+								Subnets:          []*string{aws.String("corp-subnet")},
+							},
+						},
+					}, nil)
+				m.DescribeLoadBalancerAttributes(gomock.Eq(&elb.DescribeLoadBalancerAttributesInput{
+					LoadBalancerName: aws.String("corp-apiserver"),
+				})).
+					Return(&elb.DescribeLoadBalancerAttributesOutput{
+						LoadBalancerAttributes: &elb.LoadBalancerAttributes{
+							CrossZoneLoadBalancing: &elb.CrossZoneLoadBalancing{
+								Enabled: aws.Bool(false),
+							},
+						},
+					}, nil)
+				m.DescribeTags(&elb.DescribeTagsInput{LoadBalancerNames: []*string{aws.String("corp-apiserver")}}).Return(
+					&elb.DescribeTagsOutput{
+						TagDescriptions: []*elb.TagDescription{
+							{
+								LoadBalancerName: aws.String("corp-apiserver"),
+								Tags: []*elb.Tag{{
+									Key:   aws.String("team"),
+									Value: aws.String("platform"),
+								}},
+							},
+						},
+					}, nil)
+
+				m.RegisterInstancesWithLoadBalancer(gomock.Eq(&elb.RegisterInstancesWithLoadBalancerInput{
+					Instances:        []*elb.Instance{{InstanceId: aws.String(instanceID)}},
+					LoadBalancerName: aws.String("corp-apiserver"),
+				})).
+					Return(&elb.RegisterInstancesWithLoadBalancerOutput{
+						Instances: []*elb.Instance{{InstanceId: aws.String(instanceID)}},
+					}, nil)
+			},
+			ec2Mocks: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeSubnets(gomock.Eq(&ec2.DescribeSubnetsInput{
+					SubnetIds: []*string{aws.String("corp-subnet")},
+				})).
+					Return(&ec2.DescribeSubnetsOutput{
+						Subnets: []*ec2.Subnet{
+							{
+								SubnetId:         aws.String("corp-subnet"),
+								AvailabilityZone: aws.String(az),
+							},
+						},
+					}, nil)
+			},
+			check: func(t *testing.T, err error) {
+				t.Helper()
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -603,7 +685,9 @@ func TestDeleteAPIServerELB(t *testing.T) {
 	elbName := "bar-apiserver"
 	tests := []struct {
 		name        string
+		existing    *infrav1.ExistingLoadBalancer
 		elbAPIMocks func(m *mock_elbiface.MockELBAPIMockRecorder)
+		ec2Mocks    func(m *mock_ec2iface.MockEC2APIMockRecorder)
 	}{
 		{
 			name: "if control plane ELB is not found, do nothing",
@@ -704,6 +788,52 @@ func TestDeleteAPIServerELB(t *testing.T) {
 				)
 			},
 		},
+		{
+			name:     "if control plane ELB is existing, deregister the cluster instances only",
+			existing: &infrav1.ExistingLoadBalancer{},
+			elbAPIMocks: func(m *mock_elbiface.MockELBAPIMockRecorder) {
+				m.DescribeLoadBalancers(&elb.DescribeLoadBalancersInput{LoadBalancerNames: []*string{aws.String(elbName)}}).Return(
+					&elb.DescribeLoadBalancersOutput{
+						LoadBalancerDescriptions: []*elb.LoadBalancerDescription{
+							{
+								LoadBalancerName: aws.String(elbName),
+								Instances: []*elb.Instance{
+									{InstanceId: aws.String("i-cluster")},
+									{InstanceId: aws.String("i-other")},
+								},
+							},
+						},
+					},
+					nil,
+				)
+
+				m.DeregisterInstancesFromLoadBalancer(&elb.DeregisterInstancesFromLoadBalancerInput{
+					Instances:        []*elb.Instance{{InstanceId: aws.String("i-cluster")}},
+					LoadBalancerName: aws.String(elbName),
+				}).Return(&elb.DeregisterInstancesFromLoadBalancerOutput{}, nil)
+			},
+			ec2Mocks: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeInstancesPages(&ec2.DescribeInstancesInput{
+					Filters: []*ec2.Filter{
+						{
+							Name:   aws.String("instance-id"),
+							Values: aws.StringSlice([]string{"i-cluster", "i-other"}),
+						},
+						{
+							Name:   aws.String("tag:" + infrav1.ClusterTagKey(clusterName)),
+							Values: aws.StringSlice([]string{string(infrav1.ResourceLifecycleOwned)}),
+						},
+					},
+				}, gomock.Any()).DoAndReturn(func(_ *ec2.DescribeInstancesInput, fn func(*ec2.DescribeInstancesOutput, bool) bool) error {
+					fn(&ec2.DescribeInstancesOutput{
+						Reservations: []*ec2.Reservation{
+							{Instances: []*ec2.Instance{{InstanceId: aws.String("i-cluster")}}},
+						},
+					}, true)
+					return nil
+				})
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -712,6 +842,7 @@ func TestDeleteAPIServerELB(t *testing.T) {
 			defer mockCtrl.Finish()
 			rgapiMock := mock_resourcegroupstaggingapiiface.NewMockResourceGroupsTaggingAPIAPI(mockCtrl)
 			elbapiMock := mock_elbiface.NewMockELBAPI(mockCtrl)
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
 
 			scheme, err := setupScheme()
 			if err != nil {
@@ -722,7 +853,8 @@ func TestDeleteAPIServerELB(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{Name: "test"},
 				Spec: infrav1.AWSClusterSpec{
 					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
-						Name: aws.String(elbName),
+						Name:     aws.String(elbName),
+						Existing: tc.existing,
 					},
 				},
 			}
@@ -746,11 +878,15 @@ func TestDeleteAPIServerELB(t *testing.T) {
 			}
 
 			tc.elbAPIMocks(elbapiMock.EXPECT())
+			if tc.ec2Mocks != nil {
+				tc.ec2Mocks(ec2Mock.EXPECT())
+			}
 
 			s := &Service{
 				scope:                 clusterScope,
 				ResourceTaggingClient: rgapiMock,
 				ELBClient:             elbapiMock,
+				EC2Client:             ec2Mock,
 			}
 
 			err = s.deleteAPIServerELB()
@@ -761,6 +897,216 @@ func TestDeleteAPIServerELB(t *testing.T) {
 	}
 }
 
+func TestReconcileExistingLoadBalancer(t *testing.T) {
+	const clusterName = "bar"
+
+	tests := []struct {
+		name        string
+		tags        infrav1.Tags
+		elbAPIMocks func(m *mock_elbiface.MockELBAPIMockRecorder)
+		expectErr   string
+	}{
+		{
+			name: "uses the existing load balancer without configuring it",
+			tags: infrav1.Tags{"team": "platform"},
+			elbAPIMocks: func(m *mock_elbiface.MockELBAPIMockRecorder) {
+				m.DescribeLoadBalancers(gomock.Eq(&elb.DescribeLoadBalancersInput{
+					LoadBalancerNames: aws.StringSlice([]string{"corp-apiserver"}),
+				})).
+					Return(&elb.DescribeLoadBalancersOutput{
+						LoadBalancerDescriptions: []*elb.LoadBalancerDescription{
+							{
+								LoadBalancerName: aws.String("corp-apiserver"),
+								Scheme:           aws.String(string(infrav1.ClassicELBSchemeInternetFacing)),
+								Subnets:          []*string{aws.String("corp-subnet")},
+								ListenerDescriptions: []*elb.ListenerDescription{{
+									Listener: &elb.Listener{
+										Protocol:         aws.String("TCP"),
+										LoadBalancerPort: aws.Int64(6443),
+										InstanceProtocol: aws.String("TCP"),
+										InstancePort:     aws.Int64(6443),
+									},
+								}},
+							},
+						},
+					}, nil)
+				m.DescribeLoadBalancerAttributes(gomock.Eq(&elb.DescribeLoadBalancerAttributesInput{
+					LoadBalancerName: aws.String("corp-apiserver"),
+				})).
+					Return(&elb.DescribeLoadBalancerAttributesOutput{
+						LoadBalancerAttributes: &elb.LoadBalancerAttributes{
+							CrossZoneLoadBalancing: &elb.CrossZoneLoadBalancing{
+								Enabled: aws.Bool(false),
+							},
+						},
+					}, nil)
+				m.DescribeTags(&elb.DescribeTagsInput{LoadBalancerNames: []*string{aws.String("corp-apiserver")}}).Return(
+					&elb.DescribeTagsOutput{
+						TagDescriptions: []*elb.TagDescription{
+							{
+								LoadBalancerName: aws.String("corp-apiserver"),
+								Tags: []*elb.Tag{{
+									Key:   aws.String("team"),
+									Value: aws.String("platform"),
+								}},
+							},
+						},
+					}, nil)
+			},
+		},
+		{
+			name: "fails when the existing load balancer has no listener for the API server port",
+			tags: infrav1.Tags{"team": "platform"},
+			elbAPIMocks: func(m *mock_elbiface.MockELBAPIMockRecorder) {
+				m.DescribeLoadBalancers(gomock.Eq(&elb.DescribeLoadBalancersInput{
+					LoadBalancerNames: aws.StringSlice([]string{"corp-apiserver"}),
+				})).
+					Return(&elb.DescribeLoadBalancersOutput{
+						LoadBalancerDescriptions: []*elb.LoadBalancerDescription{
+							{
+								LoadBalancerName: aws.String("corp-apiserver"),
+								Scheme:           aws.String(string(infrav1.ClassicELBSchemeInternetFacing)),
+								Subnets:          []*string{aws.String("corp-subnet")},
+								ListenerDescriptions: []*elb.ListenerDescription{{
+									Listener: &elb.Listener{
+										Protocol:         aws.String("TCP"),
+										LoadBalancerPort: aws.Int64(443),
+										InstanceProtocol: aws.String("TCP"),
+										InstancePort:     aws.Int64(6443),
+									},
+								}},
+							},
+						},
+					}, nil)
+				m.DescribeLoadBalancerAttributes(gomock.Eq(&elb.DescribeLoadBalancerAttributesInput{
+					LoadBalancerName: aws.String("corp-apiserver"),
+				})).
+					Return(&elb.DescribeLoadBalancerAttributesOutput{
+						LoadBalancerAttributes: &elb.LoadBalancerAttributes{
+							CrossZoneLoadBalancing: &elb.CrossZoneLoadBalancing{
+								Enabled: aws.Bool(false),
+							},
+						},
+					}, nil)
+				m.DescribeTags(&elb.DescribeTagsInput{LoadBalancerNames: []*string{aws.String("corp-apiserver")}}).Return(
+					&elb.DescribeTagsOutput{
+						TagDescriptions: []*elb.TagDescription{
+							{
+								LoadBalancerName: aws.String("corp-apiserver"),
+								Tags: []*elb.Tag{{
+									Key:   aws.String("team"),
+									Value: aws.String("platform"),
+								}},
+							},
+						},
+					}, nil)
+			},
+			expectErr: "has no listener forwarding port 6443",
+		},
+		{
+			name: "fails when the existing load balancer doesn't have the expected tags",
+			tags: infrav1.Tags{"team": "other"},
+			elbAPIMocks: func(m *mock_elbiface.MockELBAPIMockRecorder) {
+				m.DescribeLoadBalancers(gomock.Eq(&elb.DescribeLoadBalancersInput{
+					LoadBalancerNames: aws.StringSlice([]string{"corp-apiserver"}),
+				})).
+					Return(&elb.DescribeLoadBalancersOutput{
+						LoadBalancerDescriptions: []*elb.LoadBalancerDescription{
+							{
+								LoadBalancerName: aws.String("corp-apiserver"),
+								Scheme:           aws.String(string(infrav1.ClassicELBSchemeInternetFacing)),
+								Subnets:          []*string{aws.String("corp-subnet")},
+							},
+						},
+					}, nil)
+				m.DescribeLoadBalancerAttributes(gomock.Eq(&elb.DescribeLoadBalancerAttributesInput{
+					LoadBalancerName: aws.String("corp-apiserver"),
+				})).
+					Return(&elb.DescribeLoadBalancerAttributesOutput{
+						LoadBalancerAttributes: &elb.LoadBalancerAttributes{
+							CrossZoneLoadBalancing: &elb.CrossZoneLoadBalancing{
+								Enabled: aws.Bool(false),
+							},
+						},
+					}, nil)
+				m.DescribeTags(&elb.DescribeTagsInput{LoadBalancerNames: []*string{aws.String("corp-apiserver")}}).Return(
+					&elb.DescribeTagsOutput{
+						TagDescriptions: []*elb.TagDescription{
+							{
+								LoadBalancerName: aws.String("corp-apiserver"),
+								Tags: []*elb.Tag{{
+									Key:   aws.String("team"),
+									Value: aws.String("platform"),
+								}},
+							},
+						},
+					}, nil)
+			},
+			expectErr: "doesn't have the tag team=other",
+		},
+		{
+			name: "fails without creating a load balancer when the existing one is not found",
+			elbAPIMocks: func(m *mock_elbiface.MockELBAPIMockRecorder) {
+				m.DescribeLoadBalancers(gomock.Eq(&elb.DescribeLoadBalancersInput{
+					LoadBalancerNames: aws.StringSlice([]string{"corp-apiserver"}),
+				})).Return(nil, awserr.New(elb.ErrCodeAccessPointNotFoundException, "", nil))
+			},
+			expectErr: "the existing control plane load balancer \"corp-apiserver\"",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			elbAPIMocks := mock_elbiface.NewMockELBAPI(mockCtrl)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+
+			awsCluster := &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+						Existing: &infrav1.ExistingLoadBalancer{
+							ARN:  "arn:aws:elasticloadbalancing:us-west-1:123456789012:loadbalancer/corp-apiserver",
+							Tags: tc.tags,
+						},
+					},
+				},
+			}
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "foo",
+						Name:      clusterName,
+					},
+				},
+				AWSCluster: awsCluster,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.elbAPIMocks(elbAPIMocks.EXPECT())
+
+			s := &Service{
+				scope:     clusterScope,
+				ELBClient: elbAPIMocks,
+			}
+
+			err = s.ReconcileLoadbalancers()
+			if tc.expectErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tc.expectErr)))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(awsCluster.Status.Network.APIServerELB.Name).To(Equal("corp-apiserver"))
+		})
+	}
+}
+
 func TestDeleteAWSCloudProviderELBs(t *testing.T) {
 	clusterName := "bar"
 	tests := []struct {
@@ -0,0 +1,185 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gputimeslicing
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/internal/managed"
+)
+
+const (
+	defaultConfigMapName = "nvidia-device-plugin-config"
+	defaultNamespace     = metav1.NamespaceSystem
+
+	// configKey is the ConfigMap key of the device plugin config. The device plugin uses the
+	// only config of its ConfigMap as the default for all nodes.
+	configKey = "any"
+
+	gpuResourceName = "nvidia.com/gpu"
+)
+
+// devicePluginConfig is the subset of the NVIDIA device plugin config file used for time-slicing.
+type devicePluginConfig struct {
+	Version string  `json:"version"`
+	Sharing sharing `json:"sharing"`
+}
+
+type sharing struct {
+	TimeSlicing timeSlicing `json:"timeSlicing"`
+}
+
+type timeSlicing struct {
+	RenameByDefault bool                 `json:"renameByDefault"`
+	Resources       []replicatedResource `json:"resources"`
+}
+
+type replicatedResource struct {
+	Name     string `json:"name"`
+	Replicas int32  `json:"replicas"`
+}
+
+// ReconcileGPUTimeSlicing ensures the NVIDIA device plugin ConfigMap holds the configured
+// time-slicing config, and removes the ConfigMaps previously created by CAPA that are no longer
+// wanted.
+func (s *Service) ReconcileGPUTimeSlicing(ctx context.Context) error {
+	s.scope.Info("Reconciling GPU time-slicing config in cluster", "cluster-name", s.scope.Name(), "cluster-namespace", s.scope.Namespace())
+
+	remoteClient, err := s.scope.RemoteClient()
+	if err != nil {
+		s.scope.Error(err, "getting client for remote cluster")
+		return fmt.Errorf("getting client for remote cluster: %w", err)
+	}
+
+	var desired *corev1.ConfigMap
+	if cfg := s.scope.GPUTimeSlicing(); cfg != nil {
+		desired, err = s.configMap(cfg)
+		if err != nil {
+			return fmt.Errorf("generating device plugin config: %w", err)
+		}
+		if err := s.ensureConfigMap(ctx, remoteClient, desired); err != nil {
+			return err
+		}
+	}
+
+	return s.deleteStaleConfigMaps(ctx, remoteClient, desired)
+}
+
+func (s *Service) ensureConfigMap(ctx context.Context, remoteClient client.Client, desired *corev1.ConfigMap) error {
+	current := &corev1.ConfigMap{}
+	err := remoteClient.Get(ctx, client.ObjectKeyFromObject(desired), current)
+	if apierrors.IsNotFound(err) {
+		s.scope.Info("Creating device plugin ConfigMap", "namespace", desired.Namespace, "name", desired.Name)
+		if err := remoteClient.Create(ctx, desired, &client.CreateOptions{}); err != nil {
+			return fmt.Errorf("creating device plugin ConfigMap: %w", err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("getting device plugin ConfigMap: %w", err)
+	}
+
+	if !managed.HasLabels(current, s.metaLabels()) {
+		s.scope.Info("Skipping, device plugin ConfigMap is not managed by CAPA", "namespace", current.Namespace, "name", current.Name)
+		return nil
+	}
+	if current.Data[configKey] == desired.Data[configKey] && len(current.Data) == 1 {
+		return nil
+	}
+
+	s.scope.Info("Updating device plugin ConfigMap", "namespace", desired.Namespace, "name", desired.Name)
+	current.Data = desired.Data
+	if err := remoteClient.Update(ctx, current, &client.UpdateOptions{}); err != nil {
+		return fmt.Errorf("updating device plugin ConfigMap: %w", err)
+	}
+	return nil
+}
+
+// deleteStaleConfigMaps deletes the ConfigMaps created by CAPA other than the desired one, after
+// the ConfigMap was renamed, moved or the time-slicing config was removed.
+func (s *Service) deleteStaleConfigMaps(ctx context.Context, remoteClient client.Client, desired *corev1.ConfigMap) error {
+	configMaps := &corev1.ConfigMapList{}
+	if err := remoteClient.List(ctx, configMaps, client.MatchingLabels(s.metaLabels())); err != nil {
+		return fmt.Errorf("listing device plugin ConfigMaps: %w", err)
+	}
+
+	for i := range configMaps.Items {
+		cm := &configMaps.Items[i]
+		if desired != nil && cm.Namespace == desired.Namespace && cm.Name == desired.Name {
+			continue
+		}
+
+		s.scope.Info("Deleting device plugin ConfigMap", "namespace", cm.Namespace, "name", cm.Name)
+		if err := remoteClient.Delete(ctx, cm, &client.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("deleting device plugin ConfigMap: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) configMap(cfg *ekscontrolplanev1.GPUTimeSlicing) (*corev1.ConfigMap, error) {
+	name := cfg.ConfigMapName
+	if name == "" {
+		name = defaultConfigMapName
+	}
+	namespace := cfg.Namespace
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+
+	config, err := yaml.Marshal(devicePluginConfig{
+		Version: "v1",
+		Sharing: sharing{
+			TimeSlicing: timeSlicing{
+				RenameByDefault: cfg.RenameByDefault,
+				Resources: []replicatedResource{{
+					Name:     gpuResourceName,
+					Replicas: cfg.Replicas,
+				}},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    s.metaLabels(),
+		},
+		Data: map[string]string{
+			configKey: string(config),
+		},
+	}, nil
+}
+
+func (s *Service) metaLabels() map[string]string {
+	labels := managed.Labels(s.scope.Name())
+	labels["app.kubernetes.io/component"] = "gpu-time-slicing"
+	return labels
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gputimeslicing
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+)
+
+func TestReconcileGPUTimeSlicing(t *testing.T) {
+	managedLabels := map[string]string{
+		"app.kubernetes.io/managed-by": "cluster-api-provider-aws",
+		"app.kubernetes.io/part-of":    "mock-name",
+		"app.kubernetes.io/component":  "gpu-time-slicing",
+	}
+	fourReplicas := `sharing:
+  timeSlicing:
+    renameByDefault: false
+    resources:
+    - name: nvidia.com/gpu
+      replicas: 4
+version: v1
+`
+
+	tests := []struct {
+		name       string
+		cfg        *ekscontrolplanev1.GPUTimeSlicing
+		existing   []client.Object
+		expectData map[string]map[string]string
+	}{
+		{
+			name:       "not configured",
+			expectData: map[string]map[string]string{},
+		},
+		{
+			name: "creates the ConfigMap with the defaults",
+			cfg:  &ekscontrolplanev1.GPUTimeSlicing{Replicas: 4},
+			expectData: map[string]map[string]string{
+				"kube-system/nvidia-device-plugin-config": {"any": fourReplicas},
+			},
+		},
+		{
+			name: "creates the ConfigMap with renamed resources",
+			cfg: &ekscontrolplanev1.GPUTimeSlicing{
+				ConfigMapName:   "time-slicing",
+				Namespace:       "nvidia",
+				Replicas:        2,
+				RenameByDefault: true,
+			},
+			expectData: map[string]map[string]string{
+				"nvidia/time-slicing": {"any": `sharing:
+  timeSlicing:
+    renameByDefault: true
+    resources:
+    - name: nvidia.com/gpu
+      replicas: 2
+version: v1
+`},
+			},
+		},
+		{
+			name: "updates the replica count",
+			cfg:  &ekscontrolplanev1.GPUTimeSlicing{Replicas: 4},
+			existing: []client.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "nvidia-device-plugin-config", Namespace: "kube-system", Labels: managedLabels},
+					Data:       map[string]string{"any": "version: v1\n", "stale": "x"},
+				},
+			},
+			expectData: map[string]map[string]string{
+				"kube-system/nvidia-device-plugin-config": {"any": fourReplicas},
+			},
+		},
+		{
+			name: "leaves an unmanaged ConfigMap alone",
+			cfg:  &ekscontrolplanev1.GPUTimeSlicing{Replicas: 4},
+			existing: []client.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "nvidia-device-plugin-config", Namespace: "kube-system"},
+					Data:       map[string]string{"config.yaml": "version: v1\n"},
+				},
+			},
+			expectData: map[string]map[string]string{
+				"kube-system/nvidia-device-plugin-config": {"config.yaml": "version: v1\n"},
+			},
+		},
+		{
+			name: "deletes the previous ConfigMap when renamed",
+			cfg:  &ekscontrolplanev1.GPUTimeSlicing{Replicas: 4},
+			existing: []client.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "time-slicing", Namespace: "nvidia", Labels: managedLabels},
+					Data:       map[string]string{"any": fourReplicas},
+				},
+			},
+			expectData: map[string]map[string]string{
+				"kube-system/nvidia-device-plugin-config": {"any": fourReplicas},
+			},
+		},
+		{
+			name: "deletes the managed ConfigMap when no longer configured",
+			existing: []client.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "nvidia-device-plugin-config", Namespace: "kube-system", Labels: managedLabels},
+					Data:       map[string]string{"any": fourReplicas},
+				},
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "kube-system"},
+				},
+			},
+			expectData: map[string]map[string]string{
+				"kube-system/other": nil,
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+			remoteClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tc.existing...).Build()
+
+			s := NewService(&mockScope{
+				client: remoteClient,
+				cfg:    tc.cfg,
+			})
+
+			// Reconcile twice to ensure the reconcile is idempotent.
+			for i := 0; i < 2; i++ {
+				g.Expect(s.ReconcileGPUTimeSlicing(context.Background())).To(Succeed())
+			}
+
+			configMaps := &corev1.ConfigMapList{}
+			g.Expect(remoteClient.List(context.Background(), configMaps)).To(Succeed())
+			data := map[string]map[string]string{}
+			for _, cm := range configMaps.Items {
+				data[cm.Namespace+"/"+cm.Name] = cm.Data
+			}
+			g.Expect(data).To(Equal(tc.expectData))
+		})
+	}
+}
+
+type mockScope struct {
+	scope.GPUTimeSlicingScope
+	client client.Client
+	cfg    *ekscontrolplanev1.GPUTimeSlicing
+}
+
+func (s *mockScope) RemoteClient() (client.Client, error) {
+	return s.client, nil
+}
+
+func (s *mockScope) GPUTimeSlicing() *ekscontrolplanev1.GPUTimeSlicing {
+	return s.cfg
+}
+
+func (s *mockScope) Info(msg string, keysAndValues ...interface{}) {
+
+}
+
+func (s *mockScope) Name() string {
+	return "mock-name"
+}
+
+func (s *mockScope) Namespace() string {
+	return "mock-namespace"
+}
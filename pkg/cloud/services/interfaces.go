@@ -17,9 +17,14 @@ limitations under the License.
 package services
 
 import (
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/servicequotas"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 const (
@@ -40,6 +45,8 @@ type ASGInterface interface {
 	CanStartASGInstanceRefresh(scope *scope.MachinePoolScope) (bool, error)
 	UpdateResourceTags(resourceID *string, create, remove map[string]string) error
 	DeleteASGAndWait(id string) error
+	DescribeScalingActivities(name string, since *metav1.Time, maxRecords int64) ([]*autoscaling.Activity, error)
+	ReconcilePredictiveScalingPolicy(scope *scope.MachinePoolScope) error
 }
 
 // EC2Interface encapsulates the methods exposed to the machine
@@ -110,3 +117,9 @@ type ObjectStoreInterface interface {
 	Delete(m *scope.MachineScope) error
 	Create(m *scope.MachineScope, data []byte) (objectURL string, err error)
 }
+
+// ServiceQuotaInterface encapsulates the methods exposed to the machine and machinepool
+// actuators.
+type ServiceQuotaInterface interface {
+	ReconcileHeadroom(obj conditions.Setter, req servicequotas.Request) error
+}
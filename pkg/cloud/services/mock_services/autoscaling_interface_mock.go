@@ -23,7 +23,9 @@ package mock_services
 import (
 	reflect "reflect"
 
+	autoscaling "github.com/aws/aws-sdk-go/service/autoscaling"
 	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1beta1 "sigs.k8s.io/cluster-api-provider-aws/exp/api/v1beta1"
 	scope "sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
 )
@@ -110,6 +112,21 @@ func (mr *MockASGInterfaceMockRecorder) DeleteASGAndWait(arg0 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteASGAndWait", reflect.TypeOf((*MockASGInterface)(nil).DeleteASGAndWait), arg0)
 }
 
+// DescribeScalingActivities mocks base method.
+func (m *MockASGInterface) DescribeScalingActivities(arg0 string, arg1 *v1.Time, arg2 int64) ([]*autoscaling.Activity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeScalingActivities", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*autoscaling.Activity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeScalingActivities indicates an expected call of DescribeScalingActivities.
+func (mr *MockASGInterfaceMockRecorder) DescribeScalingActivities(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeScalingActivities", reflect.TypeOf((*MockASGInterface)(nil).DescribeScalingActivities), arg0, arg1, arg2)
+}
+
 // GetASGByName mocks base method.
 func (m *MockASGInterface) GetASGByName(arg0 *scope.MachinePoolScope) (*v1beta1.AutoScalingGroup, error) {
 	m.ctrl.T.Helper()
@@ -125,6 +142,20 @@ func (mr *MockASGInterfaceMockRecorder) GetASGByName(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetASGByName", reflect.TypeOf((*MockASGInterface)(nil).GetASGByName), arg0)
 }
 
+// ReconcilePredictiveScalingPolicy mocks base method.
+func (m *MockASGInterface) ReconcilePredictiveScalingPolicy(arg0 *scope.MachinePoolScope) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcilePredictiveScalingPolicy", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcilePredictiveScalingPolicy indicates an expected call of ReconcilePredictiveScalingPolicy.
+func (mr *MockASGInterfaceMockRecorder) ReconcilePredictiveScalingPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcilePredictiveScalingPolicy", reflect.TypeOf((*MockASGInterface)(nil).ReconcilePredictiveScalingPolicy), arg0)
+}
+
 // StartASGInstanceRefresh mocks base method.
 func (m *MockASGInterface) StartASGInstanceRefresh(arg0 *scope.MachinePoolScope) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,174 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+)
+
+// reconcileBlackholeNetworkInterface returns the id of the network interface targeted by the blackhole
+// routes, creating it when blackhole CIDR blocks are configured. The network interface is never attached
+// to an instance, so AWS drops the traffic routed to it.
+func (s *Service) reconcileBlackholeNetworkInterface() (string, error) {
+	if id := s.scope.Network().BlackholeNetworkInterfaceID; id != "" || len(s.scope.VPC().BlackholeCIDRs) == 0 {
+		return id, nil
+	}
+
+	// Recover the network interface of a previous reconciliation whose status wasn't persisted.
+	out, err := s.EC2Client.DescribeNetworkInterfaces(&ec2.DescribeNetworkInterfacesInput{
+		Filters: []*ec2.Filter{
+			filter.EC2.VPC(s.scope.VPC().ID),
+			filter.EC2.ClusterOwned(s.scope.Name()),
+			filter.EC2.ProviderRole(infrav1.BlackholeRoleTagValue),
+		},
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to describe blackhole network interface in vpc %q", s.scope.VPC().ID)
+	}
+	if len(out.NetworkInterfaces) > 0 {
+		s.scope.Network().BlackholeNetworkInterfaceID = aws.StringValue(out.NetworkInterfaces[0].NetworkInterfaceId)
+		return s.scope.Network().BlackholeNetworkInterfaceID, nil
+	}
+
+	subnets := s.scope.Subnets()
+	if len(subnets) == 0 {
+		return "", errors.Errorf("failed to create blackhole network interface: no subnets in vpc %q", s.scope.VPC().ID)
+	}
+
+	created, err := s.EC2Client.CreateNetworkInterface(&ec2.CreateNetworkInterfaceInput{
+		SubnetId:    aws.String(subnets[0].ID),
+		Description: aws.String(fmt.Sprintf("Target of the blackhole routes of cluster %s", s.scope.Name())),
+		TagSpecifications: []*ec2.TagSpecification{
+			tags.BuildParamsToTagSpecification(ec2.ResourceTypeNetworkInterface, s.getBlackholeTagParams()),
+		},
+	})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateBlackholeNetworkInterface", "Failed to create blackhole network interface: %v", err)
+		return "", errors.Wrap(err, "failed to create blackhole network interface")
+	}
+
+	id := aws.StringValue(created.NetworkInterface.NetworkInterfaceId)
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateBlackholeNetworkInterface", "Created blackhole network interface %q", id)
+	s.scope.Network().BlackholeNetworkInterfaceID = id
+	return id, nil
+}
+
+// blackholeCIDRs returns the blackhole CIDR blocks normalized to their network address, the way
+// AWS returns the destinations of the routes. Invalid and duplicate CIDR blocks are skipped.
+func (s *Service) blackholeCIDRs() []string {
+	cidrs := make([]string, 0, len(s.scope.VPC().BlackholeCIDRs))
+	seen := make(map[string]bool, len(s.scope.VPC().BlackholeCIDRs))
+	for _, cidr := range s.scope.VPC().BlackholeCIDRs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil || seen[ipNet.String()] {
+			continue
+		}
+		seen[ipNet.String()] = true
+		cidrs = append(cidrs, ipNet.String())
+	}
+	return cidrs
+}
+
+// getBlackholeRoutes returns the routes of the blackhole CIDR blocks to the given network interface.
+func (s *Service) getBlackholeRoutes(networkInterfaceID string) []*ec2.Route {
+	if networkInterfaceID == "" {
+		return nil
+	}
+
+	cidrs := s.blackholeCIDRs()
+	routes := make([]*ec2.Route, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		routes = append(routes, &ec2.Route{
+			DestinationCidrBlock: aws.String(cidr),
+			NetworkInterfaceId:   aws.String(networkInterfaceID),
+		})
+	}
+	return routes
+}
+
+// deleteStaleBlackholeRoutes deletes the routes of the table to the blackhole network interface whose
+// CIDR block is no longer configured.
+func (s *Service) deleteStaleBlackholeRoutes(rt *ec2.RouteTable, networkInterfaceID string) error {
+	if networkInterfaceID == "" {
+		return nil
+	}
+
+	desired := make(map[string]bool)
+	for _, cidr := range s.blackholeCIDRs() {
+		desired[cidr] = true
+	}
+
+	for _, r := range rt.Routes {
+		if aws.StringValue(r.NetworkInterfaceId) != networkInterfaceID || r.DestinationCidrBlock == nil || desired[*r.DestinationCidrBlock] {
+			continue
+		}
+
+		if _, err := s.EC2Client.DeleteRoute(&ec2.DeleteRouteInput{
+			RouteTableId:         rt.RouteTableId,
+			DestinationCidrBlock: r.DestinationCidrBlock,
+		}); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedDeleteRoute", "Failed to delete blackhole route %q from RouteTable %q: %v", *r.DestinationCidrBlock, *rt.RouteTableId, err)
+			return errors.Wrapf(err, "failed to delete blackhole route %q from route table %q", *r.DestinationCidrBlock, *rt.RouteTableId)
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteRoute", "Deleted blackhole route %q from RouteTable %q", *r.DestinationCidrBlock, *rt.RouteTableId)
+	}
+
+	return nil
+}
+
+// deleteBlackholeNetworkInterface deletes the blackhole network interface, if any.
+func (s *Service) deleteBlackholeNetworkInterface() error {
+	id := s.scope.Network().BlackholeNetworkInterfaceID
+	if id == "" {
+		return nil
+	}
+
+	if _, err := s.EC2Client.DeleteNetworkInterface(&ec2.DeleteNetworkInterfaceInput{
+		NetworkInterfaceId: aws.String(id),
+	}); err != nil {
+		if code, ok := awserrors.Code(errors.Cause(err)); !ok || code != awserrors.NetworkInterfaceNotFound {
+			record.Warnf(s.scope.InfraCluster(), "FailedDeleteBlackholeNetworkInterface", "Failed to delete blackhole network interface %q: %v", id, err)
+			return errors.Wrapf(err, "failed to delete blackhole network interface %q", id)
+		}
+	} else {
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteBlackholeNetworkInterface", "Deleted blackhole network interface %q", id)
+	}
+
+	s.scope.Network().BlackholeNetworkInterfaceID = ""
+	return nil
+}
+
+func (s *Service) getBlackholeTagParams() infrav1.BuildParams {
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(fmt.Sprintf("%s-blackhole", s.scope.Name())),
+		Role:        aws.String(infrav1.BlackholeRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
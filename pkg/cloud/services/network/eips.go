@@ -25,6 +25,7 @@ import (
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/converters"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/filter"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/wait"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/tags"
@@ -55,6 +56,27 @@ func (s *Service) getOrAllocateAddresses(num int, role string) (eips []string, e
 	return eips, nil
 }
 
+// reconcileAddressTags makes sure the tags of the Elastic IP addresses of the cluster with the given role are up to date.
+func (s *Service) reconcileAddressTags(role string) error {
+	out, err := s.describeAddresses(role)
+	if err != nil {
+		record.Eventf(s.scope.InfraCluster(), "FailedDescribeAddresses", "Failed to query addresses for role %q: %v", role, err)
+		return errors.Wrap(err, "failed to query addresses")
+	}
+
+	for _, address := range out.Addresses {
+		buildParams := s.getEIPTagParams(role)
+		buildParams.ResourceID = aws.StringValue(address.AllocationId)
+		tagsBuilder := tags.New(&buildParams, tags.WithEC2(s.EC2Client), tags.WithRemovedKeys(s.scope.RemovedCostAllocationTagKeys()...))
+		if err := tagsBuilder.Ensure(converters.TagsToMap(address.Tags)); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedTagEIP", "Failed to tag Elastic IP %q: %v", buildParams.ResourceID, err)
+			return errors.Wrapf(err, "failed to tag Elastic IP %q", buildParams.ResourceID)
+		}
+	}
+
+	return nil
+}
+
 func (s *Service) allocateAddress(role string) (string, error) {
 	tagSpecifications := tags.BuildParamsToTagSpecification(ec2.ResourceTypeElasticIp, s.getEIPTagParams(role))
 	out, err := s.EC2Client.AllocateAddress(&ec2.AllocateAddressInput{
@@ -25,6 +25,7 @@ import (
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -172,3 +173,137 @@ func TestService_releaseAddresses(t *testing.T) {
 		})
 	}
 }
+
+func TestService_reconcileAddressTags(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	addressTags := func(additional map[string]string) []*ec2.Tag {
+		tags := []*ec2.Tag{
+			{
+				Key:   aws.String("Name"),
+				Value: aws.String("test-cluster-eip-apiserver"),
+			},
+			{
+				Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+				Value: aws.String("owned"),
+			},
+			{
+				Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+				Value: aws.String("apiserver"),
+			},
+		}
+		for k, v := range additional {
+			tags = append(tags, &ec2.Tag{Key: aws.String(k), Value: aws.String(v)})
+		}
+		return tags
+	}
+
+	tests := []struct {
+		name               string
+		costAllocationTags infrav1.Tags
+		lastApplied        string
+		expect             func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		wantErr            bool
+	}{
+		{
+			name:               "Should not tag the IP addresses when their tags are up to date",
+			costAllocationTags: infrav1.Tags{"team": "a"},
+			lastApplied:        `["team"]`,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeAddresses(gomock.AssignableToTypeOf(&ec2.DescribeAddressesInput{})).Return(&ec2.DescribeAddressesOutput{
+					Addresses: []*ec2.Address{
+						{
+							AllocationId: aws.String("allocation-id"),
+							Tags:         addressTags(map[string]string{"team": "a"}),
+						},
+					},
+				}, nil)
+			},
+		},
+		{
+			name:               "Should add the cost allocation tags missing from the IP addresses",
+			costAllocationTags: infrav1.Tags{"team": "b"},
+			lastApplied:        `["team"]`,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeAddresses(gomock.AssignableToTypeOf(&ec2.DescribeAddressesInput{})).Return(&ec2.DescribeAddressesOutput{
+					Addresses: []*ec2.Address{
+						{
+							AllocationId: aws.String("allocation-id"),
+							Tags:         addressTags(map[string]string{"team": "a"}),
+						},
+					},
+				}, nil)
+				m.CreateTags(gomock.Eq(&ec2.CreateTagsInput{
+					Resources: aws.StringSlice([]string{"allocation-id"}),
+					Tags:      append(addressTags(nil), &ec2.Tag{Key: aws.String("team"), Value: aws.String("b")}),
+				})).Return(nil, nil)
+			},
+		},
+		{
+			name:               "Should remove the deleted cost allocation tags from the IP addresses",
+			costAllocationTags: infrav1.Tags{"team": "a"},
+			lastApplied:        `["cost-center","team"]`,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeAddresses(gomock.AssignableToTypeOf(&ec2.DescribeAddressesInput{})).Return(&ec2.DescribeAddressesOutput{
+					Addresses: []*ec2.Address{
+						{
+							AllocationId: aws.String("allocation-id"),
+							Tags:         addressTags(map[string]string{"cost-center": "1234", "team": "a"}),
+						},
+					},
+				}, nil)
+				m.DeleteTags(gomock.Eq(&ec2.DeleteTagsInput{
+					Resources: aws.StringSlice([]string{"allocation-id"}),
+					Tags:      []*ec2.Tag{{Key: aws.String("cost-center")}},
+				})).Return(nil, nil)
+			},
+		},
+		{
+			name: "Should return error if failed to describe IP addresses",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeAddresses(gomock.AssignableToTypeOf(&ec2.DescribeAddressesInput{})).Return(nil, awserrors.NewFailedDependency("dependency failure"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			err := infrav1.AddToScheme(scheme)
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+			awsCluster := &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					CostAllocationTags: tt.costAllocationTags,
+				},
+			}
+			if tt.lastApplied != "" {
+				awsCluster.Annotations = map[string]string{infrav1.CostAllocationTagsLastAppliedAnnotation: tt.lastApplied}
+			}
+
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: awsCluster,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(cs)
+			s.EC2Client = ec2Mock
+
+			tt.expect(ec2Mock.EXPECT())
+
+			if err := s.reconcileAddressTags(infrav1.APIServerRoleTagValue); (err != nil) != tt.wantErr {
+				t.Errorf("reconcileAddressTags() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
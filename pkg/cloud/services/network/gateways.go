@@ -63,7 +63,7 @@ func (s *Service) reconcileInternetGateways() error {
 	// Make sure tags are up to date.
 	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
 		buildParams := s.getGatewayTagParams(*gateway.InternetGatewayId)
-		tagsBuilder := tags.New(&buildParams, tags.WithEC2(s.EC2Client))
+		tagsBuilder := tags.New(&buildParams, tags.WithEC2(s.EC2Client), tags.WithRemovedKeys(s.scope.RemovedCostAllocationTagKeys()...))
 		if err := tagsBuilder.Ensure(converters.TagsToMap(gateway.Tags)); err != nil {
 			return false, err
 		}
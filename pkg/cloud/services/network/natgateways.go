@@ -29,6 +29,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/converters"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/filter"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/servicequotas"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/wait"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/tags"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
@@ -80,7 +81,7 @@ func (s *Service) reconcileNatGateways() error {
 			// Make sure tags are up to date.
 			if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
 				buildParams := s.getNatGatewayTagParams(*ngw.NatGatewayId)
-				tagsBuilder := tags.New(&buildParams, tags.WithEC2(s.EC2Client))
+				tagsBuilder := tags.New(&buildParams, tags.WithEC2(s.EC2Client), tags.WithRemovedKeys(s.scope.RemovedCostAllocationTagKeys()...))
 				if err := tagsBuilder.Ensure(converters.TagsToMap(ngw.Tags)); err != nil {
 					return false, err
 				}
@@ -96,8 +97,19 @@ func (s *Service) reconcileNatGateways() error {
 		subnetIDs = append(subnetIDs, sn.ID)
 	}
 
+	// Make sure tags of the Elastic IP addresses are up to date.
+	if !s.scope.VPC().NatGateway.IsPrivate() {
+		if err := s.reconcileAddressTags(infrav1.APIServerRoleTagValue); err != nil {
+			return err
+		}
+	}
+
 	// Batch the creation of NAT gateways
 	if len(subnetIDs) > 0 {
+		if err := s.reconcileNatGatewayQuotas(subnetIDs); err != nil {
+			return errors.Wrap(err, "failed to check service quotas for NAT gateways")
+		}
+
 		// set NatGatewayCreationStarted if the condition has never been set before
 		if !conditions.Has(s.scope.InfraCluster(), infrav1.NatGatewaysReadyCondition) {
 			conditions.MarkFalse(s.scope.InfraCluster(), infrav1.NatGatewaysReadyCondition, infrav1.NatGatewaysCreationStartedReason, clusterv1.ConditionSeverityInfo, "")
@@ -209,10 +221,43 @@ func (s *Service) getNatGatewayTagParams(id string) infrav1.BuildParams {
 	}
 }
 
+// reconcileNatGatewayQuotas checks the headroom of the service quotas for the NAT gateways to create in the
+// given subnets and their Elastic IP addresses, when enabled for the cluster.
+func (s *Service) reconcileNatGatewayQuotas(subnetIDs []string) error {
+	if s.scope.ServiceQuotaChecks() == nil {
+		return nil
+	}
+
+	req := servicequotas.Request{NATGateways: map[string]int64{}}
+	for _, subnetID := range subnetIDs {
+		req.NATGateways[s.scope.Subnets().FindByID(subnetID).AvailabilityZone]++
+	}
+
+	// The unassociated Elastic IP addresses of the cluster are reused.
+	if !s.scope.VPC().NatGateway.IsPrivate() {
+		out, err := s.describeAddresses(infrav1.APIServerRoleTagValue)
+		if err != nil {
+			return errors.Wrap(err, "failed to query addresses")
+		}
+		req.ElasticIPs = int64(len(subnetIDs))
+		for _, address := range out.Addresses {
+			if address.AssociationId == nil {
+				req.ElasticIPs--
+			}
+		}
+	}
+
+	return s.ServiceQuotaService.ReconcileHeadroom(s.scope.InfraCluster(), req)
+}
+
 func (s *Service) createNatGateways(subnetIDs []string) (natgateways []*ec2.NatGateway, err error) {
-	eips, err := s.getOrAllocateAddresses(len(subnetIDs), infrav1.APIServerRoleTagValue)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to create one or more IP addresses for NAT gateways")
+	// Private NAT gateways have no Elastic IP address.
+	eips := make([]string, len(subnetIDs))
+	if !s.scope.VPC().NatGateway.IsPrivate() {
+		eips, err = s.getOrAllocateAddresses(len(subnetIDs), infrav1.APIServerRoleTagValue)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create one or more IP addresses for NAT gateways")
+		}
 	}
 	type ngwCreation struct {
 		natGateway *ec2.NatGateway
@@ -241,12 +286,18 @@ func (s *Service) createNatGateway(subnetID, ip string) (*ec2.NatGateway, error)
 	var out *ec2.CreateNatGatewayOutput
 	var err error
 
+	input := &ec2.CreateNatGatewayInput{
+		SubnetId:          aws.String(subnetID),
+		TagSpecifications: []*ec2.TagSpecification{tags.BuildParamsToTagSpecification(ec2.ResourceTypeNatgateway, s.getNatGatewayTagParams(services.TemporaryResourceID))},
+	}
+	if s.scope.VPC().NatGateway.IsPrivate() {
+		input.ConnectivityType = aws.String(ec2.ConnectivityTypePrivate)
+	} else {
+		input.AllocationId = aws.String(ip)
+	}
+
 	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
-		if out, err = s.EC2Client.CreateNatGateway(&ec2.CreateNatGatewayInput{
-			SubnetId:          aws.String(subnetID),
-			AllocationId:      aws.String(ip),
-			TagSpecifications: []*ec2.TagSpecification{tags.BuildParamsToTagSpecification(ec2.ResourceTypeNatgateway, s.getNatGatewayTagParams(services.TemporaryResourceID))},
-		}); err != nil {
+		if out, err = s.EC2Client.CreateNatGateway(input); err != nil {
 			return false, err
 		}
 		return true, nil
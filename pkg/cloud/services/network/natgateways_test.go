@@ -24,6 +24,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -32,6 +33,8 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/mock_services"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/servicequotas"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 )
 
@@ -44,9 +47,10 @@ func TestReconcileNatGateways(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	testCases := []struct {
-		name   string
-		input  []infrav1.SubnetSpec
-		expect func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		name       string
+		input      []infrav1.SubnetSpec
+		natGateway *infrav1.NatGatewaySpec
+		expect     func(m *mock_ec2iface.MockEC2APIMockRecorder)
 	}{
 		{
 			name: "single private subnet exists, should create no NAT gateway",
@@ -110,7 +114,8 @@ func TestReconcileNatGateways(t *testing.T) {
 					gomock.Any()).Return(nil)
 
 				m.DescribeAddresses(gomock.Any()).
-					Return(&ec2.DescribeAddressesOutput{}, nil)
+					Return(&ec2.DescribeAddressesOutput{}, nil).
+					Times(2)
 
 				m.AllocateAddress(&ec2.AllocateAddressInput{
 					Domain: aws.String("vpc"),
@@ -172,6 +177,67 @@ func TestReconcileNatGateways(t *testing.T) {
 				}).Return(nil)
 			},
 		},
+		{
+			name: "public & private subnet exists with private connectivity, should create 1 NAT gateway without an Elastic IP",
+			input: []infrav1.SubnetSpec{
+				{
+					ID:               "subnet-1",
+					AvailabilityZone: "us-east-1a",
+					CidrBlock:        "10.0.10.0/24",
+					IsPublic:         true,
+				},
+				{
+					ID:               "subnet-2",
+					AvailabilityZone: "us-east-1a",
+					CidrBlock:        "10.0.12.0/24",
+					IsPublic:         false,
+				},
+			},
+			natGateway: &infrav1.NatGatewaySpec{
+				ConnectivityType: infrav1.NatGatewayConnectivityTypePrivate,
+				TransitGatewayID: "tgw-01",
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeNatGatewaysPages(gomock.Any(), gomock.Any()).Return(nil)
+
+				m.DescribeAddresses(gomock.Any()).Times(0)
+				m.AllocateAddress(gomock.Any()).Times(0)
+
+				m.CreateNatGateway(&ec2.CreateNatGatewayInput{
+					ConnectivityType: aws.String("private"),
+					SubnetId:         aws.String("subnet-1"),
+					TagSpecifications: []*ec2.TagSpecification{
+						{
+							ResourceType: aws.String("natgateway"),
+							Tags: []*ec2.Tag{
+								{
+									Key:   aws.String("Name"),
+									Value: aws.String("test-cluster-nat"),
+								},
+								{
+									Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+									Value: aws.String("owned"),
+								},
+								{
+									Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+									Value: aws.String("common"),
+								},
+							},
+						},
+					},
+				},
+				).Return(&ec2.CreateNatGatewayOutput{
+					NatGateway: &ec2.NatGateway{
+						NatGatewayId: aws.String("natgateway"),
+						SubnetId:     aws.String("subnet-1"),
+					},
+				}, nil)
+
+				m.WaitUntilNatGatewayAvailable(&ec2.DescribeNatGatewaysInput{
+					NatGatewayIds: []*string{aws.String("natgateway")},
+				}).Return(nil)
+			},
+		},
 		{
 			name: "two public & 1 private subnet, and one NAT gateway exists",
 			input: []infrav1.SubnetSpec{
@@ -217,7 +283,8 @@ func TestReconcileNatGateways(t *testing.T) {
 				}).Return(nil)
 
 				m.DescribeAddresses(gomock.Any()).
-					Return(&ec2.DescribeAddressesOutput{}, nil)
+					Return(&ec2.DescribeAddressesOutput{}, nil).
+					Times(2)
 
 				m.AllocateAddress(&ec2.AllocateAddressInput{
 					Domain: aws.String("vpc"),
@@ -333,7 +400,8 @@ func TestReconcileNatGateways(t *testing.T) {
 					}}}, true)
 				}).Return(nil)
 
-				m.DescribeAddresses(gomock.Any()).Times(0)
+				m.DescribeAddresses(gomock.Any()).
+					Return(&ec2.DescribeAddressesOutput{}, nil)
 				m.AllocateAddress(gomock.Any()).Times(0)
 				m.CreateNatGateway(gomock.Any()).Times(0)
 			},
@@ -358,6 +426,8 @@ func TestReconcileNatGateways(t *testing.T) {
 				m.DescribeNatGatewaysPages(gomock.Any(), gomock.Any()).
 					Return(nil).
 					Times(1)
+				m.DescribeAddresses(gomock.Any()).
+					Return(&ec2.DescribeAddressesOutput{}, nil)
 			},
 		},
 	}
@@ -376,6 +446,7 @@ func TestReconcileNatGateways(t *testing.T) {
 							Tags: infrav1.Tags{
 								infrav1.ClusterTagKey("test-cluster"): "owned",
 							},
+							NatGateway: tc.natGateway,
 						},
 						Subnets: tc.input,
 					},
@@ -407,6 +478,101 @@ func TestReconcileNatGateways(t *testing.T) {
 	}
 }
 
+func TestReconcileNatGatewayQuotas(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name        string
+		checks      *infrav1.ServiceQuotaChecks
+		expect      func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		expectQuota func(m *mock_services.MockServiceQuotaInterfaceMockRecorder)
+		expectErr   bool
+	}{
+		{
+			name: "does nothing when service quota checks are disabled",
+		},
+		{
+			name:   "checks the NAT gateways per availability zone and the Elastic IP addresses to allocate",
+			checks: &infrav1.ServiceQuotaChecks{},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeAddresses(gomock.AssignableToTypeOf(&ec2.DescribeAddressesInput{})).Return(&ec2.DescribeAddressesOutput{
+					Addresses: []*ec2.Address{
+						{AllocationId: aws.String("eipalloc-1"), AssociationId: aws.String("eipassoc-1")},
+						{AllocationId: aws.String("eipalloc-2")},
+					},
+				}, nil)
+			},
+			expectQuota: func(m *mock_services.MockServiceQuotaInterfaceMockRecorder) {
+				m.ReconcileHeadroom(gomock.AssignableToTypeOf(&infrav1.AWSCluster{}), servicequotas.Request{
+					NATGateways: map[string]int64{"us-east-1a": 1, "us-east-1b": 1},
+					ElasticIPs:  1,
+				}).Return(nil)
+			},
+		},
+		{
+			name:   "returns the insufficient quota error",
+			checks: &infrav1.ServiceQuotaChecks{},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeAddresses(gomock.Any()).Return(&ec2.DescribeAddressesOutput{}, nil)
+			},
+			expectQuota: func(m *mock_services.MockServiceQuotaInterfaceMockRecorder) {
+				m.ReconcileHeadroom(gomock.Any(), gomock.Any()).Return(errors.New("insufficient quota"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			quotaMock := mock_services.NewMockServiceQuotaInterface(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrav1.AWSClusterSpec{
+						NetworkSpec: infrav1.NetworkSpec{
+							VPC: infrav1.VPCSpec{ID: subnetsVPCID},
+							Subnets: []infrav1.SubnetSpec{
+								{ID: "subnet-1", AvailabilityZone: "us-east-1a", IsPublic: true},
+								{ID: "subnet-2", AvailabilityZone: "us-east-1b", IsPublic: true},
+							},
+						},
+						ServiceQuotaChecks: tc.checks,
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+			if tc.expectQuota != nil {
+				tc.expectQuota(quotaMock.EXPECT())
+			}
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+			s.ServiceQuotaService = quotaMock
+
+			err = s.reconcileNatGatewayQuotas([]string{"subnet-1", "subnet-2"})
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
 func TestDeleteNatGateways(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -47,6 +47,11 @@ func (s *Service) ReconcileNetwork() (err error) {
 		return err
 	}
 
+	// Subnet free IPs.
+	if err := s.reconcileSubnetFreeIPs(); err != nil {
+		return err
+	}
+
 	// Internet Gateways.
 	if err := s.reconcileInternetGateways(); err != nil {
 		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.InternetGatewayReadyCondition, infrav1.InternetGatewayFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), err.Error())
@@ -103,6 +108,11 @@ func (s *Service) DeleteNetwork() (err error) {
 	}
 	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.RouteTablesReadyCondition, clusterv1.DeletedReason, clusterv1.ConditionSeverityInfo, "")
 
+	// Blackhole network interface.
+	if err := s.deleteBlackholeNetworkInterface(); err != nil {
+		return err
+	}
+
 	// NAT Gateways.
 	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.NatGatewaysReadyCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
 	if err := s.scope.PatchObject(); err != nil {
@@ -51,62 +51,56 @@ func (s *Service) reconcileRouteTables() error {
 		return err
 	}
 
+	blackholeNetworkInterfaceID, err := s.reconcileBlackholeNetworkInterface()
+	if err != nil {
+		return err
+	}
+
 	subnets := s.scope.Subnets()
 	for i := range subnets {
 		sn := subnets[i]
 		// We need to compile the minimum routes for this subnet first, so we can compare it or create them.
 		var routes []*ec2.Route
-		if sn.IsPublic {
+		if sn.IsPublic && s.scope.VPC().NatGateway.IsPrivate() {
+			// The subnets hosting private NAT gateways send their traffic to the transit gateway.
+			routes = append(routes, s.getTransitGatewayRoute())
+		} else if sn.IsPublic {
 			if s.scope.VPC().InternetGatewayID == nil {
 				return errors.Errorf("failed to create routing tables: internet gateway for %q is nil", s.scope.VPC().ID)
 			}
 			routes = append(routes, s.getGatewayPublicRoute())
-		} else {
+		} else if sn.Tier != infrav1.SubnetTierDatabase {
+			// Subnets of the database tier are isolated and get no default route.
 			natGatewayID, err := s.getNatGatewayForSubnet(&sn)
 			if err != nil {
 				return err
 			}
 			routes = append(routes, s.getNatGatewayPrivateRoute(natGatewayID))
 		}
+		routes = append(routes, s.getBlackholeRoutes(blackholeNetworkInterfaceID)...)
 
 		if rt, ok := subnetRouteMap[sn.ID]; ok {
 			s.scope.V(2).Info("Subnet is already associated with route table", "subnet-id", sn.ID, "route-table-id", *rt.RouteTableId)
-			// TODO(vincepri): check that everything is in order, e.g. routes match the subnet type.
 
-			// For managed environments we need to reconcile the routes of our tables if there is a mistmatch.
+			// For managed environments we need to reconcile the routes of our tables if there is a mismatch.
 			// For example, a gateway can be deleted and our controller will re-create it, then we replace the route
-			// for the subnet to allow traffic to flow.
-			for _, currentRoute := range rt.Routes {
-				for i := range routes {
-					// Routes destination cidr blocks must be unique within a routing table.
-					// If there is a mistmatch, we replace the routing association.
-					specRoute := routes[i]
-					if (currentRoute.DestinationCidrBlock != nil && // Manually-created routes can have .DestinationIpv6CidrBlock or .DestinationPrefixListId set instead.
-						*currentRoute.DestinationCidrBlock == *specRoute.DestinationCidrBlock) &&
-						((currentRoute.GatewayId != nil && *currentRoute.GatewayId != *specRoute.GatewayId) ||
-							(currentRoute.NatGatewayId != nil && *currentRoute.NatGatewayId != *specRoute.NatGatewayId)) {
-						if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
-							if _, err := s.EC2Client.ReplaceRoute(&ec2.ReplaceRouteInput{
-								RouteTableId:         rt.RouteTableId,
-								DestinationCidrBlock: specRoute.DestinationCidrBlock,
-								GatewayId:            specRoute.GatewayId,
-								NatGatewayId:         specRoute.NatGatewayId,
-							}); err != nil {
-								return false, err
-							}
-							return true, nil
-						}); err != nil {
-							record.Warnf(s.scope.InfraCluster(), "FailedReplaceRoute", "Failed to replace outdated route on managed RouteTable %q: %v", *rt.RouteTableId, err)
-							return errors.Wrapf(err, "failed to replace outdated route on route table %q", *rt.RouteTableId)
-						}
-					}
-				}
+			// for the subnet to allow traffic to flow. Default routes removed out-of-band are re-created.
+			if err := s.reconcileRoutes(rt, routes); err != nil {
+				return err
+			}
+
+			if err := s.deleteStaleBlackholeRoutes(rt, blackholeNetworkInterfaceID); err != nil {
+				return err
+			}
+
+			if err := s.reconcileRoutePropagation(*rt.RouteTableId, rt.PropagatingVgws); err != nil {
+				return err
 			}
 
 			// Make sure tags are up to date.
 			if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
 				buildParams := s.getRouteTableTagParams(*rt.RouteTableId, sn.IsPublic, sn.AvailabilityZone)
-				tagsBuilder := tags.New(&buildParams, tags.WithEC2(s.EC2Client))
+				tagsBuilder := tags.New(&buildParams, tags.WithEC2(s.EC2Client), tags.WithRemovedKeys(s.scope.RemovedCostAllocationTagKeys()...))
 				if err := tagsBuilder.Ensure(converters.TagsToMap(rt.Tags)); err != nil {
 					return false, err
 				}
@@ -139,7 +133,25 @@ func (s *Service) reconcileRouteTables() error {
 
 		s.scope.V(2).Info("Subnet has been associated with route table", "subnet-id", sn.ID, "route-table-id", rt.ID)
 		sn.RouteTableID = aws.String(rt.ID)
+
+		if err := s.reconcileRoutePropagation(rt.ID, nil); err != nil {
+			return err
+		}
 	}
+
+	// The network interface is only deleted once no route targets it anymore.
+	if len(s.scope.VPC().BlackholeCIDRs) == 0 {
+		if err := s.deleteBlackholeNetworkInterface(); err != nil {
+			return err
+		}
+	}
+
+	// Propagation from the gateways that are no longer configured has been disabled on every managed route table.
+	s.scope.Network().RoutePropagationGatewayIDs = nil
+	if gatewayID := s.routePropagationGatewayID(); gatewayID != "" {
+		s.scope.Network().RoutePropagationGatewayIDs = []string{gatewayID}
+	}
+
 	conditions.MarkTrue(s.scope.InfraCluster(), infrav1.RouteTablesReadyCondition)
 	return nil
 }
@@ -240,33 +252,101 @@ func (s *Service) createRouteTableWithRoutes(routes []*ec2.Route, isPublic bool,
 	s.scope.Info("Created route table", "route-table-id", *out.RouteTable.RouteTableId)
 
 	for i := range routes {
-		route := routes[i]
+		// TODO(vincepri): cleanup the route table if this fails.
+		if err := s.createRoute(*out.RouteTable.RouteTableId, routes[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return &infrav1.RouteTable{
+		ID: *out.RouteTable.RouteTableId,
+	}, nil
+}
+
+// reconcileRoutes converges the routes of an existing route table to the desired routes. A desired
+// route missing from the table is created, and a route to the same destination with a different
+// target is replaced. Routes to other destinations are left untouched.
+func (s *Service) reconcileRoutes(rt *ec2.RouteTable, routes []*ec2.Route) error {
+	for i := range routes {
+		specRoute := routes[i]
+		currentRoute := findRoute(rt.Routes, specRoute)
+		if currentRoute == nil {
+			s.scope.Info("Route is missing from route table, creating it", "route-table-id", *rt.RouteTableId, "destination", aws.StringValue(specRoute.DestinationCidrBlock))
+			if err := s.createRoute(*rt.RouteTableId, specRoute); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if routeTargetMatches(currentRoute, specRoute) {
+			continue
+		}
+
 		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
-			if _, err := s.EC2Client.CreateRoute(&ec2.CreateRouteInput{
-				RouteTableId:                out.RouteTable.RouteTableId,
-				DestinationCidrBlock:        route.DestinationCidrBlock,
-				DestinationIpv6CidrBlock:    route.DestinationIpv6CidrBlock,
-				EgressOnlyInternetGatewayId: route.EgressOnlyInternetGatewayId,
-				GatewayId:                   route.GatewayId,
-				InstanceId:                  route.InstanceId,
-				NatGatewayId:                route.NatGatewayId,
-				NetworkInterfaceId:          route.NetworkInterfaceId,
-				VpcPeeringConnectionId:      route.VpcPeeringConnectionId,
+			if _, err := s.EC2Client.ReplaceRoute(&ec2.ReplaceRouteInput{
+				RouteTableId:         rt.RouteTableId,
+				DestinationCidrBlock: specRoute.DestinationCidrBlock,
+				GatewayId:            specRoute.GatewayId,
+				NatGatewayId:         specRoute.NatGatewayId,
+				NetworkInterfaceId:   specRoute.NetworkInterfaceId,
+				TransitGatewayId:     specRoute.TransitGatewayId,
 			}); err != nil {
 				return false, err
 			}
 			return true, nil
-		}, awserrors.RouteTableNotFound, awserrors.NATGatewayNotFound, awserrors.GatewayNotFound); err != nil {
-			// TODO(vincepri): cleanup the route table if this fails.
-			record.Warnf(s.scope.InfraCluster(), "FailedCreateRoute", "Failed to create route %s for RouteTable %q: %v", route.GoString(), *out.RouteTable.RouteTableId, err)
-			return nil, errors.Wrapf(err, "failed to create route in route table %q: %s", *out.RouteTable.RouteTableId, route.GoString())
+		}); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedReplaceRoute", "Failed to replace outdated route on managed RouteTable %q: %v", *rt.RouteTableId, err)
+			return errors.Wrapf(err, "failed to replace outdated route on route table %q", *rt.RouteTableId)
 		}
-		record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateRoute", "Created route %s for RouteTable %q", route.GoString(), *out.RouteTable.RouteTableId)
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulReplaceRoute", "Replaced route %s for RouteTable %q", specRoute.GoString(), *rt.RouteTableId)
 	}
 
-	return &infrav1.RouteTable{
-		ID: *out.RouteTable.RouteTableId,
-	}, nil
+	return nil
+}
+
+// findRoute returns the route of the table with the same destination as the given route.
+// Routes destination cidr blocks must be unique within a routing table.
+func findRoute(current []*ec2.Route, route *ec2.Route) *ec2.Route {
+	for _, r := range current {
+		// Manually-created routes can have .DestinationIpv6CidrBlock or .DestinationPrefixListId set instead.
+		if r.DestinationCidrBlock != nil && *r.DestinationCidrBlock == aws.StringValue(route.DestinationCidrBlock) {
+			return r
+		}
+	}
+	return nil
+}
+
+// routeTargetMatches returns whether the current route sends traffic to the gateway of the desired route.
+func routeTargetMatches(current, desired *ec2.Route) bool {
+	return aws.StringValue(current.GatewayId) == aws.StringValue(desired.GatewayId) &&
+		aws.StringValue(current.NatGatewayId) == aws.StringValue(desired.NatGatewayId) &&
+		aws.StringValue(current.NetworkInterfaceId) == aws.StringValue(desired.NetworkInterfaceId) &&
+		aws.StringValue(current.TransitGatewayId) == aws.StringValue(desired.TransitGatewayId)
+}
+
+func (s *Service) createRoute(routeTableID string, route *ec2.Route) error {
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		if _, err := s.EC2Client.CreateRoute(&ec2.CreateRouteInput{
+			RouteTableId:                aws.String(routeTableID),
+			DestinationCidrBlock:        route.DestinationCidrBlock,
+			DestinationIpv6CidrBlock:    route.DestinationIpv6CidrBlock,
+			EgressOnlyInternetGatewayId: route.EgressOnlyInternetGatewayId,
+			GatewayId:                   route.GatewayId,
+			InstanceId:                  route.InstanceId,
+			NatGatewayId:                route.NatGatewayId,
+			NetworkInterfaceId:          route.NetworkInterfaceId,
+			TransitGatewayId:            route.TransitGatewayId,
+			VpcPeeringConnectionId:      route.VpcPeeringConnectionId,
+		}); err != nil {
+			return false, err
+		}
+		return true, nil
+	}, awserrors.RouteTableNotFound, awserrors.NATGatewayNotFound, awserrors.GatewayNotFound); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateRoute", "Failed to create route %s for RouteTable %q: %v", route.GoString(), routeTableID, err)
+		return errors.Wrapf(err, "failed to create route in route table %q: %s", routeTableID, route.GoString())
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateRoute", "Created route %s for RouteTable %q", route.GoString(), routeTableID)
+	return nil
 }
 
 func (s *Service) associateRouteTable(rt *infrav1.RouteTable, subnetID string) error {
@@ -284,6 +364,78 @@ func (s *Service) associateRouteTable(rt *infrav1.RouteTable, subnetID string) e
 	return nil
 }
 
+// reconcileRoutePropagation enables or disables the propagation of routes from the configured
+// virtual private gateway into the given route table, based on its currently propagating gateways.
+// Propagation from gateways it was previously enabled from, recorded in the network status, is
+// disabled when they are no longer configured.
+func (s *Service) reconcileRoutePropagation(routeTableID string, propagating []*ec2.PropagatingVgw) error {
+	spec := s.scope.VPC().RoutePropagation
+	desired := s.routePropagationGatewayID()
+
+	enabled := false
+	for _, vgw := range propagating {
+		gatewayID := aws.StringValue(vgw.GatewayId)
+		if gatewayID == desired {
+			enabled = true
+			continue
+		}
+
+		// Propagation from gateways the provider never enabled is left alone, unless explicitly disabled.
+		explicitlyDisabled := spec != nil && spec.GatewayID == gatewayID
+		if !explicitlyDisabled && !s.enabledRoutePropagation(gatewayID) {
+			continue
+		}
+
+		if _, err := s.EC2Client.DisableVgwRoutePropagation(&ec2.DisableVgwRoutePropagationInput{
+			GatewayId:    aws.String(gatewayID),
+			RouteTableId: aws.String(routeTableID),
+		}); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedDisableRoutePropagation", "Failed to disable route propagation from gateway %q on RouteTable %q: %v", gatewayID, routeTableID, err)
+			return errors.Wrapf(err, "failed to disable route propagation from gateway %q on route table %q", gatewayID, routeTableID)
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulDisableRoutePropagation", "Disabled route propagation from gateway %q on RouteTable %q", gatewayID, routeTableID)
+	}
+
+	if desired == "" || enabled {
+		return nil
+	}
+
+	// Record the gateway before enabling propagation, so it is disabled later on even if reconciliation fails halfway.
+	if !s.enabledRoutePropagation(desired) {
+		s.scope.Network().RoutePropagationGatewayIDs = append(s.scope.Network().RoutePropagationGatewayIDs, desired)
+	}
+
+	if _, err := s.EC2Client.EnableVgwRoutePropagation(&ec2.EnableVgwRoutePropagationInput{
+		GatewayId:    aws.String(desired),
+		RouteTableId: aws.String(routeTableID),
+	}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedEnableRoutePropagation", "Failed to enable route propagation from gateway %q on RouteTable %q: %v", desired, routeTableID, err)
+		return errors.Wrapf(err, "failed to enable route propagation from gateway %q on route table %q", desired, routeTableID)
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulEnableRoutePropagation", "Enabled route propagation from gateway %q on RouteTable %q", desired, routeTableID)
+
+	return nil
+}
+
+// routePropagationGatewayID returns the ID of the gateway routes should be propagated from, if any.
+func (s *Service) routePropagationGatewayID() string {
+	spec := s.scope.VPC().RoutePropagation
+	if spec == nil || !spec.EnableRoutePropagation {
+		return ""
+	}
+	return spec.GatewayID
+}
+
+// enabledRoutePropagation returns whether route propagation from the given gateway was enabled by the provider.
+func (s *Service) enabledRoutePropagation(gatewayID string) bool {
+	for _, id := range s.scope.Network().RoutePropagationGatewayIDs {
+		if id == gatewayID {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Service) getNatGatewayPrivateRoute(natGatewayID string) *ec2.Route {
 	return &ec2.Route{
 		DestinationCidrBlock: aws.String(services.AnyIPv4CidrBlock),
@@ -298,6 +450,13 @@ func (s *Service) getGatewayPublicRoute() *ec2.Route {
 	}
 }
 
+func (s *Service) getTransitGatewayRoute() *ec2.Route {
+	return &ec2.Route{
+		DestinationCidrBlock: aws.String(services.AnyIPv4CidrBlock),
+		TransitGatewayId:     aws.String(s.scope.VPC().NatGateway.TransitGatewayID),
+	}
+}
+
 func (s *Service) getRouteTableTagParams(id string, public bool, zone string) infrav1.BuildParams {
 	var name strings.Builder
 
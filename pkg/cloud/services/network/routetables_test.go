@@ -238,6 +238,438 @@ func TestReconcileRouteTables(t *testing.T) {
 					Return(nil, nil)
 			},
 		},
+		{
+			name: "default routes are missing, creates them",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					InternetGatewayID: aws.String("igw-01"),
+					ID:                "vpc-routetables",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+				},
+				Subnets: infrav1.Subnets{
+					infrav1.SubnetSpec{
+						ID:               "subnet-routetables-private",
+						IsPublic:         false,
+						AvailabilityZone: "us-east-1a",
+					},
+					infrav1.SubnetSpec{
+						ID:               "subnet-routetables-public",
+						IsPublic:         true,
+						NatGatewayID:     aws.String("nat-01"),
+						AvailabilityZone: "us-east-1a",
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeRouteTables(gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{
+						RouteTables: []*ec2.RouteTable{
+							{
+								RouteTableId: aws.String("route-table-private"),
+								Associations: []*ec2.RouteTableAssociation{
+									{
+										SubnetId: aws.String("subnet-routetables-private"),
+									},
+								},
+								Routes: []*ec2.Route{
+									{
+										DestinationCidrBlock: aws.String("10.0.0.0/16"),
+										GatewayId:            aws.String("local"),
+									},
+								},
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("common"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("test-cluster-rt-private-us-east-1a"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+								},
+							},
+							{
+								RouteTableId: aws.String("route-table-public"),
+								Associations: []*ec2.RouteTableAssociation{
+									{
+										SubnetId: aws.String("subnet-routetables-public"),
+									},
+								},
+								Routes: []*ec2.Route{
+									{
+										DestinationCidrBlock: aws.String("10.0.0.0/16"),
+										GatewayId:            aws.String("local"),
+									},
+								},
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("common"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("test-cluster-rt-public-us-east-1a"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+								},
+							},
+						},
+					}, nil)
+
+				m.CreateRoute(gomock.Eq(&ec2.CreateRouteInput{
+					DestinationCidrBlock: aws.String("0.0.0.0/0"),
+					NatGatewayId:         aws.String("nat-01"),
+					RouteTableId:         aws.String("route-table-private"),
+				})).
+					Return(&ec2.CreateRouteOutput{Return: aws.Bool(true)}, nil)
+				m.CreateRoute(gomock.Eq(&ec2.CreateRouteInput{
+					DestinationCidrBlock: aws.String("0.0.0.0/0"),
+					GatewayId:            aws.String("igw-01"),
+					RouteTableId:         aws.String("route-table-public"),
+				})).
+					Return(&ec2.CreateRouteOutput{Return: aws.Bool(true)}, nil)
+			},
+		},
+		{
+			name: "public default route points to a nat gateway, replaces it with the internet gateway",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					InternetGatewayID: aws.String("igw-01"),
+					ID:                "vpc-routetables",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+				},
+				Subnets: infrav1.Subnets{
+					infrav1.SubnetSpec{
+						ID:               "subnet-routetables-private",
+						IsPublic:         false,
+						AvailabilityZone: "us-east-1a",
+					},
+					infrav1.SubnetSpec{
+						ID:               "subnet-routetables-public",
+						IsPublic:         true,
+						NatGatewayID:     aws.String("nat-01"),
+						AvailabilityZone: "us-east-1a",
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeRouteTables(gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{
+						RouteTables: []*ec2.RouteTable{
+							{
+								RouteTableId: aws.String("route-table-private"),
+								Associations: []*ec2.RouteTableAssociation{
+									{
+										SubnetId: aws.String("subnet-routetables-private"),
+									},
+								},
+								Routes: []*ec2.Route{
+									{
+										DestinationCidrBlock: aws.String("10.0.0.0/16"),
+										GatewayId:            aws.String("local"),
+									},
+									{
+										DestinationCidrBlock: aws.String("0.0.0.0/0"),
+										NatGatewayId:         aws.String("nat-01"),
+									},
+								},
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("common"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("test-cluster-rt-private-us-east-1a"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+								},
+							},
+							{
+								RouteTableId: aws.String("route-table-public"),
+								Associations: []*ec2.RouteTableAssociation{
+									{
+										SubnetId: aws.String("subnet-routetables-public"),
+									},
+								},
+								Routes: []*ec2.Route{
+									{
+										DestinationCidrBlock: aws.String("10.0.0.0/16"),
+										GatewayId:            aws.String("local"),
+									},
+									{
+										DestinationCidrBlock: aws.String("0.0.0.0/0"),
+										NatGatewayId:         aws.String("nat-01"),
+									},
+								},
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("common"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("test-cluster-rt-public-us-east-1a"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+								},
+							},
+						},
+					}, nil)
+
+				m.ReplaceRoute(gomock.Eq(&ec2.ReplaceRouteInput{
+					DestinationCidrBlock: aws.String("0.0.0.0/0"),
+					RouteTableId:         aws.String("route-table-public"),
+					GatewayId:            aws.String("igw-01"),
+				})).
+					Return(nil, nil)
+			},
+		},
+		{
+			name: "private NAT gateways, routes the public subnet to the transit gateway",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpc-routetables",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+					NatGateway: &infrav1.NatGatewaySpec{
+						ConnectivityType: infrav1.NatGatewayConnectivityTypePrivate,
+						TransitGatewayID: "tgw-01",
+					},
+				},
+				Subnets: infrav1.Subnets{
+					infrav1.SubnetSpec{
+						ID:               "subnet-routetables-private",
+						IsPublic:         false,
+						AvailabilityZone: "us-east-1a",
+					},
+					infrav1.SubnetSpec{
+						ID:               "subnet-routetables-public",
+						IsPublic:         true,
+						NatGatewayID:     aws.String("nat-01"),
+						AvailabilityZone: "us-east-1a",
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeRouteTables(gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{}, nil)
+
+				privateRouteTable := m.CreateRouteTable(matchRouteTableInput(&ec2.CreateRouteTableInput{VpcId: aws.String("vpc-routetables")})).
+					Return(&ec2.CreateRouteTableOutput{RouteTable: &ec2.RouteTable{RouteTableId: aws.String("rt-1")}}, nil)
+
+				m.CreateRoute(gomock.Eq(&ec2.CreateRouteInput{
+					NatGatewayId:         aws.String("nat-01"),
+					DestinationCidrBlock: aws.String("0.0.0.0/0"),
+					RouteTableId:         aws.String("rt-1"),
+				})).
+					After(privateRouteTable)
+
+				m.AssociateRouteTable(gomock.Eq(&ec2.AssociateRouteTableInput{
+					RouteTableId: aws.String("rt-1"),
+					SubnetId:     aws.String("subnet-routetables-private"),
+				})).
+					Return(&ec2.AssociateRouteTableOutput{}, nil).
+					After(privateRouteTable)
+
+				publicRouteTable := m.CreateRouteTable(matchRouteTableInput(&ec2.CreateRouteTableInput{VpcId: aws.String("vpc-routetables")})).
+					Return(&ec2.CreateRouteTableOutput{RouteTable: &ec2.RouteTable{RouteTableId: aws.String("rt-2")}}, nil)
+
+				m.CreateRoute(gomock.Eq(&ec2.CreateRouteInput{
+					TransitGatewayId:     aws.String("tgw-01"),
+					DestinationCidrBlock: aws.String("0.0.0.0/0"),
+					RouteTableId:         aws.String("rt-2"),
+				})).
+					After(publicRouteTable)
+
+				m.AssociateRouteTable(gomock.Eq(&ec2.AssociateRouteTableInput{
+					RouteTableId: aws.String("rt-2"),
+					SubnetId:     aws.String("subnet-routetables-public"),
+				})).
+					Return(&ec2.AssociateRouteTableOutput{}, nil).
+					After(publicRouteTable)
+			},
+		},
+		{
+			name: "private NAT gateways, replaces the public internet gateway route with the transit gateway",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					InternetGatewayID: aws.String("igw-01"),
+					ID:                "vpc-routetables",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+					NatGateway: &infrav1.NatGatewaySpec{
+						ConnectivityType: infrav1.NatGatewayConnectivityTypePrivate,
+						TransitGatewayID: "tgw-01",
+					},
+				},
+				Subnets: infrav1.Subnets{
+					infrav1.SubnetSpec{
+						ID:               "subnet-routetables-public",
+						IsPublic:         true,
+						NatGatewayID:     aws.String("nat-01"),
+						AvailabilityZone: "us-east-1a",
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeRouteTables(gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{
+						RouteTables: []*ec2.RouteTable{
+							{
+								RouteTableId: aws.String("route-table-public"),
+								Associations: []*ec2.RouteTableAssociation{
+									{
+										SubnetId: aws.String("subnet-routetables-public"),
+									},
+								},
+								Routes: []*ec2.Route{
+									{
+										DestinationCidrBlock: aws.String("0.0.0.0/0"),
+										GatewayId:            aws.String("igw-01"),
+									},
+								},
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("common"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("test-cluster-rt-public-us-east-1a"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+								},
+							},
+						},
+					}, nil)
+
+				m.ReplaceRoute(gomock.Eq(&ec2.ReplaceRouteInput{
+					DestinationCidrBlock: aws.String("0.0.0.0/0"),
+					RouteTableId:         aws.String("route-table-public"),
+					TransitGatewayId:     aws.String("tgw-01"),
+				})).
+					Return(nil, nil)
+			},
+		},
+		{
+			name: "creating a missing default route fails, returns error",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					InternetGatewayID: aws.String("igw-01"),
+					ID:                "vpc-routetables",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+				},
+				Subnets: infrav1.Subnets{
+					infrav1.SubnetSpec{
+						ID:               "subnet-routetables-private",
+						IsPublic:         false,
+						AvailabilityZone: "us-east-1a",
+					},
+					infrav1.SubnetSpec{
+						ID:               "subnet-routetables-public",
+						IsPublic:         true,
+						NatGatewayID:     aws.String("nat-01"),
+						AvailabilityZone: "us-east-1a",
+					},
+				},
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeRouteTables(gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{
+						RouteTables: []*ec2.RouteTable{
+							{
+								RouteTableId: aws.String("route-table-private"),
+								Associations: []*ec2.RouteTableAssociation{
+									{
+										SubnetId: aws.String("subnet-routetables-private"),
+									},
+								},
+								Routes: []*ec2.Route{
+									{
+										DestinationCidrBlock: aws.String("10.0.0.0/16"),
+										GatewayId:            aws.String("local"),
+									},
+									{
+										DestinationCidrBlock: aws.String("0.0.0.0/0"),
+										NatGatewayId:         aws.String("nat-01"),
+									},
+								},
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("common"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("test-cluster-rt-private-us-east-1a"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+								},
+							},
+							{
+								RouteTableId: aws.String("route-table-public"),
+								Associations: []*ec2.RouteTableAssociation{
+									{
+										SubnetId: aws.String("subnet-routetables-public"),
+									},
+								},
+								Routes: []*ec2.Route{
+									{
+										DestinationCidrBlock: aws.String("10.0.0.0/16"),
+										GatewayId:            aws.String("local"),
+									},
+								},
+								Tags: []*ec2.Tag{
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("common"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("test-cluster-rt-public-us-east-1a"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+								},
+							},
+						},
+					}, nil)
+
+				m.CreateRoute(gomock.AssignableToTypeOf(&ec2.CreateRouteInput{})).
+					Return(nil, awserrors.NewFailedDependency("dependency failure"))
+			},
+			err: errors.New(`failed to create route in route table "route-table-public"`),
+		},
 		{
 			name: "extra routes exist, do nothing",
 			input: &infrav1.NetworkSpec{
@@ -371,6 +803,340 @@ func TestReconcileRouteTables(t *testing.T) {
 	}
 }
 
+func TestReconcileBlackholeRoutes(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	routeTable := func(routes ...*ec2.Route) *ec2.DescribeRouteTablesOutput {
+		return &ec2.DescribeRouteTablesOutput{
+			RouteTables: []*ec2.RouteTable{
+				{
+					RouteTableId: aws.String("route-table-database"),
+					Associations: []*ec2.RouteTableAssociation{
+						{
+							SubnetId: aws.String("subnet-database"),
+						},
+					},
+					Routes: routes,
+					Tags: []*ec2.Tag{
+						{
+							Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+							Value: aws.String("common"),
+						},
+						{
+							Key:   aws.String("Name"),
+							Value: aws.String("test-cluster-rt-private-us-east-1a"),
+						},
+						{
+							Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+							Value: aws.String("owned"),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name                        string
+		blackholeCIDRs              []string
+		blackholeNetworkInterfaceID string
+		expect                      func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		expectedNetworkInterfaceID  string
+	}{
+		{
+			name:           "creates the blackhole network interface and adds the blackhole routes",
+			blackholeCIDRs: []string{"198.51.100.0/24"},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeRouteTables(gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(routeTable(), nil)
+				m.DescribeNetworkInterfaces(gomock.Eq(&ec2.DescribeNetworkInterfacesInput{
+					Filters: []*ec2.Filter{
+						{Name: aws.String("vpc-id"), Values: aws.StringSlice([]string{"vpc-routetables"})},
+						{Name: aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"), Values: aws.StringSlice([]string{"owned"})},
+						{Name: aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/role"), Values: aws.StringSlice([]string{"blackhole"})},
+					},
+				})).Return(&ec2.DescribeNetworkInterfacesOutput{}, nil)
+				m.CreateNetworkInterface(gomock.AssignableToTypeOf(&ec2.CreateNetworkInterfaceInput{})).
+					DoAndReturn(func(input *ec2.CreateNetworkInterfaceInput) (*ec2.CreateNetworkInterfaceOutput, error) {
+						if aws.StringValue(input.SubnetId) != "subnet-database" {
+							t.Fatalf("expected the network interface in subnet-database, got %q", aws.StringValue(input.SubnetId))
+						}
+						return &ec2.CreateNetworkInterfaceOutput{
+							NetworkInterface: &ec2.NetworkInterface{NetworkInterfaceId: aws.String("eni-blackhole")},
+						}, nil
+					})
+				m.CreateRoute(gomock.Eq(&ec2.CreateRouteInput{
+					RouteTableId:         aws.String("route-table-database"),
+					DestinationCidrBlock: aws.String("198.51.100.0/24"),
+					NetworkInterfaceId:   aws.String("eni-blackhole"),
+				})).Return(&ec2.CreateRouteOutput{}, nil)
+			},
+			expectedNetworkInterfaceID: "eni-blackhole",
+		},
+		{
+			name:                        "removes the blackhole routes which are no longer configured",
+			blackholeCIDRs:              []string{"198.51.100.0/24"},
+			blackholeNetworkInterfaceID: "eni-blackhole",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeRouteTables(gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(routeTable(
+						&ec2.Route{
+							DestinationCidrBlock: aws.String("198.51.100.0/24"),
+							NetworkInterfaceId:   aws.String("eni-blackhole"),
+						},
+						&ec2.Route{
+							DestinationCidrBlock: aws.String("203.0.113.0/24"),
+							NetworkInterfaceId:   aws.String("eni-blackhole"),
+						},
+						// Extra (managed outside of CAPA) route to another network interface.
+						&ec2.Route{
+							DestinationCidrBlock: aws.String("192.0.2.0/24"),
+							NetworkInterfaceId:   aws.String("eni-appliance"),
+						},
+					), nil)
+				m.DeleteRoute(gomock.Eq(&ec2.DeleteRouteInput{
+					RouteTableId:         aws.String("route-table-database"),
+					DestinationCidrBlock: aws.String("203.0.113.0/24"),
+				})).Return(&ec2.DeleteRouteOutput{}, nil)
+			},
+			expectedNetworkInterfaceID: "eni-blackhole",
+		},
+		{
+			name:                        "matches the blackhole routes by their normalized CIDR block",
+			blackholeCIDRs:              []string{"198.51.100.7/24"},
+			blackholeNetworkInterfaceID: "eni-blackhole",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeRouteTables(gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(routeTable(
+						&ec2.Route{
+							DestinationCidrBlock: aws.String("198.51.100.0/24"),
+							NetworkInterfaceId:   aws.String("eni-blackhole"),
+						},
+					), nil)
+			},
+			expectedNetworkInterfaceID: "eni-blackhole",
+		},
+		{
+			name:                        "removes all the blackhole routes and the blackhole network interface",
+			blackholeNetworkInterfaceID: "eni-blackhole",
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeRouteTables(gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(routeTable(
+						&ec2.Route{
+							DestinationCidrBlock: aws.String("198.51.100.0/24"),
+							NetworkInterfaceId:   aws.String("eni-blackhole"),
+						},
+					), nil)
+				deleteRoute := m.DeleteRoute(gomock.Eq(&ec2.DeleteRouteInput{
+					RouteTableId:         aws.String("route-table-database"),
+					DestinationCidrBlock: aws.String("198.51.100.0/24"),
+				})).Return(&ec2.DeleteRouteOutput{}, nil)
+				m.DeleteNetworkInterface(gomock.Eq(&ec2.DeleteNetworkInterfaceInput{
+					NetworkInterfaceId: aws.String("eni-blackhole"),
+				})).Return(&ec2.DeleteNetworkInterfaceOutput{}, nil).After(deleteRoute)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrav1.AWSClusterSpec{
+						NetworkSpec: infrav1.NetworkSpec{
+							VPC: infrav1.VPCSpec{
+								ID:             "vpc-routetables",
+								BlackholeCIDRs: tc.blackholeCIDRs,
+								Tags: infrav1.Tags{
+									infrav1.ClusterTagKey("test-cluster"): "owned",
+								},
+							},
+							Subnets: infrav1.Subnets{
+								infrav1.SubnetSpec{
+									ID:               "subnet-database",
+									AvailabilityZone: "us-east-1a",
+									Tier:             infrav1.SubnetTierDatabase,
+								},
+							},
+						},
+					},
+					Status: infrav1.AWSClusterStatus{
+						Network: infrav1.NetworkStatus{
+							BlackholeNetworkInterfaceID: tc.blackholeNetworkInterfaceID,
+						},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			g.Expect(s.reconcileRouteTables()).To(Succeed())
+			g.Expect(scope.Network().BlackholeNetworkInterfaceID).To(Equal(tc.expectedNetworkInterfaceID))
+		})
+	}
+}
+
+func TestReconcileRoutePropagation(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name          string
+		propagation   *infrav1.RoutePropagationSpec
+		propagating   []*ec2.PropagatingVgw
+		enabled       []string
+		expect        func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		wantErr       bool
+		expectEnabled []string
+	}{
+		{
+			name: "does nothing when route propagation is not configured",
+		},
+		{
+			name:        "enables propagation when not yet enabled",
+			propagation: &infrav1.RoutePropagationSpec{EnableRoutePropagation: true, GatewayID: "vgw-01"},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.EnableVgwRoutePropagation(gomock.Eq(&ec2.EnableVgwRoutePropagationInput{
+					GatewayId:    aws.String("vgw-01"),
+					RouteTableId: aws.String("rtb-01"),
+				})).Return(&ec2.EnableVgwRoutePropagationOutput{}, nil)
+			},
+			expectEnabled: []string{"vgw-01"},
+		},
+		{
+			name:        "enables propagation when only another gateway propagates",
+			propagation: &infrav1.RoutePropagationSpec{EnableRoutePropagation: true, GatewayID: "vgw-01"},
+			propagating: []*ec2.PropagatingVgw{{GatewayId: aws.String("vgw-02")}},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.EnableVgwRoutePropagation(gomock.Eq(&ec2.EnableVgwRoutePropagationInput{
+					GatewayId:    aws.String("vgw-01"),
+					RouteTableId: aws.String("rtb-01"),
+				})).Return(&ec2.EnableVgwRoutePropagationOutput{}, nil)
+			},
+			expectEnabled: []string{"vgw-01"},
+		},
+		{
+			name:        "does nothing when propagation is already enabled",
+			propagation: &infrav1.RoutePropagationSpec{EnableRoutePropagation: true, GatewayID: "vgw-01"},
+			propagating: []*ec2.PropagatingVgw{{GatewayId: aws.String("vgw-01")}},
+		},
+		{
+			name:        "moves propagation to the new gateway when the gateway ID changes",
+			propagation: &infrav1.RoutePropagationSpec{EnableRoutePropagation: true, GatewayID: "vgw-02"},
+			propagating: []*ec2.PropagatingVgw{{GatewayId: aws.String("vgw-01")}, {GatewayId: aws.String("vgw-03")}},
+			enabled:     []string{"vgw-01"},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DisableVgwRoutePropagation(gomock.Eq(&ec2.DisableVgwRoutePropagationInput{
+					GatewayId:    aws.String("vgw-01"),
+					RouteTableId: aws.String("rtb-01"),
+				})).Return(&ec2.DisableVgwRoutePropagationOutput{}, nil)
+				m.EnableVgwRoutePropagation(gomock.Eq(&ec2.EnableVgwRoutePropagationInput{
+					GatewayId:    aws.String("vgw-02"),
+					RouteTableId: aws.String("rtb-01"),
+				})).Return(&ec2.EnableVgwRoutePropagationOutput{}, nil)
+			},
+			expectEnabled: []string{"vgw-01", "vgw-02"},
+		},
+		{
+			name:        "disables propagation it enabled once route propagation is removed",
+			propagating: []*ec2.PropagatingVgw{{GatewayId: aws.String("vgw-01")}, {GatewayId: aws.String("vgw-03")}},
+			enabled:     []string{"vgw-01"},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DisableVgwRoutePropagation(gomock.Eq(&ec2.DisableVgwRoutePropagationInput{
+					GatewayId:    aws.String("vgw-01"),
+					RouteTableId: aws.String("rtb-01"),
+				})).Return(&ec2.DisableVgwRoutePropagationOutput{}, nil)
+			},
+			expectEnabled: []string{"vgw-01"},
+		},
+		{
+			name:        "disables propagation when it is enabled but not wanted",
+			propagation: &infrav1.RoutePropagationSpec{EnableRoutePropagation: false, GatewayID: "vgw-01"},
+			propagating: []*ec2.PropagatingVgw{{GatewayId: aws.String("vgw-01")}},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DisableVgwRoutePropagation(gomock.Eq(&ec2.DisableVgwRoutePropagationInput{
+					GatewayId:    aws.String("vgw-01"),
+					RouteTableId: aws.String("rtb-01"),
+				})).Return(&ec2.DisableVgwRoutePropagationOutput{}, nil)
+			},
+		},
+		{
+			name:        "does nothing when propagation is already disabled",
+			propagation: &infrav1.RoutePropagationSpec{EnableRoutePropagation: false, GatewayID: "vgw-01"},
+		},
+		{
+			name:        "returns error when enabling propagation fails",
+			propagation: &infrav1.RoutePropagationSpec{EnableRoutePropagation: true, GatewayID: "vgw-01"},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.EnableVgwRoutePropagation(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrav1.AWSClusterSpec{
+						NetworkSpec: infrav1.NetworkSpec{
+							VPC: infrav1.VPCSpec{
+								ID:               "vpc-routetables",
+								RoutePropagation: tc.propagation,
+							},
+						},
+					},
+					Status: infrav1.AWSClusterStatus{
+						Network: infrav1.NetworkStatus{RoutePropagationGatewayIDs: tc.enabled},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = s.reconcileRoutePropagation("rtb-01", tc.propagating)
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(scope.Network().RoutePropagationGatewayIDs).To(Equal(tc.expectEnabled))
+		})
+	}
+}
+
 func TestDeleteRouteTables(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -20,6 +20,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/servicequotas"
 )
 
 // Service holds a collection of interfaces.
@@ -28,12 +30,16 @@ import (
 type Service struct {
 	scope     scope.NetworkScope
 	EC2Client ec2iface.EC2API
+
+	// ServiceQuotaService checks the service quota headroom before NAT gateways are created.
+	ServiceQuotaService services.ServiceQuotaInterface
 }
 
 // NewService returns a new service given the ec2 api client.
 func NewService(networkScope scope.NetworkScope) *Service {
 	return &Service{
-		scope:     networkScope,
-		EC2Client: scope.NewEC2Client(networkScope, networkScope, networkScope, networkScope.InfraCluster()),
+		scope:               networkScope,
+		EC2Client:           scope.NewEC2Client(networkScope, networkScope, networkScope, networkScope.InfraCluster()),
+		ServiceQuotaService: servicequotas.NewService(networkScope),
 	}
 }
@@ -0,0 +1,77 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// reconcileSubnetFreeIPs reports whether the subnets used by the cluster have at least
+// the configured number of available IP addresses.
+func (s *Service) reconcileSubnetFreeIPs() error {
+	threshold := s.scope.VPC().SubnetFreeIPThreshold
+	if threshold == nil {
+		conditions.Delete(s.scope.InfraCluster(), infrav1.SubnetsFreeIPsSufficientCondition)
+		return nil
+	}
+
+	out, err := s.describeSubnets()
+	if err != nil {
+		return err
+	}
+
+	low := subnetsBelowFreeIPThreshold(out.Subnets, s.scope.Subnets(), *threshold)
+	if len(low) == 0 {
+		conditions.MarkTrue(s.scope.InfraCluster(), infrav1.SubnetsFreeIPsSufficientCondition)
+		return nil
+	}
+
+	message := fmt.Sprintf("subnets with fewer than %d available IP addresses: %s", *threshold, strings.Join(low, ", "))
+	if !conditions.IsFalse(s.scope.InfraCluster(), infrav1.SubnetsFreeIPsSufficientCondition) {
+		record.Warnf(s.scope.InfraCluster(), "SubnetFreeIPsLow", "Found %s", message)
+	}
+	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.SubnetsFreeIPsSufficientCondition, infrav1.SubnetsFreeIPsLowReason, clusterv1.ConditionSeverityWarning, "%s", message)
+
+	return nil
+}
+
+// subnetsBelowFreeIPThreshold returns a description of the subnets in the spec that have
+// fewer available IP addresses than the threshold, sorted by subnet ID.
+func subnetsBelowFreeIPThreshold(subnets []*ec2.Subnet, spec infrav1.Subnets, threshold int64) []string {
+	low := []string{}
+	for _, subnet := range subnets {
+		id := aws.StringValue(subnet.SubnetId)
+		if spec.FindByID(id) == nil {
+			continue
+		}
+		if available := aws.Int64Value(subnet.AvailableIpAddressCount); available < threshold {
+			low = append(low, fmt.Sprintf("%s (%d available)", id, available))
+		}
+	}
+	sort.Strings(low)
+	return low
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestSubnetsBelowFreeIPThreshold(t *testing.T) {
+	spec := infrav1.Subnets{
+		{ID: "subnet-1"},
+		{ID: "subnet-2"},
+		{ID: "subnet-3"},
+	}
+	subnets := []*ec2.Subnet{
+		{SubnetId: aws.String("subnet-3"), AvailableIpAddressCount: aws.Int64(5)},
+		{SubnetId: aws.String("subnet-1"), AvailableIpAddressCount: aws.Int64(99)},
+		{SubnetId: aws.String("subnet-2"), AvailableIpAddressCount: aws.Int64(100)},
+		{SubnetId: aws.String("subnet-unmanaged"), AvailableIpAddressCount: aws.Int64(0)},
+	}
+
+	testCases := []struct {
+		name      string
+		threshold int64
+		want      []string
+	}{
+		{
+			name:      "no subnet below threshold",
+			threshold: 5,
+			want:      []string{},
+		},
+		{
+			name:      "subnets below threshold sorted by id",
+			threshold: 100,
+			want:      []string{"subnet-1 (99 available)", "subnet-3 (5 available)"},
+		},
+		{
+			name:      "subnet at threshold is sufficient",
+			threshold: 99,
+			want:      []string{"subnet-3 (5 available)"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(subnetsBelowFreeIPThreshold(subnets, spec, tc.threshold)).To(Equal(tc.want))
+		})
+	}
+}
+
+func TestReconcileSubnetFreeIPs(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	describeSubnets := func(available int64) func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+		return func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+			m.DescribeSubnets(gomock.AssignableToTypeOf(&ec2.DescribeSubnetsInput{})).
+				Return(&ec2.DescribeSubnetsOutput{
+					Subnets: []*ec2.Subnet{
+						{SubnetId: aws.String("subnet-1"), AvailableIpAddressCount: aws.Int64(available)},
+					},
+				}, nil)
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		threshold     *int64
+		expect        func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		wantCondition bool
+		wantStatus    bool
+	}{
+		{
+			name: "no threshold does not check subnets",
+		},
+		{
+			name:          "subnets above threshold",
+			threshold:     aws.Int64(10),
+			expect:        describeSubnets(200),
+			wantCondition: true,
+			wantStatus:    true,
+		},
+		{
+			name:          "subnets below threshold",
+			threshold:     aws.Int64(10),
+			expect:        describeSubnets(3),
+			wantCondition: true,
+			wantStatus:    false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrav1.AWSClusterSpec{
+						NetworkSpec: infrav1.NetworkSpec{
+							VPC: infrav1.VPCSpec{
+								ID:                    "vpc-subnets",
+								SubnetFreeIPThreshold: tc.threshold,
+							},
+							Subnets: infrav1.Subnets{{ID: "subnet-1"}},
+						},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			g.Expect(s.reconcileSubnetFreeIPs()).To(Succeed())
+
+			awsCluster := scope.InfraCluster()
+			g.Expect(conditions.Has(awsCluster, infrav1.SubnetsFreeIPsSufficientCondition)).To(Equal(tc.wantCondition))
+			if !tc.wantCondition {
+				return
+			}
+			g.Expect(conditions.IsTrue(awsCluster, infrav1.SubnetsFreeIPsSufficientCondition)).To(Equal(tc.wantStatus))
+			if !tc.wantStatus {
+				g.Expect(conditions.GetReason(awsCluster, infrav1.SubnetsFreeIPsSufficientCondition)).To(Equal(infrav1.SubnetsFreeIPsLowReason))
+			}
+		})
+	}
+}
@@ -116,8 +116,12 @@ func (s *Service) reconcileSubnets() error {
 			subnetTags := sub.Tags
 			// Make sure tags are up-to-date.
 			if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
-				buildParams := s.getSubnetTagParams(unmanagedVPC, existingSubnet.ID, existingSubnet.IsPublic, existingSubnet.AvailabilityZone, subnetTags)
-				tagsBuilder := tags.New(&buildParams, tags.WithEC2(s.EC2Client))
+				tier := sub.Tier
+				if tier == "" {
+					tier = existingSubnet.Tier
+				}
+				buildParams := s.getSubnetTagParams(unmanagedVPC, existingSubnet.ID, existingSubnet.IsPublic, existingSubnet.AvailabilityZone, tier, subnetTags)
+				tagsBuilder := tags.New(&buildParams, tags.WithEC2(s.EC2Client), tags.WithRemovedKeys(s.scope.RemovedCostAllocationTagKeys()...))
 				if err := tagsBuilder.Ensure(existingSubnet.Tags); err != nil {
 					return false, err
 				}
@@ -211,6 +215,10 @@ func (s *Service) getDefaultSubnets() (infrav1.Subnets, error) {
 		s.scope.V(2).Info("zones selected", "region", s.scope.Region(), "zones", zones)
 	}
 
+	if tiers := s.scope.VPC().SubnetTiers; len(tiers) > 0 {
+		return s.getTieredSubnets(zones, tiers)
+	}
+
 	// 1 private subnet for each AZ plus 1 other subnet that will be further sub-divided for the public subnets
 	numSubnets := len(zones) + 1
 	subnetCIDRs, err := cidr.SplitIntoSubnetsIPv4(s.scope.VPC().CidrBlock, numSubnets)
@@ -241,6 +249,37 @@ func (s *Service) getDefaultSubnets() (infrav1.Subnets, error) {
 	return subnets, nil
 }
 
+// getTieredSubnets splits the VPC CIDR block into one block per tier, each of which is
+// split again into one subnet per availability zone.
+func (s *Service) getTieredSubnets(zones []string, tiers []infrav1.SubnetTier) (infrav1.Subnets, error) {
+	if errs := s.scope.VPC().ValidateSubnetTiers(); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+
+	tierCIDRs, err := cidr.SplitIntoSubnetsIPv4(s.scope.VPC().CidrBlock, len(tiers))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed splitting VPC CIDR %s into subnet tiers", s.scope.VPC().CidrBlock)
+	}
+
+	subnets := infrav1.Subnets{}
+	for i, tier := range tiers {
+		subnetCIDRs, err := cidr.SplitIntoSubnetsIPv4(tierCIDRs[i].String(), len(zones))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed splitting CIDR %s into %s subnets", tierCIDRs[i].String(), tier)
+		}
+		for j, zone := range zones {
+			subnets = append(subnets, infrav1.SubnetSpec{
+				CidrBlock:        subnetCIDRs[j].String(),
+				AvailabilityZone: zone,
+				IsPublic:         tier == infrav1.SubnetTierLB,
+				Tier:             tier,
+			})
+		}
+	}
+
+	return subnets, nil
+}
+
 func (s *Service) deleteSubnets() error {
 	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
 		s.scope.V(4).Info("Skipping subnets deletion in unmanaged mode")
@@ -294,6 +333,10 @@ func (s *Service) describeVpcSubnets() (infrav1.Subnets, error) {
 			spec.IsPublic = true
 		}
 
+		if tier, ok := spec.Tags[infrav1.NameAWSSubnetTier]; ok {
+			spec.Tier = infrav1.SubnetTier(tier)
+		}
+
 		// ... or if it has an internet route
 		rt := routeTables[*ec2sn.SubnetId]
 		if rt == nil {
@@ -348,7 +391,7 @@ func (s *Service) createSubnet(sn *infrav1.SubnetSpec) (*infrav1.SubnetSpec, err
 		TagSpecifications: []*ec2.TagSpecification{
 			tags.BuildParamsToTagSpecification(
 				ec2.ResourceTypeSubnet,
-				s.getSubnetTagParams(false, services.TemporaryResourceID, sn.IsPublic, sn.AvailabilityZone, sn.Tags),
+				s.getSubnetTagParams(false, services.TemporaryResourceID, sn.IsPublic, sn.AvailabilityZone, sn.Tier, sn.Tags),
 			),
 		},
 	})
@@ -396,6 +439,7 @@ func (s *Service) createSubnet(sn *infrav1.SubnetSpec) (*infrav1.SubnetSpec, err
 		AvailabilityZone: *out.Subnet.AvailabilityZone,
 		CidrBlock:        *out.Subnet.CidrBlock,
 		IsPublic:         sn.IsPublic,
+		Tier:             sn.Tier,
 	}, nil
 }
 
@@ -413,7 +457,7 @@ func (s *Service) deleteSubnet(id string) error {
 	return nil
 }
 
-func (s *Service) getSubnetTagParams(unmanagedVPC bool, id string, public bool, zone string, manualTags infrav1.Tags) infrav1.BuildParams {
+func (s *Service) getSubnetTagParams(unmanagedVPC bool, id string, public bool, zone string, tier infrav1.SubnetTier, manualTags infrav1.Tags) infrav1.BuildParams {
 	var role string
 	additionalTags := make(map[string]string)
 
@@ -423,12 +467,22 @@ func (s *Service) getSubnetTagParams(unmanagedVPC bool, id string, public bool,
 
 	if public {
 		role = infrav1.PublicRoleTagValue
-		additionalTags[externalLoadBalancerTag] = "1"
 	} else {
 		role = infrav1.PrivateRoleTagValue
+	}
+
+	// Only the lb and node tiers are meant to host load balancers.
+	switch {
+	case public:
+		additionalTags[externalLoadBalancerTag] = "1"
+	case tier != infrav1.SubnetTierPod && tier != infrav1.SubnetTierDatabase:
 		additionalTags[internalLoadBalancerTag] = "1"
 	}
 
+	if tier != "" {
+		additionalTags[infrav1.NameAWSSubnetTier] = string(tier)
+	}
+
 	// Add tag needed for Service type=LoadBalancer
 	additionalTags[infrav1.NameKubernetesAWSCloudProviderPrefix+s.scope.KubernetesClusterName()] = string(infrav1.ResourceLifecycleShared)
 
@@ -440,7 +494,11 @@ func (s *Service) getSubnetTagParams(unmanagedVPC bool, id string, public bool,
 		var name strings.Builder
 		name.WriteString(s.scope.Name())
 		name.WriteString("-subnet-")
-		name.WriteString(role)
+		if tier != "" {
+			name.WriteString(string(tier))
+		} else {
+			name.WriteString(role)
+		}
 		name.WriteString("-")
 		name.WriteString(zone)
 
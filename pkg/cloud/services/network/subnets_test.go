@@ -2119,6 +2119,173 @@ type ScopeBuilder interface {
 	Build() (scope.NetworkScope, error)
 }
 
+func TestGetDefaultSubnetsWithTiers(t *testing.T) {
+	testCases := []struct {
+		name          string
+		tiers         []infrav1.SubnetTier
+		expect        infrav1.Subnets
+		errorExpected bool
+	}{
+		{
+			name:  "carves one subnet per tier and availability zone",
+			tiers: []infrav1.SubnetTier{infrav1.SubnetTierLB, infrav1.SubnetTierNode, infrav1.SubnetTierPod, infrav1.SubnetTierDatabase},
+			expect: infrav1.Subnets{
+				{CidrBlock: "10.0.0.0/19", AvailabilityZone: "us-east-1a", IsPublic: true, Tier: infrav1.SubnetTierLB},
+				{CidrBlock: "10.0.32.0/19", AvailabilityZone: "us-east-1b", IsPublic: true, Tier: infrav1.SubnetTierLB},
+				{CidrBlock: "10.0.64.0/19", AvailabilityZone: "us-east-1a", Tier: infrav1.SubnetTierNode},
+				{CidrBlock: "10.0.96.0/19", AvailabilityZone: "us-east-1b", Tier: infrav1.SubnetTierNode},
+				{CidrBlock: "10.0.128.0/19", AvailabilityZone: "us-east-1a", Tier: infrav1.SubnetTierPod},
+				{CidrBlock: "10.0.160.0/19", AvailabilityZone: "us-east-1b", Tier: infrav1.SubnetTierPod},
+				{CidrBlock: "10.0.192.0/19", AvailabilityZone: "us-east-1a", Tier: infrav1.SubnetTierDatabase},
+				{CidrBlock: "10.0.224.0/19", AvailabilityZone: "us-east-1b", Tier: infrav1.SubnetTierDatabase},
+			},
+		},
+		{
+			name:  "carves only the lb and node tiers",
+			tiers: []infrav1.SubnetTier{infrav1.SubnetTierLB, infrav1.SubnetTierNode},
+			expect: infrav1.Subnets{
+				{CidrBlock: "10.0.0.0/18", AvailabilityZone: "us-east-1a", IsPublic: true, Tier: infrav1.SubnetTierLB},
+				{CidrBlock: "10.0.64.0/18", AvailabilityZone: "us-east-1b", IsPublic: true, Tier: infrav1.SubnetTierLB},
+				{CidrBlock: "10.0.128.0/18", AvailabilityZone: "us-east-1a", Tier: infrav1.SubnetTierNode},
+				{CidrBlock: "10.0.192.0/18", AvailabilityZone: "us-east-1b", Tier: infrav1.SubnetTierNode},
+			},
+		},
+		{
+			name:          "fails without the node tier",
+			tiers:         []infrav1.SubnetTier{infrav1.SubnetTierLB, infrav1.SubnetTierPod},
+			errorExpected: true,
+		},
+		{
+			name:          "fails with a duplicated tier",
+			tiers:         []infrav1.SubnetTier{infrav1.SubnetTierLB, infrav1.SubnetTierNode, infrav1.SubnetTierNode},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+
+			scope, err := NewClusterScope().WithNetwork(&infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID:          subnetsVPCID,
+					CidrBlock:   defaultVPCCidr,
+					SubnetTiers: tc.tiers,
+				},
+			}).Build()
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			ec2Mock.EXPECT().DescribeAvailabilityZones(gomock.Any()).
+				Return(&ec2.DescribeAvailabilityZonesOutput{
+					AvailabilityZones: []*ec2.AvailabilityZone{
+						{ZoneName: aws.String("us-east-1a")},
+						{ZoneName: aws.String("us-east-1b")},
+					},
+				}, nil)
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+			subnets, err := s.getDefaultSubnets()
+
+			if tc.errorExpected {
+				if err == nil {
+					t.Fatal("expected error getting default subnets but got no error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got an unexpected error: %v", err)
+			}
+			if !cmp.Equal(subnets, tc.expect) {
+				t.Fatalf("mismatch in subnets: %s", cmp.Diff(tc.expect, subnets))
+			}
+		})
+	}
+}
+
+func TestGetSubnetTagParamsForTiers(t *testing.T) {
+	testCases := []struct {
+		name       string
+		public     bool
+		tier       infrav1.SubnetTier
+		expectName string
+		expectTags map[string]string
+	}{
+		{
+			name:       "lb tier is tagged for internet-facing load balancers",
+			public:     true,
+			tier:       infrav1.SubnetTierLB,
+			expectName: "test-cluster-subnet-lb-us-east-1a",
+			expectTags: map[string]string{
+				"kubernetes.io/role/elb":                           "1",
+				"kubernetes.io/cluster/test-cluster":               "shared",
+				"sigs.k8s.io/cluster-api-provider-aws/subnet-tier": "lb",
+			},
+		},
+		{
+			name:       "node tier is tagged for internal load balancers",
+			tier:       infrav1.SubnetTierNode,
+			expectName: "test-cluster-subnet-node-us-east-1a",
+			expectTags: map[string]string{
+				"kubernetes.io/role/internal-elb":                  "1",
+				"kubernetes.io/cluster/test-cluster":               "shared",
+				"sigs.k8s.io/cluster-api-provider-aws/subnet-tier": "node",
+			},
+		},
+		{
+			name:       "pod tier is not tagged for load balancers",
+			tier:       infrav1.SubnetTierPod,
+			expectName: "test-cluster-subnet-pod-us-east-1a",
+			expectTags: map[string]string{
+				"kubernetes.io/cluster/test-cluster":               "shared",
+				"sigs.k8s.io/cluster-api-provider-aws/subnet-tier": "pod",
+			},
+		},
+		{
+			name:       "database tier is not tagged for load balancers",
+			tier:       infrav1.SubnetTierDatabase,
+			expectName: "test-cluster-subnet-database-us-east-1a",
+			expectTags: map[string]string{
+				"kubernetes.io/cluster/test-cluster":               "shared",
+				"sigs.k8s.io/cluster-api-provider-aws/subnet-tier": "database",
+			},
+		},
+		{
+			name:       "subnet without tier keeps the role based tags",
+			expectName: "test-cluster-subnet-private-us-east-1a",
+			expectTags: map[string]string{
+				"kubernetes.io/role/internal-elb":    "1",
+				"kubernetes.io/cluster/test-cluster": "shared",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			scope, err := NewClusterScope().WithNetwork(&infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{ID: subnetsVPCID},
+			}).Build()
+			if err != nil {
+				t.Fatalf("Failed to create test context: %v", err)
+			}
+
+			s := NewService(scope)
+			params := s.getSubnetTagParams(false, "subnet-1", tc.public, "us-east-1a", tc.tier, nil)
+
+			if aws.StringValue(params.Name) != tc.expectName {
+				t.Fatalf("expected name %q, got %q", tc.expectName, aws.StringValue(params.Name))
+			}
+			if !cmp.Equal(map[string]string(params.Additional), tc.expectTags) {
+				t.Fatalf("mismatch in tags: %s", cmp.Diff(tc.expectTags, map[string]string(params.Additional)))
+			}
+		})
+	}
+}
+
 func NewClusterScope() *ClusterScopeBuilder {
 	return &ClusterScopeBuilder{
 		customizers: []func(p *scope.ClusterScopeParams){},
@@ -63,6 +63,19 @@ func (s *Service) reconcileVPC() error {
 			return nil
 		}
 
+		// Make sure tags are up to date.
+		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+			buildParams := s.getVPCTagParams(vpc.ID)
+			tagsBuilder := tags.New(&buildParams, tags.WithEC2(s.EC2Client), tags.WithRemovedKeys(s.scope.RemovedCostAllocationTagKeys()...))
+			if err := tagsBuilder.Ensure(vpc.Tags); err != nil {
+				return false, err
+			}
+			return true, nil
+		}, awserrors.VPCNotFound); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedTagVPC", "Failed to tag managed VPC %q: %v", vpc.ID, err)
+			return errors.Wrapf(err, "failed to tag vpc %q", vpc.ID)
+		}
+
 		// if the VPC is managed, make managed sure attributes are configured.
 		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
 			if err := s.ensureManagedVPCAttributes(vpc); err != nil {
@@ -182,6 +195,9 @@ func (s *Service) createVPC() (*infrav1.VPCSpec, error) {
 			tags.BuildParamsToTagSpecification(ec2.ResourceTypeVpc, s.getVPCTagParams(services.TemporaryResourceID)),
 		},
 	}
+	if s.scope.VPC().InstanceTenancy != "" {
+		input.InstanceTenancy = aws.String(s.scope.VPC().InstanceTenancy)
+	}
 
 	out, err := s.EC2Client.CreateVpc(input)
 	if err != nil {
@@ -85,11 +85,12 @@ func TestReconcileVPC(t *testing.T) {
 	}
 
 	testCases := []struct {
-		name    string
-		input   *infrav1.VPCSpec
-		want    *infrav1.VPCSpec
-		expect  func(m *mock_ec2iface.MockEC2APIMockRecorder)
-		wantErr bool
+		name               string
+		input              *infrav1.VPCSpec
+		costAllocationTags infrav1.Tags
+		want               *infrav1.VPCSpec
+		expect             func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		wantErr            bool
 	}{
 		{
 			name:  "Should update tags with aws VPC resource tags, if managed vpc exists",
@@ -132,6 +133,60 @@ func TestReconcileVPC(t *testing.T) {
 					DoAndReturn(describeVpcAttributeTrue).AnyTimes()
 			},
 		},
+		{
+			name:               "Should add cost allocation tags to managed vpc, if missing",
+			input:              &infrav1.VPCSpec{ID: "vpc-exists", AvailabilityZoneUsageLimit: &usageLimit, AvailabilityZoneSelection: &selection},
+			costAllocationTags: infrav1.Tags{"cost-center": "1234"},
+			want: &infrav1.VPCSpec{
+				ID:        "vpc-exists",
+				CidrBlock: "10.0.0.0/8",
+				Tags: map[string]string{
+					"sigs.k8s.io/cluster-api-provider-aws/role": "common",
+					"Name": "test-cluster-vpc",
+					"sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster": "owned",
+				},
+				AvailabilityZoneUsageLimit: &usageLimit,
+				AvailabilityZoneSelection:  &selection,
+			},
+			wantErr: false,
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeVpcs(gomock.AssignableToTypeOf(&ec2.DescribeVpcsInput{})).Return(&ec2.DescribeVpcsOutput{
+					Vpcs: []*ec2.Vpc{
+						{
+							State:     aws.String("available"),
+							VpcId:     aws.String("vpc-exists"),
+							CidrBlock: aws.String("10.0.0.0/8"),
+							Tags:      tags,
+						},
+					},
+				}, nil)
+
+				m.CreateTags(gomock.Eq(&ec2.CreateTagsInput{
+					Resources: aws.StringSlice([]string{"vpc-exists"}),
+					Tags: []*ec2.Tag{
+						{
+							Key:   aws.String("Name"),
+							Value: aws.String("test-cluster-vpc"),
+						},
+						{
+							Key:   aws.String("cost-center"),
+							Value: aws.String("1234"),
+						},
+						{
+							Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+							Value: aws.String("owned"),
+						},
+						{
+							Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+							Value: aws.String("common"),
+						},
+					},
+				})).Return(&ec2.CreateTagsOutput{}, nil)
+
+				m.DescribeVpcAttribute(gomock.AssignableToTypeOf(&ec2.DescribeVpcAttributeInput{})).
+					DoAndReturn(describeVpcAttributeTrue).AnyTimes()
+			},
+		},
 		{
 			name:    "Should create a new VPC if managed vpc does not exist",
 			input:   &infrav1.VPCSpec{AvailabilityZoneUsageLimit: &usageLimit, AvailabilityZoneSelection: &selection},
@@ -309,6 +364,43 @@ func TestReconcileVPC(t *testing.T) {
 				}, nil)
 			},
 		},
+		{
+			name:    "Should create a new VPC with dedicated tenancy",
+			input:   &infrav1.VPCSpec{InstanceTenancy: "dedicated", AvailabilityZoneUsageLimit: &usageLimit, AvailabilityZoneSelection: &selection},
+			wantErr: false,
+			want: &infrav1.VPCSpec{
+				ID:        "vpc-new",
+				CidrBlock: "10.1.0.0/16",
+				Tags: map[string]string{
+					"sigs.k8s.io/cluster-api-provider-aws/role": "common",
+					"Name": "test-cluster-vpc",
+					"sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster": "owned",
+				},
+				InstanceTenancy:            "dedicated",
+				AvailabilityZoneUsageLimit: &usageLimit,
+				AvailabilityZoneSelection:  &selection,
+			},
+			expect: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.CreateVpc(gomock.AssignableToTypeOf(&ec2.CreateVpcInput{})).DoAndReturn(func(input *ec2.CreateVpcInput) (*ec2.CreateVpcOutput, error) {
+					if aws.StringValue(input.InstanceTenancy) != "dedicated" {
+						t.Fatalf("expected dedicated instance tenancy, got %q", aws.StringValue(input.InstanceTenancy))
+					}
+					return &ec2.CreateVpcOutput{
+						Vpc: &ec2.Vpc{
+							State:     aws.String("available"),
+							VpcId:     aws.String("vpc-new"),
+							CidrBlock: aws.String("10.1.0.0/16"),
+							Tags:      tags,
+						},
+					}, nil
+				})
+
+				m.DescribeVpcAttribute(gomock.AssignableToTypeOf(&ec2.DescribeVpcAttributeInput{})).
+					DoAndReturn(describeVpcAttributeFalse).MinTimes(1)
+
+				m.ModifyVpcAttribute(gomock.AssignableToTypeOf(&ec2.ModifyVpcAttributeInput{})).Return(&ec2.ModifyVpcAttributeOutput{}, nil).Times(2)
+			},
+		},
 		{
 			name:    "Should return error if vpc state is not available/pending",
 			input:   &infrav1.VPCSpec{ID: "managed-vpc-exists", AvailabilityZoneUsageLimit: &usageLimit, AvailabilityZoneSelection: &selection},
@@ -330,6 +422,7 @@ func TestReconcileVPC(t *testing.T) {
 			g := NewWithT(t)
 			clusterScope, err := getClusterScope(tc.input)
 			g.Expect(err).NotTo(HaveOccurred())
+			clusterScope.AWSCluster.Spec.CostAllocationTags = tc.costAllocationTags
 			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
 			tc.expect(ec2Mock.EXPECT())
 			s := NewService(clusterScope)
@@ -0,0 +1,365 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeproblemdetector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/internal/managed"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const (
+	npdName         = "node-problem-detector"
+	npdNamespace    = metav1.NamespaceSystem
+	npdConfigName   = "node-problem-detector-config"
+	npdClusterRole  = "system:node-problem-detector"
+	npdDefaultImage = "registry.k8s.io/node-problem-detector/node-problem-detector:v0.8.12"
+
+	// builtinConfigDir is where the node-problem-detector image ships its monitor configurations.
+	builtinConfigDir = "/config"
+	customConfigDir  = "/custom-config"
+	customConfigKey  = "capa-monitor.json"
+)
+
+// ReconcileNodeProblemDetector installs node-problem-detector in the cluster when it is configured,
+// and removes the resources previously created by CAPA otherwise. Whether node-problem-detector was
+// installed is tracked by the NodeProblemDetectorReady condition.
+func (s *Service) ReconcileNodeProblemDetector(ctx context.Context) error {
+	npd := s.scope.NodeProblemDetector()
+	if npd == nil && !conditions.Has(s.scope.InfraCluster(), ekscontrolplanev1.NodeProblemDetectorReadyCondition) {
+		return nil
+	}
+
+	s.scope.Info("Reconciling node-problem-detector in cluster", "cluster-name", s.scope.Name(), "cluster-namespace", s.scope.Namespace())
+
+	remoteClient, err := s.scope.RemoteClient()
+	if err != nil {
+		s.scope.Error(err, "getting client for remote cluster")
+		return fmt.Errorf("getting client for remote cluster: %w", err)
+	}
+
+	if npd == nil {
+		if err := s.deleteNodeProblemDetector(ctx, remoteClient); err != nil {
+			return err
+		}
+		conditions.Delete(s.scope.InfraCluster(), ekscontrolplanev1.NodeProblemDetectorReadyCondition)
+		return nil
+	}
+
+	if err := s.installNodeProblemDetector(ctx, remoteClient, npd); err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), ekscontrolplanev1.NodeProblemDetectorReadyCondition, ekscontrolplanev1.NodeProblemDetectorReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return err
+	}
+	conditions.MarkTrue(s.scope.InfraCluster(), ekscontrolplanev1.NodeProblemDetectorReadyCondition)
+
+	return nil
+}
+
+func (s *Service) installNodeProblemDetector(ctx context.Context, remoteClient client.Client, npd *ekscontrolplanev1.NodeProblemDetector) error {
+	config, err := monitorConfig(npd.Rules)
+	if err != nil {
+		return fmt.Errorf("generating node-problem-detector config: %w", err)
+	}
+
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: s.objectMeta(npdName, npdNamespace),
+	}
+	if err := s.ensureObject(ctx, remoteClient, serviceAccount, &corev1.ServiceAccount{}, nil); err != nil {
+		return err
+	}
+
+	binding := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: s.objectMeta(npdName, ""),
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     npdClusterRole,
+		},
+		Subjects: []rbacv1.Subject{{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      npdName,
+			Namespace: npdNamespace,
+		}},
+	}
+	if err := s.ensureObject(ctx, remoteClient, binding, &rbacv1.ClusterRoleBinding{}, func(current client.Object) {
+		current.(*rbacv1.ClusterRoleBinding).Subjects = binding.Subjects
+	}); err != nil {
+		return err
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: s.objectMeta(npdConfigName, npdNamespace),
+		Data: map[string]string{
+			customConfigKey: config,
+		},
+	}
+	if err := s.ensureObject(ctx, remoteClient, configMap, &corev1.ConfigMap{}, func(current client.Object) {
+		current.(*corev1.ConfigMap).Data = configMap.Data
+	}); err != nil {
+		return err
+	}
+
+	ds := s.daemonSet(npd)
+	if err := s.ensureObject(ctx, remoteClient, ds, &appsv1.DaemonSet{}, func(current client.Object) {
+		// Only the fields derived from the configuration are updated, the others are defaulted
+		// by the API server and would never compare equal.
+		containers := current.(*appsv1.DaemonSet).Spec.Template.Spec.Containers
+		for i := range containers {
+			if containers[i].Name == npdName {
+				containers[i].Image = ds.Spec.Template.Spec.Containers[0].Image
+				containers[i].Command = ds.Spec.Template.Spec.Containers[0].Command
+			}
+		}
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ensureObject creates the desired object, or updates the current one when mutate changes it.
+// Objects that weren't created by CAPA are left untouched.
+func (s *Service) ensureObject(ctx context.Context, remoteClient client.Client, desired, current client.Object, mutate func(current client.Object)) error {
+	kind := strings.TrimPrefix(fmt.Sprintf("%T", desired), "*")
+
+	err := remoteClient.Get(ctx, client.ObjectKeyFromObject(desired), current)
+	if apierrors.IsNotFound(err) {
+		s.scope.Info("Creating node-problem-detector resource", "kind", kind, "name", desired.GetName())
+		if err := remoteClient.Create(ctx, desired, &client.CreateOptions{}); err != nil {
+			return fmt.Errorf("creating node-problem-detector %s: %w", kind, err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("getting node-problem-detector %s: %w", kind, err)
+	}
+
+	if !managed.HasLabels(current, s.metaLabels()) {
+		s.scope.Info("Skipping node-problem-detector resource not managed by CAPA", "kind", kind, "name", desired.GetName())
+		return nil
+	}
+
+	if mutate == nil {
+		return nil
+	}
+	before := current.DeepCopyObject()
+	mutate(current)
+	if equality.Semantic.DeepEqual(before, current) {
+		return nil
+	}
+
+	s.scope.Info("Updating node-problem-detector resource", "kind", kind, "name", desired.GetName())
+	if err := remoteClient.Update(ctx, current, &client.UpdateOptions{}); err != nil {
+		return fmt.Errorf("updating node-problem-detector %s: %w", kind, err)
+	}
+	return nil
+}
+
+func (s *Service) deleteNodeProblemDetector(ctx context.Context, remoteClient client.Client) error {
+	objs := []client.Object{
+		&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: npdName, Namespace: npdNamespace}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: npdConfigName, Namespace: npdNamespace}},
+		&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: npdName}},
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: npdName, Namespace: npdNamespace}},
+	}
+
+	for _, obj := range objs {
+		kind := strings.TrimPrefix(fmt.Sprintf("%T", obj), "*")
+		if err := remoteClient.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("getting node-problem-detector %s: %w", kind, err)
+		}
+		if !managed.HasLabels(obj, s.metaLabels()) {
+			continue
+		}
+
+		s.scope.Info("Deleting node-problem-detector resource", "kind", kind, "name", obj.GetName())
+		if err := remoteClient.Delete(ctx, obj, &client.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("deleting node-problem-detector %s: %w", kind, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) daemonSet(npd *ekscontrolplanev1.NodeProblemDetector) *appsv1.DaemonSet {
+	image := npd.Image
+	if image == "" {
+		image = npdDefaultImage
+	}
+
+	configs := make([]string, 0, len(npd.Monitors)+1)
+	for _, monitor := range npd.Monitors {
+		configs = append(configs, path.Join(builtinConfigDir, monitor+".json"))
+	}
+	if len(npd.Rules) > 0 {
+		configs = append(configs, path.Join(customConfigDir, customConfigKey))
+	}
+	command := []string{"/node-problem-detector", "--logtostderr"}
+	if len(configs) > 0 {
+		command = append(command, "--config.system-log-monitor="+strings.Join(configs, ","))
+	}
+
+	podLabels := map[string]string{"app": npdName}
+	resources := corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("10m"),
+		corev1.ResourceMemory: resource.MustParse("80Mi"),
+	}
+	hostPathFileOrCreate := corev1.HostPathFileOrCreate
+
+	return &appsv1.DaemonSet{
+		ObjectMeta: s.objectMeta(npdName, npdNamespace),
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: podLabels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: podLabels},
+				Spec: corev1.PodSpec{
+					ServiceAccountName: npdName,
+					Containers: []corev1.Container{{
+						Name:    npdName,
+						Image:   image,
+						Command: command,
+						Env: []corev1.EnvVar{{
+							Name: "NODE_NAME",
+							ValueFrom: &corev1.EnvVarSource{
+								FieldRef: &corev1.ObjectFieldSelector{FieldPath: "spec.nodeName"},
+							},
+						}},
+						Resources: corev1.ResourceRequirements{
+							Limits:   resources,
+							Requests: resources,
+						},
+						// node-problem-detector reads /dev/kmsg, which requires a privileged container.
+						SecurityContext: &corev1.SecurityContext{Privileged: pointer.Bool(true)},
+						VolumeMounts: []corev1.VolumeMount{
+							{Name: "log", MountPath: "/var/log", ReadOnly: true},
+							{Name: "kmsg", MountPath: "/dev/kmsg", ReadOnly: true},
+							{Name: "localtime", MountPath: "/etc/localtime", ReadOnly: true},
+							{Name: "config", MountPath: customConfigDir, ReadOnly: true},
+						},
+					}},
+					Tolerations: []corev1.Toleration{
+						{Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute},
+						{Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+					},
+					Volumes: []corev1.Volume{
+						{Name: "log", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/var/log"}}},
+						{Name: "kmsg", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/dev/kmsg"}}},
+						{Name: "localtime", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/etc/localtime", Type: &hostPathFileOrCreate}}},
+						{Name: "config", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+							LocalObjectReference: corev1.LocalObjectReference{Name: npdConfigName},
+						}}},
+					},
+				},
+			},
+		},
+	}
+}
+
+type systemLogMonitorConfig struct {
+	Plugin     string             `json:"plugin"`
+	LogPath    string             `json:"logPath"`
+	Lookback   string             `json:"lookback"`
+	BufferSize int                `json:"bufferSize"`
+	Source     string             `json:"source"`
+	Conditions []monitorCondition `json:"conditions"`
+	Rules      []monitorRule      `json:"rules"`
+}
+
+type monitorCondition struct {
+	Type    string `json:"type"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+type monitorRule struct {
+	Type      string `json:"type"`
+	Condition string `json:"condition,omitempty"`
+	Reason    string `json:"reason"`
+	Pattern   string `json:"pattern"`
+}
+
+// monitorConfig generates the kernel log monitor configuration of the user provided rules.
+// Every condition set by a permanent rule is declared with its default, healthy state.
+func monitorConfig(rules []ekscontrolplanev1.NodeProblemDetectorRule) (string, error) {
+	config := systemLogMonitorConfig{
+		Plugin:     "kmsg",
+		LogPath:    "/dev/kmsg",
+		Lookback:   "5m",
+		BufferSize: 10,
+		Source:     "capa-monitor",
+		Conditions: []monitorCondition{},
+		Rules:      []monitorRule{},
+	}
+
+	conditions := map[string]bool{}
+	for _, rule := range rules {
+		config.Rules = append(config.Rules, monitorRule{
+			Type:      string(rule.Type),
+			Condition: rule.Condition,
+			Reason:    rule.Reason,
+			Pattern:   rule.Pattern,
+		})
+
+		if rule.Type != ekscontrolplanev1.NodeProblemDetectorRulePermanent || conditions[rule.Condition] {
+			continue
+		}
+		conditions[rule.Condition] = true
+		config.Conditions = append(config.Conditions, monitorCondition{
+			Type:    rule.Condition,
+			Reason:  "No" + rule.Condition,
+			Message: fmt.Sprintf("%s is not detected", rule.Condition),
+		})
+	}
+
+	out, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+func (s *Service) objectMeta(name, namespace string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: namespace,
+		Labels:    s.metaLabels(),
+	}
+}
+
+func (s *Service) metaLabels() map[string]string {
+	return managed.Labels(s.scope.Name())
+}
@@ -0,0 +1,286 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeproblemdetector
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestReconcileNodeProblemDetector(t *testing.T) {
+	managedLabels := map[string]string{
+		"app.kubernetes.io/managed-by": "cluster-api-provider-aws",
+		"app.kubernetes.io/part-of":    "mock-name",
+	}
+
+	tests := []struct {
+		name          string
+		npd           *ekscontrolplanev1.NodeProblemDetector
+		installed     bool
+		existing      []client.Object
+		expectExists  bool
+		expectImage   string
+		expectCommand []string
+	}{
+		{
+			name:         "not configured",
+			expectExists: false,
+		},
+		{
+			name: "installs with the default image and builtin monitors",
+			npd: &ekscontrolplanev1.NodeProblemDetector{
+				Monitors: []string{"kernel-monitor", "docker-monitor"},
+			},
+			expectExists: true,
+			expectImage:  npdDefaultImage,
+			expectCommand: []string{
+				"/node-problem-detector",
+				"--logtostderr",
+				"--config.system-log-monitor=/config/kernel-monitor.json,/config/docker-monitor.json",
+			},
+		},
+		{
+			name: "installs with custom rules",
+			npd: &ekscontrolplanev1.NodeProblemDetector{
+				Image:    "example.com/npd:v1",
+				Monitors: []string{"kernel-monitor"},
+				Rules: []ekscontrolplanev1.NodeProblemDetectorRule{
+					{Type: ekscontrolplanev1.NodeProblemDetectorRuleTemporary, Reason: "EBSStuck", Pattern: "nvme.*timeout"},
+				},
+			},
+			expectExists: true,
+			expectImage:  "example.com/npd:v1",
+			expectCommand: []string{
+				"/node-problem-detector",
+				"--logtostderr",
+				"--config.system-log-monitor=/config/kernel-monitor.json,/custom-config/capa-monitor.json",
+			},
+		},
+		{
+			name: "updates the image of an existing DaemonSet",
+			npd: &ekscontrolplanev1.NodeProblemDetector{
+				Image: "example.com/npd:v2",
+			},
+			existing: []client.Object{
+				&appsv1.DaemonSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "node-problem-detector", Namespace: "kube-system", Labels: managedLabels},
+					Spec: appsv1.DaemonSetSpec{
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{{Name: "node-problem-detector", Image: "example.com/npd:v1"}},
+							},
+						},
+					},
+				},
+			},
+			expectExists:  true,
+			expectImage:   "example.com/npd:v2",
+			expectCommand: []string{"/node-problem-detector", "--logtostderr"},
+		},
+		{
+			name:      "deletes managed resources when no longer configured",
+			installed: true,
+			existing: []client.Object{
+				&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "node-problem-detector", Namespace: "kube-system", Labels: managedLabels}},
+				&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "node-problem-detector-config", Namespace: "kube-system", Labels: managedLabels}},
+				&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "node-problem-detector", Labels: managedLabels}},
+				&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "node-problem-detector", Namespace: "kube-system", Labels: managedLabels}},
+			},
+			expectExists: false,
+		},
+		{
+			name:      "leaves unmanaged DaemonSet alone",
+			installed: true,
+			existing: []client.Object{
+				&appsv1.DaemonSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "node-problem-detector", Namespace: "kube-system"},
+					Spec: appsv1.DaemonSetSpec{
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{{Name: "node-problem-detector", Image: "example.com/npd:v1"}},
+							},
+						},
+					},
+				},
+			},
+			expectExists: true,
+			expectImage:  "example.com/npd:v1",
+		},
+		{
+			name: "does not update unmanaged DaemonSet",
+			npd: &ekscontrolplanev1.NodeProblemDetector{
+				Image: "example.com/npd:v2",
+			},
+			existing: []client.Object{
+				&appsv1.DaemonSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "node-problem-detector", Namespace: "kube-system"},
+					Spec: appsv1.DaemonSetSpec{
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{{Name: "node-problem-detector", Image: "example.com/npd:v1"}},
+							},
+						},
+					},
+				},
+			},
+			expectExists: true,
+			expectImage:  "example.com/npd:v1",
+		},
+		{
+			name: "does not delete resources when never installed",
+			existing: []client.Object{
+				&appsv1.DaemonSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "node-problem-detector", Namespace: "kube-system", Labels: managedLabels},
+					Spec: appsv1.DaemonSetSpec{
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{{Name: "node-problem-detector", Image: "example.com/npd:v1"}},
+							},
+						},
+					},
+				},
+			},
+			expectExists: true,
+			expectImage:  "example.com/npd:v1",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			g.Expect(appsv1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(rbacv1.AddToScheme(scheme)).To(Succeed())
+			remoteClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tc.existing...).Build()
+
+			controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{}
+			if tc.installed {
+				conditions.MarkTrue(controlPlane, ekscontrolplanev1.NodeProblemDetectorReadyCondition)
+			}
+			s := NewService(&mockScope{
+				client:       remoteClient,
+				npd:          tc.npd,
+				controlPlane: controlPlane,
+			})
+
+			// Reconcile twice to ensure the reconcile is idempotent.
+			for i := 0; i < 2; i++ {
+				g.Expect(s.ReconcileNodeProblemDetector(context.Background())).To(Succeed())
+			}
+
+			ds := &appsv1.DaemonSet{}
+			err := remoteClient.Get(context.Background(), types.NamespacedName{Namespace: "kube-system", Name: "node-problem-detector"}, ds)
+			if !tc.expectExists {
+				g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+				binding := &rbacv1.ClusterRoleBinding{}
+				err = remoteClient.Get(context.Background(), types.NamespacedName{Name: "node-problem-detector"}, binding)
+				g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+				g.Expect(conditions.Has(controlPlane, ekscontrolplanev1.NodeProblemDetectorReadyCondition)).To(BeFalse())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(ds.Spec.Template.Spec.Containers).To(HaveLen(1))
+			g.Expect(ds.Spec.Template.Spec.Containers[0].Image).To(Equal(tc.expectImage))
+			g.Expect(ds.Spec.Template.Spec.Containers[0].Command).To(Equal(tc.expectCommand))
+
+			if tc.npd == nil {
+				return
+			}
+			g.Expect(conditions.IsTrue(controlPlane, ekscontrolplanev1.NodeProblemDetectorReadyCondition)).To(BeTrue())
+			binding := &rbacv1.ClusterRoleBinding{}
+			g.Expect(remoteClient.Get(context.Background(), types.NamespacedName{Name: "node-problem-detector"}, binding)).To(Succeed())
+			g.Expect(binding.RoleRef.Name).To(Equal("system:node-problem-detector"))
+			cm := &corev1.ConfigMap{}
+			g.Expect(remoteClient.Get(context.Background(), types.NamespacedName{Namespace: "kube-system", Name: "node-problem-detector-config"}, cm)).To(Succeed())
+			g.Expect(cm.Data).To(HaveKey("capa-monitor.json"))
+		})
+	}
+}
+
+func TestMonitorConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	out, err := monitorConfig([]ekscontrolplanev1.NodeProblemDetectorRule{
+		{Type: ekscontrolplanev1.NodeProblemDetectorRuleTemporary, Reason: "EBSStuck", Pattern: "nvme.*timeout"},
+		{Type: ekscontrolplanev1.NodeProblemDetectorRulePermanent, Condition: "ReadonlyFilesystem", Reason: "FilesystemIsReadOnly", Pattern: "Remounting filesystem read-only"},
+		{Type: ekscontrolplanev1.NodeProblemDetectorRulePermanent, Condition: "ReadonlyFilesystem", Reason: "XFSShutdown", Pattern: "XFS.*Shutting down filesystem"},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	config := systemLogMonitorConfig{}
+	g.Expect(json.Unmarshal([]byte(out), &config)).To(Succeed())
+	g.Expect(config.Plugin).To(Equal("kmsg"))
+	g.Expect(config.LogPath).To(Equal("/dev/kmsg"))
+	g.Expect(config.Source).To(Equal("capa-monitor"))
+	g.Expect(config.Conditions).To(Equal([]monitorCondition{
+		{Type: "ReadonlyFilesystem", Reason: "NoReadonlyFilesystem", Message: "ReadonlyFilesystem is not detected"},
+	}))
+	g.Expect(config.Rules).To(Equal([]monitorRule{
+		{Type: "temporary", Reason: "EBSStuck", Pattern: "nvme.*timeout"},
+		{Type: "permanent", Condition: "ReadonlyFilesystem", Reason: "FilesystemIsReadOnly", Pattern: "Remounting filesystem read-only"},
+		{Type: "permanent", Condition: "ReadonlyFilesystem", Reason: "XFSShutdown", Pattern: "XFS.*Shutting down filesystem"},
+	}))
+}
+
+type mockScope struct {
+	scope.NodeProblemDetectorScope
+	client       client.Client
+	npd          *ekscontrolplanev1.NodeProblemDetector
+	controlPlane *ekscontrolplanev1.AWSManagedControlPlane
+}
+
+func (s *mockScope) InfraCluster() cloud.ClusterObject {
+	return s.controlPlane
+}
+
+func (s *mockScope) RemoteClient() (client.Client, error) {
+	return s.client, nil
+}
+
+func (s *mockScope) NodeProblemDetector() *ekscontrolplanev1.NodeProblemDetector {
+	return s.npd
+}
+
+func (s *mockScope) Info(msg string, keysAndValues ...interface{}) {
+
+}
+
+func (s *mockScope) Name() string {
+	return "mock-name"
+}
+
+func (s *mockScope) Namespace() string {
+	return "mock-namespace"
+}
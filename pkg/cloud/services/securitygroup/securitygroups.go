@@ -123,7 +123,7 @@ func (s *Service) ReconcileSecurityGroups() error {
 			// Make sure tags are up to date.
 			if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
 				buildParams := s.getSecurityGroupTagParams(existing.Name, existing.ID, role)
-				tagsBuilder := tags.New(&buildParams, tags.WithEC2(s.EC2Client))
+				tagsBuilder := tags.New(&buildParams, tags.WithEC2(s.EC2Client), tags.WithRemovedKeys(s.scope.RemovedCostAllocationTagKeys()...))
 				if err := tagsBuilder.Ensure(existing.Tags); err != nil {
 					return false, err
 				}
@@ -488,17 +488,23 @@ func (s *Service) getSecurityGroupIngressRules(role infrav1.SecurityGroupRole) (
 			},
 		}, nil
 	case infrav1.SecurityGroupControlPlane:
+		apiServerSources := []string{
+			s.scope.SecurityGroups()[infrav1.SecurityGroupAPIServerLB].ID,
+			s.scope.SecurityGroups()[infrav1.SecurityGroupControlPlane].ID,
+			s.scope.SecurityGroups()[infrav1.SecurityGroupNode].ID,
+		}
+		// An existing load balancer isn't attached to the API server load balancer security group,
+		// its own security groups are allowed once the load balancer has been found.
+		if lb := s.scope.ControlPlaneLoadBalancer(); lb != nil && lb.Existing != nil {
+			apiServerSources = append(apiServerSources, s.scope.Network().APIServerELB.SecurityGroupIDs...)
+		}
 		rules := infrav1.IngressRules{
 			{
-				Description: "Kubernetes API",
-				Protocol:    infrav1.SecurityGroupProtocolTCP,
-				FromPort:    6443,
-				ToPort:      6443,
-				SourceSecurityGroupIDs: []string{
-					s.scope.SecurityGroups()[infrav1.SecurityGroupAPIServerLB].ID,
-					s.scope.SecurityGroups()[infrav1.SecurityGroupControlPlane].ID,
-					s.scope.SecurityGroups()[infrav1.SecurityGroupNode].ID,
-				},
+				Description:            "Kubernetes API",
+				Protocol:               infrav1.SecurityGroupProtocolTCP,
+				FromPort:               6443,
+				ToPort:                 6443,
+				SourceSecurityGroupIDs: apiServerSources,
 			},
 			{
 				Description:            "etcd",
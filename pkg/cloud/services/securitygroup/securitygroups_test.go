@@ -401,6 +401,50 @@ func TestControlPlaneSecurityGroupNotOpenToAnyCIDR(t *testing.T) {
 	}
 }
 
+func TestControlPlaneSecurityGroupAllowsExistingLoadBalancer(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			Spec: infrav1.AWSClusterSpec{
+				ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+					Existing: &infrav1.ExistingLoadBalancer{
+						ARN: "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/corp-apiserver",
+					},
+				},
+			},
+			Status: infrav1.AWSClusterStatus{
+				Network: infrav1.NetworkStatus{
+					APIServerELB: infrav1.ClassicELB{
+						SecurityGroupIDs: []string{"sg-corp-lb"},
+					},
+				},
+			},
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s := NewService(cs, testSecurityGroupRoles)
+	rules, err := s.getSecurityGroupIngressRules(infrav1.SecurityGroupControlPlane)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	var apiServerRule *infrav1.IngressRule
+	for i := range rules {
+		if rules[i].Description == "Kubernetes API" {
+			apiServerRule = &rules[i]
+		}
+	}
+	g.Expect(apiServerRule).NotTo(BeNil())
+	g.Expect(apiServerRule.SourceSecurityGroupIDs).To(ContainElement("sg-corp-lb"))
+}
+
 func TestDeleteSecurityGroups(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -0,0 +1,268 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicediscovery
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/hash"
+)
+
+const (
+	// maxCreatorRequestIDLength is the maximum length of the CreatorRequestId accepted by AWS Cloud Map.
+	maxCreatorRequestIDLength = 64
+
+	// NamespaceNotReadyRequeueAfter is how long to wait before checking on a namespace that is still being created.
+	NamespaceNotReadyRequeueAfter = 15 * time.Second
+)
+
+// ErrNamespaceNotReady is returned while the AWS Cloud Map namespace is still being created.
+var ErrNamespaceNotReady = errors.New("service discovery namespace is still being created")
+
+// ReconcileNamespace ensures the AWS Cloud Map private DNS namespace exists for the cluster VPC
+// and records it in the cluster status. ErrNamespaceNotReady is returned while the namespace is
+// still being created. If service discovery is no longer configured, the namespace recorded in
+// status is deleted.
+func (s *Service) ReconcileNamespace() error {
+	spec := s.scope.ServiceDiscovery()
+	if spec == nil {
+		if status := s.scope.ServiceDiscoveryStatus(); status != nil && status.NamespaceID != "" {
+			return s.DeleteNamespace()
+		}
+		return nil
+	}
+
+	vpcID := s.scope.VPC().ID
+	if vpcID == "" {
+		return errors.New("vpc ID is not set, can't create service discovery namespace")
+	}
+
+	s.scope.V(2).Info("Reconciling service discovery namespace", "name", spec.NamespaceName)
+
+	namespace, err := s.findNamespace(spec.NamespaceName)
+	if err != nil {
+		return err
+	}
+
+	if namespace == nil {
+		namespaceID, err := s.createNamespace(spec, vpcID)
+		if err != nil {
+			return err
+		}
+
+		out, err := s.ServiceDiscoveryClient.GetNamespace(&servicediscovery.GetNamespaceInput{Id: aws.String(namespaceID)})
+		if err != nil {
+			return errors.Wrapf(err, "failed to describe service discovery namespace %q", namespaceID)
+		}
+		namespace = out.Namespace
+	}
+
+	s.scope.SetServiceDiscoveryStatus(&infrav1.ServiceDiscoveryStatus{
+		NamespaceID:  aws.StringValue(namespace.Id),
+		NamespaceARN: aws.StringValue(namespace.Arn),
+	})
+
+	return nil
+}
+
+// DeleteNamespace deletes the AWS Cloud Map namespace owned by the cluster.
+func (s *Service) DeleteNamespace() error {
+	var namespace *servicediscovery.Namespace
+	var err error
+
+	switch {
+	case s.scope.ServiceDiscovery() != nil:
+		namespace, err = s.findNamespace(s.scope.ServiceDiscovery().NamespaceName)
+	case s.scope.ServiceDiscoveryStatus() != nil && s.scope.ServiceDiscoveryStatus().NamespaceID != "":
+		namespace, err = s.getNamespace(s.scope.ServiceDiscoveryStatus().NamespaceID)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if namespace == nil {
+		s.scope.SetServiceDiscoveryStatus(nil)
+		return nil
+	}
+
+	s.scope.Info("Deleting service discovery namespace", "id", aws.StringValue(namespace.Id))
+
+	if _, err := s.ServiceDiscoveryClient.DeleteNamespace(&servicediscovery.DeleteNamespaceInput{
+		Id: namespace.Id,
+	}); err != nil && !isNotFound(err) {
+		return errors.Wrapf(err, "failed to delete service discovery namespace %q", aws.StringValue(namespace.Id))
+	}
+
+	s.scope.SetServiceDiscoveryStatus(nil)
+
+	return nil
+}
+
+// findNamespace returns the namespace owned by the cluster, first by the ID recorded in
+// status and then by looking up private DNS namespaces with the given name.
+func (s *Service) findNamespace(name string) (*servicediscovery.Namespace, error) {
+	if status := s.scope.ServiceDiscoveryStatus(); status != nil && status.NamespaceID != "" {
+		namespace, err := s.getNamespace(status.NamespaceID)
+		if err != nil {
+			return nil, err
+		}
+		if namespace != nil {
+			return namespace, nil
+		}
+	}
+
+	input := &servicediscovery.ListNamespacesInput{
+		Filters: []*servicediscovery.NamespaceFilter{
+			{
+				Name:      aws.String(servicediscovery.NamespaceFilterNameType),
+				Condition: aws.String(servicediscovery.FilterConditionEq),
+				Values:    aws.StringSlice([]string{servicediscovery.NamespaceTypeDnsPrivate}),
+			},
+		},
+	}
+
+	for {
+		out, err := s.ServiceDiscoveryClient.ListNamespaces(input)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list service discovery namespaces")
+		}
+
+		for _, summary := range out.Namespaces {
+			if aws.StringValue(summary.Name) != name {
+				continue
+			}
+
+			owned, err := s.isOwned(summary.Arn)
+			if err != nil {
+				return nil, err
+			}
+			if owned {
+				return s.getNamespace(aws.StringValue(summary.Id))
+			}
+		}
+
+		if aws.StringValue(out.NextToken) == "" {
+			return nil, nil
+		}
+		input.NextToken = out.NextToken
+	}
+}
+
+func (s *Service) getNamespace(id string) (*servicediscovery.Namespace, error) {
+	out, err := s.ServiceDiscoveryClient.GetNamespace(&servicediscovery.GetNamespaceInput{Id: aws.String(id)})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to describe service discovery namespace %q", id)
+	}
+
+	return out.Namespace, nil
+}
+
+func (s *Service) isOwned(arn *string) (bool, error) {
+	out, err := s.ServiceDiscoveryClient.ListTagsForResource(&servicediscovery.ListTagsForResourceInput{
+		ResourceARN: arn,
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to list tags for service discovery namespace %q", aws.StringValue(arn))
+	}
+
+	tags := make(infrav1.Tags, len(out.Tags))
+	for _, tag := range out.Tags {
+		tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+
+	return tags.HasOwned(s.scope.Name()), nil
+}
+
+// createNamespace requests creation of the namespace and returns its ID once the operation has succeeded.
+// Namespace creation is asynchronous, so ErrNamespaceNotReady is returned while the operation is still in progress. The
+// creator request ID is stable for the cluster, which makes repeated create calls return the same operation.
+func (s *Service) createNamespace(spec *infrav1.ServiceDiscovery, vpcID string) (string, error) {
+	tags := infrav1.Build(infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(spec.NamespaceName),
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	})
+
+	input := &servicediscovery.CreatePrivateDnsNamespaceInput{
+		CreatorRequestId: aws.String(s.creatorRequestID(spec.NamespaceName)),
+		Name:             aws.String(spec.NamespaceName),
+		Vpc:              aws.String(vpcID),
+		Tags:             make([]*servicediscovery.Tag, 0, len(tags)),
+	}
+	if spec.Description != "" {
+		input.Description = aws.String(spec.Description)
+	}
+	for k, v := range tags {
+		input.Tags = append(input.Tags, &servicediscovery.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+
+	out, err := s.ServiceDiscoveryClient.CreatePrivateDnsNamespace(input)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to create service discovery namespace %q", spec.NamespaceName)
+	}
+
+	op, err := s.ServiceDiscoveryClient.GetOperation(&servicediscovery.GetOperationInput{OperationId: out.OperationId})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get status of service discovery operation %q", aws.StringValue(out.OperationId))
+	}
+
+	switch aws.StringValue(op.Operation.Status) {
+	case servicediscovery.OperationStatusSuccess:
+		s.scope.Info("Created service discovery namespace", "name", spec.NamespaceName)
+		return aws.StringValue(op.Operation.Targets[servicediscovery.OperationTargetTypeNamespace]), nil
+	case servicediscovery.OperationStatusFail:
+		return "", errors.Errorf("failed to create service discovery namespace %q: %s: %s", spec.NamespaceName,
+			aws.StringValue(op.Operation.ErrorCode), aws.StringValue(op.Operation.ErrorMessage))
+	default:
+		s.scope.V(2).Info("Service discovery namespace is still being created", "name", spec.NamespaceName)
+		return "", ErrNamespaceNotReady
+	}
+}
+
+func (s *Service) creatorRequestID(name string) string {
+	id := fmt.Sprintf("%s-%s", s.scope.Name(), name)
+	if len(id) <= maxCreatorRequestIDLength {
+		return id
+	}
+
+	hashed, err := hash.Base36TruncatedHash(id, maxCreatorRequestIDLength)
+	if err != nil {
+		return id[:maxCreatorRequestIDLength]
+	}
+	return hashed
+}
+
+func isNotFound(err error) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		return aerr.Code() == servicediscovery.ErrCodeNamespaceNotFound
+	}
+	return false
+}
@@ -0,0 +1,320 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicediscovery
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/servicediscovery/mock_servicediscoveryiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const (
+	testNamespaceID  = "ns-1234"
+	testNamespaceARN = "arn:aws:servicediscovery:us-east-1:123456789012:namespace/ns-1234"
+)
+
+func TestReconcileNamespace(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ownedTags := []*servicediscovery.Tag{
+		{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"), Value: aws.String("owned")},
+	}
+	listPrivateNamespaces := &servicediscovery.ListNamespacesInput{
+		Filters: []*servicediscovery.NamespaceFilter{
+			{
+				Name:      aws.String("TYPE"),
+				Condition: aws.String("EQ"),
+				Values:    aws.StringSlice([]string{"DNS_PRIVATE"}),
+			},
+		},
+	}
+	namespace := &servicediscovery.Namespace{
+		Id:   aws.String(testNamespaceID),
+		Arn:  aws.String(testNamespaceARN),
+		Name: aws.String("cluster.local"),
+	}
+
+	testCases := []struct {
+		name           string
+		spec           *infrav1.ServiceDiscovery
+		status         *infrav1.ServiceDiscoveryStatus
+		expect         func(m *mock_servicediscoveryiface.MockServiceDiscoveryAPIMockRecorder)
+		expectErr      bool
+		expectedErr    error
+		expectedStatus *infrav1.ServiceDiscoveryStatus
+	}{
+		{
+			name:   "does nothing when service discovery is not configured",
+			expect: func(m *mock_servicediscoveryiface.MockServiceDiscoveryAPIMockRecorder) {},
+		},
+		{
+			name:   "deletes namespace recorded in status after service discovery was removed from spec",
+			status: &infrav1.ServiceDiscoveryStatus{NamespaceID: testNamespaceID, NamespaceARN: testNamespaceARN},
+			expect: func(m *mock_servicediscoveryiface.MockServiceDiscoveryAPIMockRecorder) {
+				m.GetNamespace(gomock.Eq(&servicediscovery.GetNamespaceInput{Id: aws.String(testNamespaceID)})).
+					Return(&servicediscovery.GetNamespaceOutput{Namespace: namespace}, nil)
+				m.DeleteNamespace(gomock.Eq(&servicediscovery.DeleteNamespaceInput{Id: aws.String(testNamespaceID)})).
+					Return(&servicediscovery.DeleteNamespaceOutput{OperationId: aws.String("op-2")}, nil)
+			},
+		},
+		{
+			name: "creates namespace when it does not exist",
+			spec: &infrav1.ServiceDiscovery{NamespaceName: "cluster.local", Description: "test"},
+			expect: func(m *mock_servicediscoveryiface.MockServiceDiscoveryAPIMockRecorder) {
+				m.ListNamespaces(gomock.Eq(listPrivateNamespaces)).Return(&servicediscovery.ListNamespacesOutput{}, nil)
+				m.CreatePrivateDnsNamespace(gomock.AssignableToTypeOf(&servicediscovery.CreatePrivateDnsNamespaceInput{})).
+					DoAndReturn(func(input *servicediscovery.CreatePrivateDnsNamespaceInput) (*servicediscovery.CreatePrivateDnsNamespaceOutput, error) {
+						g := NewWithT(t)
+						g.Expect(input.Name).To(Equal(aws.String("cluster.local")))
+						g.Expect(input.Vpc).To(Equal(aws.String("vpc-1")))
+						g.Expect(input.Description).To(Equal(aws.String("test")))
+						g.Expect(input.CreatorRequestId).To(Equal(aws.String("test-cluster-cluster.local")))
+						g.Expect(input.Tags).To(ContainElement(ownedTags[0]))
+						return &servicediscovery.CreatePrivateDnsNamespaceOutput{OperationId: aws.String("op-1")}, nil
+					})
+				m.GetOperation(gomock.Eq(&servicediscovery.GetOperationInput{OperationId: aws.String("op-1")})).
+					Return(&servicediscovery.GetOperationOutput{Operation: &servicediscovery.Operation{
+						Status:  aws.String(servicediscovery.OperationStatusSuccess),
+						Targets: aws.StringMap(map[string]string{"NAMESPACE": testNamespaceID}),
+					}}, nil)
+				m.GetNamespace(gomock.Eq(&servicediscovery.GetNamespaceInput{Id: aws.String(testNamespaceID)})).
+					Return(&servicediscovery.GetNamespaceOutput{Namespace: namespace}, nil)
+			},
+			expectedStatus: &infrav1.ServiceDiscoveryStatus{NamespaceID: testNamespaceID, NamespaceARN: testNamespaceARN},
+		},
+		{
+			name: "returns not ready while namespace creation is pending",
+			spec: &infrav1.ServiceDiscovery{NamespaceName: "cluster.local"},
+			expect: func(m *mock_servicediscoveryiface.MockServiceDiscoveryAPIMockRecorder) {
+				m.ListNamespaces(gomock.Any()).Return(&servicediscovery.ListNamespacesOutput{}, nil)
+				m.CreatePrivateDnsNamespace(gomock.Any()).
+					Return(&servicediscovery.CreatePrivateDnsNamespaceOutput{OperationId: aws.String("op-1")}, nil)
+				m.GetOperation(gomock.Any()).
+					Return(&servicediscovery.GetOperationOutput{Operation: &servicediscovery.Operation{
+						Status: aws.String(servicediscovery.OperationStatusPending),
+					}}, nil)
+			},
+			expectErr:   true,
+			expectedErr: ErrNamespaceNotReady,
+		},
+		{
+			name: "returns error when namespace creation failed",
+			spec: &infrav1.ServiceDiscovery{NamespaceName: "cluster.local"},
+			expect: func(m *mock_servicediscoveryiface.MockServiceDiscoveryAPIMockRecorder) {
+				m.ListNamespaces(gomock.Any()).Return(&servicediscovery.ListNamespacesOutput{}, nil)
+				m.CreatePrivateDnsNamespace(gomock.Any()).
+					Return(&servicediscovery.CreatePrivateDnsNamespaceOutput{OperationId: aws.String("op-1")}, nil)
+				m.GetOperation(gomock.Any()).
+					Return(&servicediscovery.GetOperationOutput{Operation: &servicediscovery.Operation{
+						Status:       aws.String(servicediscovery.OperationStatusFail),
+						ErrorCode:    aws.String("CONFLICTING_DOMAIN_EXISTS"),
+						ErrorMessage: aws.String("conflict"),
+					}}, nil)
+			},
+			expectErr: true,
+		},
+		{
+			name: "adopts existing namespace owned by the cluster",
+			spec: &infrav1.ServiceDiscovery{NamespaceName: "cluster.local"},
+			expect: func(m *mock_servicediscoveryiface.MockServiceDiscoveryAPIMockRecorder) {
+				m.ListNamespaces(gomock.Eq(listPrivateNamespaces)).Return(&servicediscovery.ListNamespacesOutput{
+					Namespaces: []*servicediscovery.NamespaceSummary{
+						{Id: aws.String("ns-other"), Arn: aws.String("arn-other"), Name: aws.String("cluster.local")},
+						{Id: aws.String(testNamespaceID), Arn: aws.String(testNamespaceARN), Name: aws.String("cluster.local")},
+					},
+				}, nil)
+				m.ListTagsForResource(gomock.Eq(&servicediscovery.ListTagsForResourceInput{ResourceARN: aws.String("arn-other")})).
+					Return(&servicediscovery.ListTagsForResourceOutput{}, nil)
+				m.ListTagsForResource(gomock.Eq(&servicediscovery.ListTagsForResourceInput{ResourceARN: aws.String(testNamespaceARN)})).
+					Return(&servicediscovery.ListTagsForResourceOutput{Tags: ownedTags}, nil)
+				m.GetNamespace(gomock.Eq(&servicediscovery.GetNamespaceInput{Id: aws.String(testNamespaceID)})).
+					Return(&servicediscovery.GetNamespaceOutput{Namespace: namespace}, nil)
+			},
+			expectedStatus: &infrav1.ServiceDiscoveryStatus{NamespaceID: testNamespaceID, NamespaceARN: testNamespaceARN},
+		},
+		{
+			name:   "uses namespace ID recorded in status",
+			spec:   &infrav1.ServiceDiscovery{NamespaceName: "cluster.local"},
+			status: &infrav1.ServiceDiscoveryStatus{NamespaceID: testNamespaceID},
+			expect: func(m *mock_servicediscoveryiface.MockServiceDiscoveryAPIMockRecorder) {
+				m.GetNamespace(gomock.Eq(&servicediscovery.GetNamespaceInput{Id: aws.String(testNamespaceID)})).
+					Return(&servicediscovery.GetNamespaceOutput{Namespace: namespace}, nil)
+			},
+			expectedStatus: &infrav1.ServiceDiscoveryStatus{NamespaceID: testNamespaceID, NamespaceARN: testNamespaceARN},
+		},
+		{
+			name: "returns error when listing namespaces fails",
+			spec: &infrav1.ServiceDiscovery{NamespaceName: "cluster.local"},
+			expect: func(m *mock_servicediscoveryiface.MockServiceDiscoveryAPIMockRecorder) {
+				m.ListNamespaces(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			sdMock := mock_servicediscoveryiface.NewMockServiceDiscoveryAPI(mockCtrl)
+			clusterScope, err := setupCluster("test-cluster", tc.spec, tc.status)
+			g.Expect(err).To(Not(HaveOccurred()))
+
+			tc.expect(sdMock.EXPECT())
+			s := NewService(clusterScope)
+			s.ServiceDiscoveryClient = sdMock
+
+			err = s.ReconcileNamespace()
+			if tc.expectErr {
+				g.Expect(err).NotTo(BeNil())
+				if tc.expectedErr != nil {
+					g.Expect(errors.Is(err, tc.expectedErr)).To(BeTrue())
+				}
+				return
+			}
+			g.Expect(err).To(BeNil())
+			g.Expect(clusterScope.ServiceDiscoveryStatus()).To(Equal(tc.expectedStatus))
+		})
+	}
+}
+
+func TestDeleteNamespace(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	namespace := &servicediscovery.Namespace{
+		Id:   aws.String(testNamespaceID),
+		Arn:  aws.String(testNamespaceARN),
+		Name: aws.String("cluster.local"),
+	}
+
+	testCases := []struct {
+		name      string
+		spec      *infrav1.ServiceDiscovery
+		status    *infrav1.ServiceDiscoveryStatus
+		expect    func(m *mock_servicediscoveryiface.MockServiceDiscoveryAPIMockRecorder)
+		expectErr bool
+	}{
+		{
+			name:   "does nothing when service discovery is not configured",
+			expect: func(m *mock_servicediscoveryiface.MockServiceDiscoveryAPIMockRecorder) {},
+		},
+		{
+			name:   "deletes namespace recorded in status",
+			spec:   &infrav1.ServiceDiscovery{NamespaceName: "cluster.local"},
+			status: &infrav1.ServiceDiscoveryStatus{NamespaceID: testNamespaceID},
+			expect: func(m *mock_servicediscoveryiface.MockServiceDiscoveryAPIMockRecorder) {
+				m.GetNamespace(gomock.Eq(&servicediscovery.GetNamespaceInput{Id: aws.String(testNamespaceID)})).
+					Return(&servicediscovery.GetNamespaceOutput{Namespace: namespace}, nil)
+				m.DeleteNamespace(gomock.Eq(&servicediscovery.DeleteNamespaceInput{Id: aws.String(testNamespaceID)})).
+					Return(&servicediscovery.DeleteNamespaceOutput{OperationId: aws.String("op-2")}, nil)
+			},
+		},
+		{
+			name:   "deletes namespace recorded in status after it was removed from spec",
+			status: &infrav1.ServiceDiscoveryStatus{NamespaceID: testNamespaceID},
+			expect: func(m *mock_servicediscoveryiface.MockServiceDiscoveryAPIMockRecorder) {
+				m.GetNamespace(gomock.Any()).Return(&servicediscovery.GetNamespaceOutput{Namespace: namespace}, nil)
+				m.DeleteNamespace(gomock.Eq(&servicediscovery.DeleteNamespaceInput{Id: aws.String(testNamespaceID)})).
+					Return(&servicediscovery.DeleteNamespaceOutput{OperationId: aws.String("op-2")}, nil)
+			},
+		},
+		{
+			name: "does not error when namespace is already gone",
+			spec: &infrav1.ServiceDiscovery{NamespaceName: "cluster.local"},
+			expect: func(m *mock_servicediscoveryiface.MockServiceDiscoveryAPIMockRecorder) {
+				m.ListNamespaces(gomock.Any()).Return(&servicediscovery.ListNamespacesOutput{}, nil)
+			},
+		},
+		{
+			name:   "does not error when namespace disappears during delete",
+			status: &infrav1.ServiceDiscoveryStatus{NamespaceID: testNamespaceID},
+			expect: func(m *mock_servicediscoveryiface.MockServiceDiscoveryAPIMockRecorder) {
+				m.GetNamespace(gomock.Any()).Return(&servicediscovery.GetNamespaceOutput{Namespace: namespace}, nil)
+				m.DeleteNamespace(gomock.Any()).Return(nil, awserr.New(servicediscovery.ErrCodeNamespaceNotFound, "", nil))
+			},
+		},
+		{
+			name:   "returns error when namespace still has services",
+			status: &infrav1.ServiceDiscoveryStatus{NamespaceID: testNamespaceID},
+			expect: func(m *mock_servicediscoveryiface.MockServiceDiscoveryAPIMockRecorder) {
+				m.GetNamespace(gomock.Any()).Return(&servicediscovery.GetNamespaceOutput{Namespace: namespace}, nil)
+				m.DeleteNamespace(gomock.Any()).Return(nil, awserr.New(servicediscovery.ErrCodeResourceInUse, "", nil))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			sdMock := mock_servicediscoveryiface.NewMockServiceDiscoveryAPI(mockCtrl)
+			clusterScope, err := setupCluster("test-cluster", tc.spec, tc.status)
+			g.Expect(err).To(Not(HaveOccurred()))
+
+			tc.expect(sdMock.EXPECT())
+			s := NewService(clusterScope)
+			s.ServiceDiscoveryClient = sdMock
+
+			err = s.DeleteNamespace()
+			if tc.expectErr {
+				g.Expect(err).NotTo(BeNil())
+				return
+			}
+			g.Expect(err).To(BeNil())
+			g.Expect(clusterScope.ServiceDiscoveryStatus()).To(BeNil())
+		})
+	}
+}
+
+func setupCluster(clusterName string, spec *infrav1.ServiceDiscovery, status *infrav1.ServiceDiscoveryStatus) (*scope.ClusterScope, error) {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: infrav1.AWSClusterSpec{
+			NetworkSpec: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{ID: "vpc-1"},
+			},
+			ServiceDiscovery: spec,
+		},
+		Status: infrav1.AWSClusterStatus{
+			ServiceDiscovery: status,
+		},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(awsCluster).Build()
+	return scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: clusterName},
+		},
+		AWSCluster: awsCluster,
+		Client:     client,
+	})
+}
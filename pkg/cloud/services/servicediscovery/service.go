@@ -0,0 +1,39 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicediscovery
+
+import (
+	"github.com/aws/aws-sdk-go/service/servicediscovery/servicediscoveryiface"
+
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+)
+
+// Service holds a collection of interfaces.
+// The interfaces are broken down like this to group functions together.
+// One alternative is to have a large list of functions from the ec2 client.
+type Service struct {
+	scope                  scope.ServiceDiscoveryScope
+	ServiceDiscoveryClient servicediscoveryiface.ServiceDiscoveryAPI
+}
+
+// NewService returns a new service given the api clients.
+func NewService(serviceDiscoveryScope scope.ServiceDiscoveryScope) *Service {
+	return &Service{
+		scope:                  serviceDiscoveryScope,
+		ServiceDiscoveryClient: scope.NewServiceDiscoveryClient(serviceDiscoveryScope, serviceDiscoveryScope, serviceDiscoveryScope, serviceDiscoveryScope.InfraCluster()),
+	}
+}
@@ -0,0 +1,356 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicequotas
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// InsufficientQuotaRequeueAfter is how long to wait before checking again a service quota without enough headroom.
+const InsufficientQuotaRequeueAfter = 5 * time.Minute
+
+// quotaCode identifies a quota in the Service Quotas API.
+type quotaCode struct {
+	serviceCode string
+	quotaCode   string
+}
+
+// quotaCodes are the Service Quotas codes of the checked quotas.
+var quotaCodes = map[infrav1.ServiceQuota]quotaCode{
+	infrav1.ServiceQuotaVCPU:             {serviceCode: "ec2", quotaCode: "L-1216C47A"},
+	infrav1.ServiceQuotaElasticIP:        {serviceCode: "ec2", quotaCode: "L-0263D0A3"},
+	infrav1.ServiceQuotaNATGateway:       {serviceCode: "vpc", quotaCode: "L-FE5A380F"},
+	infrav1.ServiceQuotaNetworkInterface: {serviceCode: "vpc", quotaCode: "L-DF5E4CA3"},
+}
+
+// nonStandardInstanceFamilies are the instance families starting like the standard ones which have their own
+// vCPU quota.
+var nonStandardInstanceFamilies = map[string]bool{
+	"dl":  true,
+	"hpc": true,
+	"inf": true,
+	"mac": true,
+	"trn": true,
+}
+
+// Request describes the resources about to be created.
+type Request struct {
+	// InstanceType is the type of the on-demand instances to create.
+	InstanceType string
+	// Instances is the number of on-demand instances to create.
+	Instances int64
+	// ElasticIPs is the number of Elastic IP addresses to allocate.
+	ElasticIPs int64
+	// NATGateways is the number of NAT gateways to create per availability zone.
+	NATGateways map[string]int64
+	// NetworkInterfaces is the number of network interfaces to create.
+	NetworkInterfaces int64
+}
+
+// InsufficientQuotaError is returned when a service quota doesn't have enough headroom for a request.
+type InsufficientQuotaError struct {
+	Quota infrav1.ServiceQuota
+	// Name and Code are the name and the code of the quota in the Service Quotas API.
+	Name string
+	Code string
+	// AvailabilityZone is set for the quotas applying per availability zone.
+	AvailabilityZone string
+	Value            int64
+	Usage            int64
+	Required         int64
+}
+
+// IsInsufficientQuota returns true if the error is caused by a service quota without enough headroom.
+func IsInsufficientQuota(err error) bool {
+	var quotaErr *InsufficientQuotaError
+	return errors.As(err, &quotaErr)
+}
+
+func (e *InsufficientQuotaError) Error() string {
+	var zone string
+	if e.AvailabilityZone != "" {
+		zone = fmt.Sprintf(" in availability zone %s", e.AvailabilityZone)
+	}
+	return fmt.Sprintf("insufficient headroom for service quota %q (%s)%s: %d required, %d of %d in use",
+		e.Name, e.Code, zone, e.Required, e.Usage, e.Value)
+}
+
+// quota is the applied value of a service quota.
+type quota struct {
+	name  string
+	code  string
+	value int64
+}
+
+// evaluateHeadroom returns an InsufficientQuotaError when the required amount doesn't fit in the headroom left by the usage.
+func evaluateHeadroom(kind infrav1.ServiceQuota, q *quota, zone string, usage, required int64) error {
+	if required <= 0 || usage+required <= q.value {
+		return nil
+	}
+	return &InsufficientQuotaError{
+		Quota:            kind,
+		Name:             q.name,
+		Code:             q.code,
+		AvailabilityZone: zone,
+		Value:            q.value,
+		Usage:            usage,
+		Required:         required,
+	}
+}
+
+// ReconcileHeadroom checks the headroom of the service quotas for the request and reports the result
+// in the ServiceQuotaHeadroom condition of the given object.
+func (s *Service) ReconcileHeadroom(obj conditions.Setter, req Request) error {
+	err := s.CheckHeadroom(req)
+
+	var quotaErr *InsufficientQuotaError
+	switch {
+	case err == nil:
+		conditions.MarkTrue(obj, infrav1.ServiceQuotaHeadroomCondition)
+	case errors.As(err, &quotaErr):
+		record.Warnf(obj, "InsufficientServiceQuota", "Not creating resources: %v", err)
+		conditions.MarkFalse(obj, infrav1.ServiceQuotaHeadroomCondition, infrav1.InsufficientServiceQuotaReason, clusterv1.ConditionSeverityError, err.Error())
+	default:
+		conditions.MarkFalse(obj, infrav1.ServiceQuotaHeadroomCondition, infrav1.ServiceQuotaCheckFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+	}
+
+	return err
+}
+
+// CheckHeadroom checks that the service quotas enabled for the cluster have enough headroom for the request.
+// It returns an InsufficientQuotaError for the first quota which doesn't.
+func (s *Service) CheckHeadroom(req Request) error {
+	checks := s.scope.ServiceQuotaChecks()
+
+	if checks.Checks(infrav1.ServiceQuotaVCPU) && req.Instances > 0 && isStandardInstanceType(req.InstanceType) {
+		if err := s.checkVCPUHeadroom(req.InstanceType, req.Instances); err != nil {
+			return err
+		}
+	}
+
+	if checks.Checks(infrav1.ServiceQuotaElasticIP) && req.ElasticIPs > 0 {
+		if err := s.checkElasticIPHeadroom(req.ElasticIPs); err != nil {
+			return err
+		}
+	}
+
+	if checks.Checks(infrav1.ServiceQuotaNATGateway) && len(req.NATGateways) > 0 {
+		if err := s.checkNATGatewayHeadroom(req.NATGateways); err != nil {
+			return err
+		}
+	}
+
+	if checks.Checks(infrav1.ServiceQuotaNetworkInterface) && req.NetworkInterfaces > 0 {
+		if err := s.checkNetworkInterfaceHeadroom(req.NetworkInterfaces); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) checkVCPUHeadroom(instanceType string, instances int64) error {
+	q, err := s.getQuota(infrav1.ServiceQuotaVCPU)
+	if err != nil {
+		return err
+	}
+
+	out, err := s.EC2Client.DescribeInstanceTypes(&ec2.DescribeInstanceTypesInput{
+		InstanceTypes: aws.StringSlice([]string{instanceType}),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe instance type %q", instanceType)
+	}
+	if len(out.InstanceTypes) == 0 || out.InstanceTypes[0].VCpuInfo == nil {
+		return errors.Errorf("no vCPU information for instance type %q", instanceType)
+	}
+	required := instances * aws.Int64Value(out.InstanceTypes[0].VCpuInfo.DefaultVCpus)
+
+	var usage int64
+	if err := s.EC2Client.DescribeInstancesPages(&ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{filter.EC2.InstanceStates(ec2.InstanceStateNamePending, ec2.InstanceStateNameRunning)},
+	}, func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
+		for _, reservation := range page.Reservations {
+			for _, instance := range reservation.Instances {
+				// Spot and scheduled instances have their own quotas.
+				if instance.InstanceLifecycle != nil || !isStandardInstanceType(aws.StringValue(instance.InstanceType)) || instance.CpuOptions == nil {
+					continue
+				}
+				usage += aws.Int64Value(instance.CpuOptions.CoreCount) * aws.Int64Value(instance.CpuOptions.ThreadsPerCore)
+			}
+		}
+		return true
+	}); err != nil {
+		return errors.Wrap(err, "failed to describe instances")
+	}
+
+	return evaluateHeadroom(infrav1.ServiceQuotaVCPU, q, "", usage, required)
+}
+
+func (s *Service) checkElasticIPHeadroom(required int64) error {
+	q, err := s.getQuota(infrav1.ServiceQuotaElasticIP)
+	if err != nil {
+		return err
+	}
+
+	out, err := s.EC2Client.DescribeAddresses(&ec2.DescribeAddressesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("domain"),
+				Values: aws.StringSlice([]string{ec2.DomainTypeVpc}),
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to describe addresses")
+	}
+
+	return evaluateHeadroom(infrav1.ServiceQuotaElasticIP, q, "", int64(len(out.Addresses)), required)
+}
+
+func (s *Service) checkNATGatewayHeadroom(required map[string]int64) error {
+	q, err := s.getQuota(infrav1.ServiceQuotaNATGateway)
+	if err != nil {
+		return err
+	}
+
+	subnetIDs := []string{}
+	if err := s.EC2Client.DescribeNatGatewaysPages(&ec2.DescribeNatGatewaysInput{
+		Filter: []*ec2.Filter{filter.EC2.NATGatewayStates(ec2.NatGatewayStatePending, ec2.NatGatewayStateAvailable)},
+	}, func(page *ec2.DescribeNatGatewaysOutput, lastPage bool) bool {
+		for _, ngw := range page.NatGateways {
+			subnetIDs = append(subnetIDs, aws.StringValue(ngw.SubnetId))
+		}
+		return true
+	}); err != nil {
+		return errors.Wrap(err, "failed to describe NAT gateways")
+	}
+
+	usage := map[string]int64{}
+	if len(subnetIDs) > 0 {
+		subnetZones := map[string]string{}
+		out, err := s.EC2Client.DescribeSubnets(&ec2.DescribeSubnetsInput{
+			SubnetIds: aws.StringSlice(subnetIDs),
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to describe the subnets of the NAT gateways")
+		}
+		for _, subnet := range out.Subnets {
+			subnetZones[aws.StringValue(subnet.SubnetId)] = aws.StringValue(subnet.AvailabilityZone)
+		}
+		for _, subnetID := range subnetIDs {
+			usage[subnetZones[subnetID]]++
+		}
+	}
+
+	zones := make([]string, 0, len(required))
+	for zone := range required {
+		zones = append(zones, zone)
+	}
+	sort.Strings(zones)
+
+	for _, zone := range zones {
+		if err := evaluateHeadroom(infrav1.ServiceQuotaNATGateway, q, zone, usage[zone], required[zone]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) checkNetworkInterfaceHeadroom(required int64) error {
+	q, err := s.getQuota(infrav1.ServiceQuotaNetworkInterface)
+	if err != nil {
+		return err
+	}
+
+	var usage int64
+	if err := s.EC2Client.DescribeNetworkInterfacesPages(&ec2.DescribeNetworkInterfacesInput{},
+		func(page *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool {
+			usage += int64(len(page.NetworkInterfaces))
+			return true
+		}); err != nil {
+		return errors.Wrap(err, "failed to describe network interfaces")
+	}
+
+	return evaluateHeadroom(infrav1.ServiceQuotaNetworkInterface, q, "", usage, required)
+}
+
+// getQuota returns the value of the quota applied to the account, or its default value if it was never increased.
+func (s *Service) getQuota(kind infrav1.ServiceQuota) (*quota, error) {
+	code := quotaCodes[kind]
+
+	out, err := s.ServiceQuotasClient.GetServiceQuota(&servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String(code.serviceCode),
+		QuotaCode:   aws.String(code.quotaCode),
+	})
+	if err == nil {
+		return newQuota(out.Quota), nil
+	}
+	if c, _ := awserrors.Code(err); c != servicequotas.ErrCodeNoSuchResourceException {
+		return nil, errors.Wrapf(err, "failed to get service quota %s", code.quotaCode)
+	}
+
+	defaultOut, err := s.ServiceQuotasClient.GetAWSDefaultServiceQuota(&servicequotas.GetAWSDefaultServiceQuotaInput{
+		ServiceCode: aws.String(code.serviceCode),
+		QuotaCode:   aws.String(code.quotaCode),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get default service quota %s", code.quotaCode)
+	}
+	return newQuota(defaultOut.Quota), nil
+}
+
+func newQuota(q *servicequotas.ServiceQuota) *quota {
+	if q == nil {
+		return &quota{}
+	}
+	return &quota{
+		name:  aws.StringValue(q.QuotaName),
+		code:  aws.StringValue(q.QuotaCode),
+		value: int64(aws.Float64Value(q.Value)),
+	}
+}
+
+// isStandardInstanceType returns true if the instance type counts against the quota of the
+// standard (A, C, D, H, I, M, R, T, Z) instances.
+func isStandardInstanceType(instanceType string) bool {
+	family := instanceType
+	if i := strings.IndexFunc(instanceType, func(r rune) bool { return !unicode.IsLetter(r) }); i >= 0 {
+		family = instanceType[:i]
+	}
+	if family == "" || nonStandardInstanceFamilies[family] {
+		return false
+	}
+	return strings.ContainsRune("acdhimrtz", rune(family[0]))
+}
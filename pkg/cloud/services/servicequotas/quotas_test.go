@@ -0,0 +1,450 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicequotas
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/ec2/mock_ec2iface"
+	"sigs.k8s.io/cluster-api-provider-aws/pkg/cloud/services/servicequotas/mock_servicequotasiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestEvaluateHeadroom(t *testing.T) {
+	q := &quota{name: "EC2-VPC Elastic IPs", code: "L-0263D0A3", value: 5}
+
+	tests := []struct {
+		name     string
+		zone     string
+		usage    int64
+		required int64
+		expected error
+	}{
+		{
+			name:     "enough headroom",
+			usage:    3,
+			required: 2,
+		},
+		{
+			name:     "nothing required while over the quota",
+			usage:    6,
+			required: 0,
+		},
+		{
+			name:     "not enough headroom",
+			usage:    4,
+			required: 2,
+			expected: &InsufficientQuotaError{
+				Quota:    infrav1.ServiceQuotaElasticIP,
+				Name:     "EC2-VPC Elastic IPs",
+				Code:     "L-0263D0A3",
+				Value:    5,
+				Usage:    4,
+				Required: 2,
+			},
+		},
+		{
+			name:     "not enough headroom in availability zone",
+			zone:     "us-east-1a",
+			usage:    5,
+			required: 1,
+			expected: &InsufficientQuotaError{
+				Quota:            infrav1.ServiceQuotaElasticIP,
+				Name:             "EC2-VPC Elastic IPs",
+				Code:             "L-0263D0A3",
+				AvailabilityZone: "us-east-1a",
+				Value:            5,
+				Usage:            5,
+				Required:         1,
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			err := evaluateHeadroom(infrav1.ServiceQuotaElasticIP, q, tc.zone, tc.usage, tc.required)
+			if tc.expected == nil {
+				g.Expect(err).To(BeNil())
+				return
+			}
+			g.Expect(err).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestIsStandardInstanceType(t *testing.T) {
+	tests := map[string]bool{
+		"m5.large":       true,
+		"t3a.medium":     true,
+		"c6gn.xlarge":    true,
+		"im4gn.large":    true,
+		"z1d.large":      true,
+		"g4dn.xlarge":    false,
+		"p4d.24xlarge":   false,
+		"x2iedn.metal":   false,
+		"inf1.xlarge":    false,
+		"dl1.24xlarge":   false,
+		"mac1.metal":     false,
+		"hpc6a.48xlarge": false,
+		"":               false,
+	}
+	for instanceType, expected := range tests {
+		t.Run(instanceType, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(isStandardInstanceType(instanceType)).To(Equal(expected))
+		})
+	}
+}
+
+func TestIsInsufficientQuota(t *testing.T) {
+	quotaErr := &InsufficientQuotaError{Quota: infrav1.ServiceQuotaVCPU}
+	tests := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"insufficient quota":         {err: quotaErr, expected: true},
+		"wrapped insufficient quota": {err: errors.Wrap(quotaErr, "failed to check service quotas"), expected: true},
+		"fmt wrapped":                {err: fmt.Errorf("failed to reconcile network: %w", quotaErr), expected: true},
+		"other error":                {err: errors.New("access denied"), expected: false},
+		"nil":                        {err: nil, expected: false},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(IsInsufficientQuota(tc.err)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestCheckHeadroom(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	vcpuQuota := func(value float64) *servicequotas.GetServiceQuotaOutput {
+		return &servicequotas.GetServiceQuotaOutput{
+			Quota: &servicequotas.ServiceQuota{
+				QuotaName: aws.String("Running On-Demand Standard (A, C, D, H, I, M, R, T, Z) instances"),
+				QuotaCode: aws.String("L-1216C47A"),
+				Value:     aws.Float64(value),
+			},
+		}
+	}
+	expectInstances := func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+		m.DescribeInstanceTypes(gomock.Eq(&ec2.DescribeInstanceTypesInput{
+			InstanceTypes: aws.StringSlice([]string{"m5.xlarge"}),
+		})).Return(&ec2.DescribeInstanceTypesOutput{
+			InstanceTypes: []*ec2.InstanceTypeInfo{
+				{InstanceType: aws.String("m5.xlarge"), VCpuInfo: &ec2.VCpuInfo{DefaultVCpus: aws.Int64(4)}},
+			},
+		}, nil)
+		m.DescribeInstancesPages(gomock.Any(), gomock.Any()).DoAndReturn(func(_ *ec2.DescribeInstancesInput, fn func(*ec2.DescribeInstancesOutput, bool) bool) error {
+			fn(&ec2.DescribeInstancesOutput{
+				Reservations: []*ec2.Reservation{
+					{
+						Instances: []*ec2.Instance{
+							{
+								InstanceType: aws.String("m5.2xlarge"),
+								CpuOptions:   &ec2.CpuOptions{CoreCount: aws.Int64(4), ThreadsPerCore: aws.Int64(2)},
+							},
+							{
+								InstanceType:      aws.String("m5.2xlarge"),
+								InstanceLifecycle: aws.String(ec2.InstanceLifecycleTypeSpot),
+								CpuOptions:        &ec2.CpuOptions{CoreCount: aws.Int64(4), ThreadsPerCore: aws.Int64(2)},
+							},
+							{
+								InstanceType: aws.String("g4dn.xlarge"),
+								CpuOptions:   &ec2.CpuOptions{CoreCount: aws.Int64(2), ThreadsPerCore: aws.Int64(2)},
+							},
+						},
+					},
+				},
+			}, true)
+			return nil
+		})
+	}
+
+	tests := []struct {
+		name          string
+		checks        *infrav1.ServiceQuotaChecks
+		request       Request
+		expectEC2     func(m *mock_ec2iface.MockEC2APIMockRecorder)
+		expectQuotas  func(m *mock_servicequotasiface.MockServiceQuotasAPIMockRecorder)
+		expectedError *InsufficientQuotaError
+		expectErr     bool
+	}{
+		{
+			name:    "nothing checked when the checks are disabled",
+			checks:  nil,
+			request: Request{InstanceType: "m5.xlarge", Instances: 1, ElasticIPs: 1, NetworkInterfaces: 1},
+		},
+		{
+			name:    "only the configured quotas are checked",
+			checks:  &infrav1.ServiceQuotaChecks{Quotas: []infrav1.ServiceQuota{infrav1.ServiceQuotaElasticIP}},
+			request: Request{InstanceType: "m5.xlarge", Instances: 1, NetworkInterfaces: 1},
+		},
+		{
+			name:    "instances of other quotas than the standard one aren't checked",
+			checks:  &infrav1.ServiceQuotaChecks{},
+			request: Request{InstanceType: "g4dn.xlarge", Instances: 1},
+		},
+		{
+			name:    "vCPU headroom is enough for the instance",
+			checks:  &infrav1.ServiceQuotaChecks{},
+			request: Request{InstanceType: "m5.xlarge", Instances: 1},
+			expectQuotas: func(m *mock_servicequotasiface.MockServiceQuotasAPIMockRecorder) {
+				m.GetServiceQuota(gomock.Eq(&servicequotas.GetServiceQuotaInput{
+					ServiceCode: aws.String("ec2"),
+					QuotaCode:   aws.String("L-1216C47A"),
+				})).Return(vcpuQuota(12), nil)
+			},
+			expectEC2: expectInstances,
+		},
+		{
+			name:    "vCPU headroom is not enough for the instance",
+			checks:  &infrav1.ServiceQuotaChecks{},
+			request: Request{InstanceType: "m5.xlarge", Instances: 1},
+			expectQuotas: func(m *mock_servicequotasiface.MockServiceQuotasAPIMockRecorder) {
+				m.GetServiceQuota(gomock.Any()).Return(vcpuQuota(10), nil)
+			},
+			expectEC2: expectInstances,
+			expectedError: &InsufficientQuotaError{
+				Quota:    infrav1.ServiceQuotaVCPU,
+				Name:     "Running On-Demand Standard (A, C, D, H, I, M, R, T, Z) instances",
+				Code:     "L-1216C47A",
+				Value:    10,
+				Usage:    8,
+				Required: 4,
+			},
+		},
+		{
+			name:    "default quota is used when the quota was never increased",
+			checks:  &infrav1.ServiceQuotaChecks{},
+			request: Request{ElasticIPs: 1},
+			expectQuotas: func(m *mock_servicequotasiface.MockServiceQuotasAPIMockRecorder) {
+				m.GetServiceQuota(gomock.Any()).Return(nil, awserr.New(servicequotas.ErrCodeNoSuchResourceException, "not found", nil))
+				m.GetAWSDefaultServiceQuota(gomock.Eq(&servicequotas.GetAWSDefaultServiceQuotaInput{
+					ServiceCode: aws.String("ec2"),
+					QuotaCode:   aws.String("L-0263D0A3"),
+				})).Return(&servicequotas.GetAWSDefaultServiceQuotaOutput{
+					Quota: &servicequotas.ServiceQuota{
+						QuotaName: aws.String("EC2-VPC Elastic IPs"),
+						QuotaCode: aws.String("L-0263D0A3"),
+						Value:     aws.Float64(5),
+					},
+				}, nil)
+			},
+			expectEC2: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeAddresses(gomock.Any()).Return(&ec2.DescribeAddressesOutput{
+					Addresses: []*ec2.Address{{}, {}, {}, {}, {}},
+				}, nil)
+			},
+			expectedError: &InsufficientQuotaError{
+				Quota:    infrav1.ServiceQuotaElasticIP,
+				Name:     "EC2-VPC Elastic IPs",
+				Code:     "L-0263D0A3",
+				Value:    5,
+				Usage:    5,
+				Required: 1,
+			},
+		},
+		{
+			name:    "NAT gateway headroom is checked per availability zone",
+			checks:  &infrav1.ServiceQuotaChecks{Quotas: []infrav1.ServiceQuota{infrav1.ServiceQuotaNATGateway}},
+			request: Request{NATGateways: map[string]int64{"us-east-1a": 1, "us-east-1b": 1}},
+			expectQuotas: func(m *mock_servicequotasiface.MockServiceQuotasAPIMockRecorder) {
+				m.GetServiceQuota(gomock.Eq(&servicequotas.GetServiceQuotaInput{
+					ServiceCode: aws.String("vpc"),
+					QuotaCode:   aws.String("L-FE5A380F"),
+				})).Return(&servicequotas.GetServiceQuotaOutput{
+					Quota: &servicequotas.ServiceQuota{
+						QuotaName: aws.String("NAT gateways per Availability Zone"),
+						QuotaCode: aws.String("L-FE5A380F"),
+						Value:     aws.Float64(2),
+					},
+				}, nil)
+			},
+			expectEC2: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeNatGatewaysPages(gomock.Any(), gomock.Any()).DoAndReturn(func(_ *ec2.DescribeNatGatewaysInput, fn func(*ec2.DescribeNatGatewaysOutput, bool) bool) error {
+					fn(&ec2.DescribeNatGatewaysOutput{
+						NatGateways: []*ec2.NatGateway{
+							{SubnetId: aws.String("subnet-a1")},
+							{SubnetId: aws.String("subnet-b1")},
+							{SubnetId: aws.String("subnet-b2")},
+						},
+					}, true)
+					return nil
+				})
+				m.DescribeSubnets(gomock.Eq(&ec2.DescribeSubnetsInput{
+					SubnetIds: aws.StringSlice([]string{"subnet-a1", "subnet-b1", "subnet-b2"}),
+				})).Return(&ec2.DescribeSubnetsOutput{
+					Subnets: []*ec2.Subnet{
+						{SubnetId: aws.String("subnet-a1"), AvailabilityZone: aws.String("us-east-1a")},
+						{SubnetId: aws.String("subnet-b1"), AvailabilityZone: aws.String("us-east-1b")},
+						{SubnetId: aws.String("subnet-b2"), AvailabilityZone: aws.String("us-east-1b")},
+					},
+				}, nil)
+			},
+			expectedError: &InsufficientQuotaError{
+				Quota:            infrav1.ServiceQuotaNATGateway,
+				Name:             "NAT gateways per Availability Zone",
+				Code:             "L-FE5A380F",
+				AvailabilityZone: "us-east-1b",
+				Value:            2,
+				Usage:            2,
+				Required:         1,
+			},
+		},
+		{
+			name:    "network interface headroom is enough",
+			checks:  &infrav1.ServiceQuotaChecks{Quotas: []infrav1.ServiceQuota{infrav1.ServiceQuotaNetworkInterface}},
+			request: Request{NetworkInterfaces: 1},
+			expectQuotas: func(m *mock_servicequotasiface.MockServiceQuotasAPIMockRecorder) {
+				m.GetServiceQuota(gomock.Eq(&servicequotas.GetServiceQuotaInput{
+					ServiceCode: aws.String("vpc"),
+					QuotaCode:   aws.String("L-DF5E4CA3"),
+				})).Return(&servicequotas.GetServiceQuotaOutput{
+					Quota: &servicequotas.ServiceQuota{
+						QuotaName: aws.String("Network interfaces per Region"),
+						QuotaCode: aws.String("L-DF5E4CA3"),
+						Value:     aws.Float64(5000),
+					},
+				}, nil)
+			},
+			expectEC2: func(m *mock_ec2iface.MockEC2APIMockRecorder) {
+				m.DescribeNetworkInterfacesPages(gomock.Any(), gomock.Any()).DoAndReturn(func(_ *ec2.DescribeNetworkInterfacesInput, fn func(*ec2.DescribeNetworkInterfacesOutput, bool) bool) error {
+					fn(&ec2.DescribeNetworkInterfacesOutput{
+						NetworkInterfaces: []*ec2.NetworkInterface{{}, {}},
+					}, true)
+					return nil
+				})
+			},
+		},
+		{
+			name:    "failing to get a quota is returned",
+			checks:  &infrav1.ServiceQuotaChecks{},
+			request: Request{ElasticIPs: 1},
+			expectQuotas: func(m *mock_servicequotasiface.MockServiceQuotasAPIMockRecorder) {
+				m.GetServiceQuota(gomock.Any()).Return(nil, awserr.New("AccessDeniedException", "denied", nil))
+			},
+			expectErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			clusterScope, err := setupCluster("test-cluster", tc.checks)
+			g.Expect(err).To(BeNil())
+
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			quotasMock := mock_servicequotasiface.NewMockServiceQuotasAPI(mockCtrl)
+			if tc.expectEC2 != nil {
+				tc.expectEC2(ec2Mock.EXPECT())
+			}
+			if tc.expectQuotas != nil {
+				tc.expectQuotas(quotasMock.EXPECT())
+			}
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+			s.ServiceQuotasClient = quotasMock
+
+			err = s.CheckHeadroom(tc.request)
+			switch {
+			case tc.expectedError != nil:
+				g.Expect(err).To(Equal(tc.expectedError))
+			case tc.expectErr:
+				g.Expect(err).NotTo(BeNil())
+			default:
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestReconcileHeadroom(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g := NewWithT(t)
+
+	clusterScope, err := setupCluster("test-cluster", &infrav1.ServiceQuotaChecks{})
+	g.Expect(err).To(BeNil())
+
+	ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+	quotasMock := mock_servicequotasiface.NewMockServiceQuotasAPI(mockCtrl)
+	quotasMock.EXPECT().GetServiceQuota(gomock.Any()).Return(&servicequotas.GetServiceQuotaOutput{
+		Quota: &servicequotas.ServiceQuota{
+			QuotaName: aws.String("EC2-VPC Elastic IPs"),
+			QuotaCode: aws.String("L-0263D0A3"),
+			Value:     aws.Float64(1),
+		},
+	}, nil)
+	ec2Mock.EXPECT().DescribeAddresses(gomock.Any()).Return(&ec2.DescribeAddressesOutput{
+		Addresses: []*ec2.Address{{}},
+	}, nil)
+
+	s := NewService(clusterScope)
+	s.EC2Client = ec2Mock
+	s.ServiceQuotasClient = quotasMock
+
+	machine := &infrav1.AWSMachine{}
+	g.Expect(s.ReconcileHeadroom(machine, Request{ElasticIPs: 1})).NotTo(Succeed())
+	g.Expect(conditions.IsFalse(machine, infrav1.ServiceQuotaHeadroomCondition)).To(BeTrue())
+	g.Expect(conditions.GetReason(machine, infrav1.ServiceQuotaHeadroomCondition)).To(Equal(infrav1.InsufficientServiceQuotaReason))
+	g.Expect(conditions.GetMessage(machine, infrav1.ServiceQuotaHeadroomCondition)).To(ContainSubstring("L-0263D0A3"))
+
+	g.Expect(s.ReconcileHeadroom(machine, Request{})).To(Succeed())
+	g.Expect(conditions.IsTrue(machine, infrav1.ServiceQuotaHeadroomCondition)).To(BeTrue())
+}
+
+func setupCluster(clusterName string, checks *infrav1.ServiceQuotaChecks) (*scope.ClusterScope, error) {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: infrav1.AWSClusterSpec{
+			ServiceQuotaChecks: checks,
+		},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(awsCluster).Build()
+	return scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: clusterName},
+		},
+		AWSCluster: awsCluster,
+		Client:     client,
+	})
+}
@@ -36,6 +36,9 @@ var (
 
 	// ErrApplyFuncRequired defines an error for when tags are not supplied.
 	ErrApplyFuncRequired = errors.New("no tags apply function supplied")
+
+	// ErrRemoveFuncRequired defines an error for when tags have to be removed but no remove function is supplied.
+	ErrRemoveFuncRequired = errors.New("no tags remove function supplied")
 )
 
 // BuilderOption represents an option when creating a tags builder.
@@ -43,8 +46,10 @@ type BuilderOption func(*Builder)
 
 // Builder is the interface for a tags builder.
 type Builder struct {
-	params    *infrav1.BuildParams
-	applyFunc func(params *infrav1.BuildParams) error
+	params      *infrav1.BuildParams
+	applyFunc   func(params *infrav1.BuildParams) error
+	removeFunc  func(params *infrav1.BuildParams, keys []string) error
+	removedKeys []string
 }
 
 // New creates a new TagsBuilder with the specified build parameters
@@ -76,17 +81,36 @@ func (b *Builder) Apply() error {
 	return nil
 }
 
-// Ensure applies the tags if the current tags differ from the params.
+// Ensure applies the tags if the current tags differ from the params,
+// and removes the current tags whose keys were removed from the params.
 func (b *Builder) Ensure(current infrav1.Tags) error {
 	if b.params == nil {
 		return ErrBuildParamsRequired
 	}
 	if diff := computeDiff(current, *b.params); len(diff) > 0 {
-		return b.Apply()
+		if err := b.Apply(); err != nil {
+			return err
+		}
+	}
+	if keys := computeRemoved(current, *b.params, b.removedKeys); len(keys) > 0 {
+		if b.removeFunc == nil {
+			return ErrRemoveFuncRequired
+		}
+		if err := b.removeFunc(b.params, keys); err != nil {
+			return fmt.Errorf("failed removing tags: %w", err)
+		}
 	}
 	return nil
 }
 
+// WithRemovedKeys is used to remove the tags with the specified keys, when they are
+// still set on the resource and no longer part of the build parameters.
+func WithRemovedKeys(keys ...string) BuilderOption {
+	return func(b *Builder) {
+		b.removedKeys = keys
+	}
+}
+
 // WithEC2 is used to denote that the tags builder will be using EC2.
 func WithEC2(ec2client ec2iface.EC2API) BuilderOption {
 	return func(b *Builder) {
@@ -117,6 +141,20 @@ func WithEC2(ec2client ec2iface.EC2API) BuilderOption {
 			_, err := ec2client.CreateTags(createTagsInput)
 			return errors.Wrapf(err, "failed to tag resource %q in cluster %q", params.ResourceID, params.ClusterName)
 		}
+		b.removeFunc = func(params *infrav1.BuildParams, keys []string) error {
+			awsTags := make([]*ec2.Tag, 0, len(keys))
+			for _, key := range keys {
+				awsTags = append(awsTags, &ec2.Tag{Key: aws.String(key)})
+			}
+
+			deleteTagsInput := &ec2.DeleteTagsInput{
+				Resources: aws.StringSlice([]string{params.ResourceID}),
+				Tags:      awsTags,
+			}
+
+			_, err := ec2client.DeleteTags(deleteTagsInput)
+			return errors.Wrapf(err, "failed to untag resource %q in cluster %q", params.ResourceID, params.ClusterName)
+		}
 	}
 }
 
@@ -141,6 +179,19 @@ func WithEKS(eksclient eksiface.EKSAPI) BuilderOption {
 				return errors.Wrapf(err, "failed to tag eks cluster %q in cluster %q", params.ResourceID, params.ClusterName)
 			}
 
+			return nil
+		}
+		b.removeFunc = func(params *infrav1.BuildParams, keys []string) error {
+			untagResourceInput := &eks.UntagResourceInput{
+				ResourceArn: aws.String(params.ResourceID),
+				TagKeys:     aws.StringSlice(keys),
+			}
+
+			_, err := eksclient.UntagResource(untagResourceInput)
+			if err != nil {
+				return errors.Wrapf(err, "failed to untag eks cluster %q in cluster %q", params.ResourceID, params.ClusterName)
+			}
+
 			return nil
 		}
 	}
@@ -158,6 +209,25 @@ func computeDiff(current infrav1.Tags, buildParams infrav1.BuildParams) infrav1.
 	return want.Difference(current)
 }
 
+// computeRemoved returns the sorted removed keys which are still set in the current tags
+// and aren't part of the build parameters.
+func computeRemoved(current infrav1.Tags, buildParams infrav1.BuildParams, removedKeys []string) []string {
+	want := infrav1.Build(buildParams)
+
+	keys := []string{}
+	for _, key := range removedKeys {
+		if _, ok := want[key]; ok {
+			continue
+		}
+		if _, ok := current[key]; ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
 // BuildParamsToTagSpecification builds a TagSpecification for the specified resource type.
 func BuildParamsToTagSpecification(ec2ResourceType string, params infrav1.BuildParams) *ec2.TagSpecification {
 	tags := infrav1.Build(params)
@@ -240,6 +240,72 @@ func TestTags_EnsureWithEKS(t *testing.T) {
 	}
 }
 
+func TestTags_EnsureWithRemovedKeys(t *testing.T) {
+	current := infrav1.Tags{
+		"Name": "test",
+		"k1":   "v1",
+		"k2":   "v2",
+		"sigs.k8s.io/cluster-api-provider-aws/cluster/testcluster": "owned",
+		"sigs.k8s.io/cluster-api-provider-aws/role":                "testrole",
+	}
+
+	tests := []struct {
+		name        string
+		removedKeys []string
+		expect      func(ec2Mock *mock_ec2iface.MockEC2APIMockRecorder, eksMock *mock_eksiface.MockEKSAPIMockRecorder)
+		withEKS     bool
+	}{
+		{
+			name:        "Should not remove keys which aren't set on the resource",
+			removedKeys: []string{"k3"},
+		},
+		{
+			name:        "Should not remove keys which are part of the build params",
+			removedKeys: []string{"k1"},
+		},
+		{
+			name:        "Should remove keys with EC2",
+			removedKeys: []string{"k3", "k2"},
+			expect: func(ec2Mock *mock_ec2iface.MockEC2APIMockRecorder, _ *mock_eksiface.MockEKSAPIMockRecorder) {
+				ec2Mock.DeleteTags(gomock.Eq(&ec2.DeleteTagsInput{
+					Resources: aws.StringSlice([]string{""}),
+					Tags:      []*ec2.Tag{{Key: aws.String("k2")}},
+				})).Return(nil, nil)
+			},
+		},
+		{
+			name:        "Should remove keys with EKS",
+			removedKeys: []string{"k2"},
+			expect: func(_ *mock_ec2iface.MockEC2APIMockRecorder, eksMock *mock_eksiface.MockEKSAPIMockRecorder) {
+				eksMock.UntagResource(gomock.Eq(&eks.UntagResourceInput{
+					ResourceArn: aws.String(""),
+					TagKeys:     aws.StringSlice([]string{"k2"}),
+				})).Return(nil, nil)
+			},
+			withEKS: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ec2Mock := mock_ec2iface.NewMockEC2API(mockCtrl)
+			eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT(), eksMock.EXPECT())
+			}
+
+			opt := WithEC2(ec2Mock)
+			if tc.withEKS {
+				opt = WithEKS(eksMock)
+			}
+			builder := New(&bp, opt, WithRemovedKeys(tc.removedKeys...))
+			g.Expect(builder.Ensure(current)).To(Succeed())
+		})
+	}
+}
+
 func TestTags_BuildParamsToTagSpecification(t *testing.T) {
 	g := NewWithT(t)
 	tagSpec := BuildParamsToTagSpecification("test-resource", bp)
@@ -0,0 +1,41 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package managed provides the labels marking the resources created by CAPA in a workload cluster.
+package managed
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Labels returns the labels of the resources created by CAPA in the workload cluster of the given cluster.
+func Labels(clusterName string) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/managed-by": "cluster-api-provider-aws",
+		"app.kubernetes.io/part-of":    clusterName,
+	}
+}
+
+// HasLabels returns whether the object has all the given labels, i.e. whether it was created by
+// CAPA and can be updated or deleted.
+func HasLabels(obj metav1.Object, labels map[string]string) bool {
+	for k, v := range labels {
+		if obj.GetLabels()[k] != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,33 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestHasLabels(t *testing.T) {
+	g := NewWithT(t)
+
+	labels := Labels("test-cluster")
+	g.Expect(HasLabels(&metav1.ObjectMeta{Labels: labels}, labels)).To(BeTrue())
+	g.Expect(HasLabels(&metav1.ObjectMeta{}, labels)).To(BeFalse())
+	g.Expect(HasLabels(&metav1.ObjectMeta{Labels: Labels("other-cluster")}, labels)).To(BeFalse())
+}